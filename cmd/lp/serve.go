@@ -9,7 +9,9 @@ import (
 
 	"github.com/DimTur/lp_learning_platform/internal/app"
 	"github.com/DimTur/lp_learning_platform/internal/config"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
 	attstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	bankstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/banks"
 	channelstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/channels"
 	lessonstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/lessons"
 	pagestorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/pages"
@@ -62,7 +64,9 @@ func NewServeCmd() *cobra.Command {
 			lessonStorage := lessonstorage.NewLessonsStorage(storagePool)
 			pageStorage := pagestorage.NewPagesStorage(storagePool)
 			questionStorage := questionstorage.NewQuestionsStorage(storagePool)
+			bankStorage := bankstorage.NewBanksStorage(storagePool)
 			attemptStorage := attstorage.NewAttemptsStorage(storagePool)
+			txManager := postgresql.NewTxManager(storagePool)
 
 			validate := validator.New()
 
@@ -72,8 +76,11 @@ func NewServeCmd() *cobra.Command {
 				lessonStorage,
 				pageStorage,
 				questionStorage,
+				bankStorage,
 				attemptStorage,
+				txManager,
 				cfg.GRPCServer.Address,
+				cfg.Attempt.PassThreshold,
 				log,
 				validate,
 			)
@@ -5,12 +5,15 @@ import (
 
 	grpcapp "github.com/DimTur/lp_learning_platform/internal/app/grpc"
 	"github.com/DimTur/lp_learning_platform/internal/services/attempt"
+	"github.com/DimTur/lp_learning_platform/internal/services/bank"
 	"github.com/DimTur/lp_learning_platform/internal/services/channel"
 	"github.com/DimTur/lp_learning_platform/internal/services/lesson"
 	"github.com/DimTur/lp_learning_platform/internal/services/page"
 	"github.com/DimTur/lp_learning_platform/internal/services/plan"
 	"github.com/DimTur/lp_learning_platform/internal/services/question"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
 	attstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	bankstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/banks"
 	channelstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/channels"
 	lessonstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/lessons"
 	pagestorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/pages"
@@ -29,8 +32,11 @@ func NewApp(
 	lessonStorage *lessonstorage.LessonsPostgresStorage,
 	pageStorage *pagestorage.PagesPostgresStorage,
 	questionStorage *questiontorage.QuestionsPostgresStorage,
+	bankStorage *bankstorage.BanksPostgresStorage,
 	attemptStorage *attstorage.AttemptsPostgresStorage,
+	txManager *postgresql.TxManager,
 	grpcAddr string,
+	passThreshold int64,
 	logger *slog.Logger,
 	validator *validator.Validate,
 ) (*App, error) {
@@ -71,6 +77,15 @@ func NewApp(
 		validator,
 		questionStorage,
 		questionStorage,
+		questionStorage,
+	)
+
+	lpGRPCBankHandlers := bank.New(
+		logger,
+		validator,
+		bankStorage,
+		bankStorage,
+		bankStorage,
 	)
 
 	lpGRPCAttemptHandlers := attempt.New(
@@ -78,6 +93,10 @@ func NewApp(
 		validator,
 		attemptStorage,
 		attemptStorage,
+		questionStorage,
+		attemptStorage,
+		txManager,
+		passThreshold,
 	)
 
 	grpcServer, err := grpcapp.NewGRPCServer(
@@ -87,6 +106,7 @@ func NewApp(
 		lpGRPCLessonHandlers,
 		lpGRPCPageHandlers,
 		lpGRPCQuestionHandlers,
+		lpGRPCBankHandlers,
 		lpGRPCAttemptHandlers,
 		logger,
 		validator,
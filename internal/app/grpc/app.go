@@ -39,6 +39,7 @@ func NewGRPCServer(
 	lessonHandlers lp_handlers.LessonHandlers,
 	pageHandlers lp_handlers.PageHandlers,
 	questionHandlers lp_handlers.QuestionHandlers,
+	bankHandlers lp_handlers.BankHandlers,
 	attemptHandlers lp_handlers.AttemptHandlers,
 	logger *slog.Logger,
 	validator *validator.Validate,
@@ -75,6 +76,7 @@ func NewGRPCServer(
 		lessonHandlers,
 		pageHandlers,
 		questionHandlers,
+		bankHandlers,
 		attemptHandlers,
 	)
 
@@ -7,6 +7,7 @@ import (
 type Config struct {
 	GRPCServer GRPCServer `yaml:"grpc_server"`
 	Storage    Storage    `yaml:"storage"`
+	Attempt    Attempt    `yaml:"attempt"`
 }
 
 type GRPCServer struct {
@@ -22,6 +23,10 @@ type Storage struct {
 	DBName   string `yaml:"dbname"`
 }
 
+type Attempt struct {
+	PassThreshold int64 `yaml:"pass_threshold" env-default:"80"`
+}
+
 func Parse(s string) (*Config, error) {
 	c := &Config{}
 	if err := cleanenv.ReadConfig(s, c); err != nil {
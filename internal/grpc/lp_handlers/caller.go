@@ -0,0 +1,57 @@
+package lp_handlers
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Identity of the caller is set in the request metadata by the gateway
+// which authenticates users.
+const (
+	userIDMetadataKey   = "x-user-id"
+	userRoleMetadataKey = "x-user-role"
+)
+
+const (
+	roleAuthor  = "author"
+	roleLearner = "learner"
+)
+
+type caller struct {
+	userID int64
+	role   string
+}
+
+// isAuthor reports whether the caller may see correct answers and explanations of questions.
+func (c caller) isAuthor() bool {
+	return c.role == roleAuthor
+}
+
+// callerFromContext reads the caller from the request metadata. A caller
+// without a role is treated as a learner, so answers are never exposed by default.
+func callerFromContext(ctx context.Context) (caller, error) {
+	c := caller{role: roleLearner}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return c, nil
+	}
+
+	if roles := md.Get(userRoleMetadataKey); len(roles) > 0 && roles[0] != "" {
+		c.role = roles[0]
+	}
+
+	if ids := md.Get(userIDMetadataKey); len(ids) > 0 && ids[0] != "" {
+		userID, err := strconv.ParseInt(ids[0], 10, 64)
+		if err != nil {
+			return caller{}, status.Error(codes.Unauthenticated, "invalid user id")
+		}
+		c.userID = userID
+	}
+
+	return c, nil
+}
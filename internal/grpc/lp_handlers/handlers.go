@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/banks"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/channels"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/lessons"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/pages"
@@ -19,6 +20,7 @@ type ChannelHandlers interface {
 	GetChannels(ctx context.Context, limit, offset int64) ([]channels.Channel, error)
 	UpdateChannel(ctx context.Context, updChannel channels.UpdateChannelRequest) (int64, error)
 	DeleteChannel(ctx context.Context, channelID int64) error
+	GetPlanChannels(ctx context.Context, planID int64) ([]channels.Channel, error)
 }
 
 type PlanHandlers interface {
@@ -27,6 +29,10 @@ type PlanHandlers interface {
 	GetPlans(ctx context.Context, channel_id int64, limit, offset int64) ([]plans.Plan, error)
 	UpdatePlan(ctx context.Context, updPlan plans.UpdatePlanRequest) (int64, error)
 	DeletePlan(ctx context.Context, planID int64) error
+	ReorderPlans(ctx context.Context, reorder plans.ReorderPlans) error
+	AttachPlanToChannel(ctx context.Context, attach plans.PlanInChannel) error
+	DetachPlanFromChannel(ctx context.Context, detach plans.PlanInChannel) error
+	ClonePlan(ctx context.Context, clone plans.ClonePlan) (int64, error)
 }
 
 type LessonHandlers interface {
@@ -35,6 +41,11 @@ type LessonHandlers interface {
 	GetLessons(ctx context.Context, plan_id int64, limit, offset int64) ([]lessons.Lesson, error)
 	UpdateLesson(ctx context.Context, updLEsson lessons.UpdateLessonRequest) (int64, error)
 	DeleteLesson(ctx context.Context, lessonID int64) error
+	ReorderLessons(ctx context.Context, reorder lessons.ReorderLessons) error
+	AttachLessonToPlan(ctx context.Context, attach lessons.LessonInPlan) error
+	DetachLessonFromPlan(ctx context.Context, detach lessons.LessonInPlan) error
+	CloneLesson(ctx context.Context, clone lessons.CloneLesson) (int64, error)
+	MoveLesson(ctx context.Context, move lessons.MoveLesson) error
 }
 
 type PageHandlers interface {
@@ -42,17 +53,41 @@ type PageHandlers interface {
 	GetPage(ctx context.Context, pageID int64, contentType string) (pages.Page, error)
 	GetPages(ctx context.Context, lessonID int64, limit, offset int64) ([]pages.BasePage, error)
 	UpdatePage(ctx context.Context, updPage pages.UpdatePage) (int64, error)
+	ReorderPages(ctx context.Context, reorder pages.ReorderPages) (int64, error)
+	MovePage(ctx context.Context, move pages.MovePage) error
 	DeletePage(ctx context.Context, pageID int64) error
 }
 
 type QuestionHandlers interface {
 	CreateQuestionPage(ctx context.Context, questionPage questions.CreateQuestionPage) (int64, error)
 	GetQuestionPageByID(ctx context.Context, pageID int64) (questions.QuestionPage, error)
+	GetLearnerQuestionPage(ctx context.Context, pageID, userID int64) (questions.QuestionPage, error)
 	UpdateQuestionPage(ctx context.Context, updPage questions.UpdateQuestionPage) (int64, error)
+	DeleteQuestionPage(ctx context.Context, pageID int64) error
+	GetQuestionPages(ctx context.Context, lessonID int64, limit, offset int64) ([]questions.QuestionPage, error)
+	GetLearnerQuestionPages(ctx context.Context, lessonID, userID int64, limit, offset int64) ([]questions.QuestionPage, error)
+}
+
+type BankHandlers interface {
+	CreateQuestionBank(ctx context.Context, bank banks.CreateBank) (int64, error)
+	GetQuestionBank(ctx context.Context, bankID int64) (banks.BankWithItems, error)
+	GetQuestionBanks(ctx context.Context, filter banks.BanksFilter, limit, offset int64) ([]banks.Bank, error)
+	DeleteQuestionBank(ctx context.Context, bankID int64) error
+	AddQuestionToBank(ctx context.Context, item banks.AddBankItem) (int64, error)
+	RemoveQuestionFromBank(ctx context.Context, bankID, pageID int64) error
+	SetLessonQuestionDraws(ctx context.Context, draws banks.SetLessonDraws) error
+	GetLessonQuestionDraws(ctx context.Context, lessonID int64) ([]banks.QuestionDraw, error)
 }
 
 type AttemptHandlers interface {
-	CreateAttempt(ctx context.Context, attempt attempts.CreateLessonAttempt) (int64, error)
+	CreateAttempt(ctx context.Context, attempt attempts.CreateLessonAttempt) (attempts.CreatedLessonAttempt, error)
+	SubmitQuestionAnswer(ctx context.Context, answer attempts.SubmitQuestionAnswer) (attempts.QuestionAnswerResult, error)
+	CompleteAttempt(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttemptResult, error)
+	GetAttempt(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttemptWithPages, error)
+	ListAttempts(ctx context.Context, filter attempts.ListAttemptsFilter, limit, offset int64) ([]attempts.LessonAttempt, error)
+	MarkPageViewed(ctx context.Context, viewed attempts.MarkPageViewed) (attempts.ContentPageAttempt, error)
+	GetPlanProgress(ctx context.Context, userID, planID int64) (attempts.PlanProgress, error)
+	GetChannelProgress(ctx context.Context, userID, channelID int64) (attempts.ChannelProgress, error)
 }
 
 type serverAPI struct {
@@ -61,6 +96,7 @@ type serverAPI struct {
 	lessonHandlers   LessonHandlers
 	pageHandlers     PageHandlers
 	questionHandlers QuestionHandlers
+	bankHandlers     BankHandlers
 	attemptHandlers  AttemptHandlers
 
 	lpv1.UnsafeLearningPlatformServer
@@ -73,6 +109,7 @@ func RegisterLPServiceServer(
 	lh LessonHandlers,
 	pgh PageHandlers,
 	qh QuestionHandlers,
+	bh BankHandlers,
 	ah AttemptHandlers,
 ) {
 	lpv1.RegisterLearningPlatformServer(gRPC, &serverAPI{
@@ -81,6 +118,7 @@ func RegisterLPServiceServer(
 		lessonHandlers:   lh,
 		pageHandlers:     pgh,
 		questionHandlers: qh,
+		bankHandlers:     bh,
 		attemptHandlers:  ah,
 	})
 }
@@ -3,12 +3,14 @@ package lp_handlers
 import (
 	"context"
 	"errors"
+	"time"
 
 	attserv "github.com/DimTur/lp_learning_platform/internal/services/attempt"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
 	lpv1 "github.com/DimTur/lp_learning_platform/pkg/server/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *serverAPI) CreateAttempt(ctx context.Context, req *lpv1.CreateAttemptRequest) (*lpv1.CreateAttemptResponse, error) {
@@ -17,19 +19,335 @@ func (s *serverAPI) CreateAttempt(ctx context.Context, req *lpv1.CreateAttemptRe
 		PlanId:    req.GetPlanId(),
 		ChannelID: req.GetChannelId(),
 		UserID:    req.GetUserId(),
+		Resume:    req.GetResume(),
 	}
 
-	lAttemptID, err := s.attemptHandlers.CreateAttempt(ctx, lAttempt)
+	created, err := s.attemptHandlers.CreateAttempt(ctx, lAttempt)
 	if err != nil {
-		if errors.Is(err, attserv.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, attserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, attserv.ErrAttemptExitsts):
+			return nil, status.Error(codes.AlreadyExists, "incomplete attempt already exists")
+		case errors.Is(err, attserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, attserv.ErrAttemptsExhausted):
+			return nil, status.Error(codes.ResourceExhausted, "maximum number of attempts reached")
+		case errors.Is(err, attserv.ErrAttemptCooldown):
+			return nil, status.Error(codes.FailedPrecondition, "attempt cooldown has not passed")
+		case errors.Is(err, attserv.ErrNotEnoughQuestions):
+			return nil, status.Error(codes.FailedPrecondition, "not enough questions in bank")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &lpv1.CreateAttemptResponse{
-		Id:      lAttemptID,
+		Id:      created.ID,
 		Success: true,
+		Resumed: created.Resumed,
 	}, nil
 }
+
+func (s *serverAPI) SubmitQuestionAnswer(ctx context.Context, req *lpv1.SubmitQuestionAnswerRequest) (*lpv1.SubmitQuestionAnswerResponse, error) {
+	if req.GetOptionId() == 0 && req.GetTextAnswer() == "" && len(req.GetSelectedOptionIds()) == 0 &&
+		req.BoolAnswer == nil && req.NumericAnswer == nil && len(req.GetBlankAnswers()) == 0 &&
+		len(req.GetOrderedItems()) == 0 && len(req.GetMatchedPairs()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "answer must be specified")
+	}
+
+	answer := attempts.SubmitQuestionAnswer{
+		LessonAttemptID:   req.GetLessonAttemptId(),
+		PageID:            req.GetPageId(),
+		OptionID:          req.GetOptionId(),
+		TextAnswer:        req.GetTextAnswer(),
+		SelectedOptionIDs: req.GetSelectedOptionIds(),
+		BoolAnswer:        req.BoolAnswer,
+		NumericAnswer:     req.NumericAnswer,
+		BlankAnswers:      req.GetBlankAnswers(),
+		OrderedItems:      req.GetOrderedItems(),
+		MatchedPairs:      convertFromMatchedPairs(req.GetMatchedPairs()),
+	}
+
+	result, err := s.attemptHandlers.SubmitQuestionAnswer(ctx, answer)
+	if err != nil {
+		switch {
+		case errors.Is(err, attserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, attserv.ErrAttemptNotFound):
+			return nil, status.Error(codes.NotFound, "attempt not found")
+		case errors.Is(err, attserv.ErrQuestionNotFound):
+			return nil, status.Error(codes.NotFound, "question not found")
+		case errors.Is(err, attserv.ErrAttemptCompleted):
+			return nil, status.Error(codes.FailedPrecondition, "attempt already completed")
+		case errors.Is(err, attserv.ErrAttemptExpired):
+			return nil, status.Error(codes.FailedPrecondition, "attempt time limit exceeded")
+		case errors.Is(err, attserv.ErrAlreadyAnswered):
+			return nil, status.Error(codes.FailedPrecondition, "question already answered in this attempt")
+		case errors.Is(err, attserv.ErrAnswerMismatch):
+			return nil, status.Error(codes.InvalidArgument, "answer does not match question type")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.SubmitQuestionAnswerResponse{
+		Id:           result.ID,
+		IsSuccessful: result.IsSuccessful,
+		Score:        result.Score,
+		Explanation:  result.Explanation,
+		Feedback:     convertToOptionFeedback(result.Feedback),
+	}, nil
+}
+
+func (s *serverAPI) CompleteAttempt(ctx context.Context, req *lpv1.CompleteAttemptRequest) (*lpv1.CompleteAttemptResponse, error) {
+	result, err := s.attemptHandlers.CompleteAttempt(ctx, req.GetId())
+	if err != nil {
+		switch {
+		case errors.Is(err, attserv.ErrAttemptNotFound):
+			return nil, status.Error(codes.NotFound, "attempt not found")
+		case errors.Is(err, attserv.ErrAttemptCompleted):
+			return nil, status.Error(codes.FailedPrecondition, "attempt already completed")
+		case errors.Is(err, attserv.ErrPagesNotViewed):
+			return nil, status.Error(codes.FailedPrecondition, "not all pages were viewed")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.CompleteAttemptResponse{
+		Id:              result.ID,
+		PercentageScore: result.PercentageScore,
+		IsSuccessful:    result.IsSuccessful,
+	}, nil
+}
+
+func (s *serverAPI) GetAttempt(ctx context.Context, req *lpv1.GetAttemptRequest) (*lpv1.GetAttemptResponse, error) {
+	lAttempt, err := s.attemptHandlers.GetAttempt(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, attserv.ErrAttemptNotFound) {
+			return nil, status.Error(codes.NotFound, "attempt not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responsePAttempts []*lpv1.PageAttempt
+	for _, pAttempt := range lAttempt.PageAttempts {
+		responsePAttempts = append(responsePAttempts, &lpv1.PageAttempt{
+			Id:           pAttempt.ID,
+			PageId:       pAttempt.PageID,
+			ContentType:  convertToContentType(pAttempt.ContentType),
+			QuestionType: convertToQuestionType(pAttempt.QuestionType),
+			UserAnswer:   pAttempt.UserAnswer,
+			IsSuccessful: pAttempt.IsSuccessful,
+			CreatedAt:    timestamppb.New(pAttempt.CreatedAt),
+			Modified:     optionalTimestamp(pAttempt.Modified),
+			IsViewed:     pAttempt.IsViewed,
+			ViewedAt:     optionalTimestamp(pAttempt.ViewedAt),
+			Progress:     pAttempt.Progress,
+			Score:        pAttempt.Score,
+			Position:     pAttempt.Position,
+			OptionOrder:  pAttempt.OptionOrder,
+			BankId:       pAttempt.BankID,
+			Draw:         toQuestionDrawResponse(pAttempt.Draw),
+		})
+	}
+
+	return &lpv1.GetAttemptResponse{
+		Attempt:      toLessonAttemptResponse(lAttempt.LessonAttempt),
+		PageAttempts: responsePAttempts,
+	}, nil
+}
+
+func (s *serverAPI) ListAttempts(ctx context.Context, req *lpv1.ListAttemptsRequest) (*lpv1.ListAttemptsResponse, error) {
+	filter := attempts.ListAttemptsFilter{
+		UserID:    req.UserId,
+		LessonID:  req.LessonId,
+		PlanID:    req.PlanId,
+		ChannelID: req.ChannelId,
+	}
+
+	lAttempts, err := s.attemptHandlers.ListAttempts(ctx, filter, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		if errors.Is(err, attserv.ErrInvalidCredentials) {
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responseLAttempts []*lpv1.LessonAttempt
+	for _, lAttempt := range lAttempts {
+		responseLAttempts = append(responseLAttempts, toLessonAttemptResponse(lAttempt))
+	}
+
+	return &lpv1.ListAttemptsResponse{
+		Attempts: responseLAttempts,
+	}, nil
+}
+
+func (s *serverAPI) MarkPageViewed(ctx context.Context, req *lpv1.MarkPageViewedRequest) (*lpv1.MarkPageViewedResponse, error) {
+	viewed := attempts.MarkPageViewed{
+		LessonAttemptID: req.GetLessonAttemptId(),
+		PageID:          req.GetPageId(),
+		Progress:        req.Progress,
+	}
+
+	cpAttempt, err := s.attemptHandlers.MarkPageViewed(ctx, viewed)
+	if err != nil {
+		switch {
+		case errors.Is(err, attserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, attserv.ErrAttemptNotFound):
+			return nil, status.Error(codes.NotFound, "attempt not found")
+		case errors.Is(err, attserv.ErrAttemptCompleted):
+			return nil, status.Error(codes.FailedPrecondition, "attempt already completed")
+		case errors.Is(err, attserv.ErrAttemptExpired):
+			return nil, status.Error(codes.FailedPrecondition, "attempt time limit exceeded")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.MarkPageViewedResponse{
+		Id:       cpAttempt.PageAttemptID,
+		IsViewed: cpAttempt.IsViewed,
+		Progress: cpAttempt.Progress,
+	}, nil
+}
+
+func (s *serverAPI) GetPlanProgress(ctx context.Context, req *lpv1.GetPlanProgressRequest) (*lpv1.GetPlanProgressResponse, error) {
+	progress, err := s.attemptHandlers.GetPlanProgress(ctx, req.GetUserId(), req.GetPlanId())
+	if err != nil {
+		switch {
+		case errors.Is(err, attserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		case errors.Is(err, attserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.GetPlanProgressResponse{
+		Progress: toPlanProgressResponse(progress),
+	}, nil
+}
+
+func (s *serverAPI) GetChannelProgress(ctx context.Context, req *lpv1.GetChannelProgressRequest) (*lpv1.GetChannelProgressResponse, error) {
+	progress, err := s.attemptHandlers.GetChannelProgress(ctx, req.GetUserId(), req.GetChannelId())
+	if err != nil {
+		switch {
+		case errors.Is(err, attserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		case errors.Is(err, attserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	var responsePlans []*lpv1.PlanProgress
+	for _, pProgress := range progress.Plans {
+		responsePlans = append(responsePlans, toPlanProgressResponse(pProgress))
+	}
+
+	return &lpv1.GetChannelProgressResponse{
+		ChannelId:            progress.ChannelID,
+		TotalLessons:         progress.TotalLessons,
+		PassedLessons:        progress.PassedLessons,
+		CompletionPercentage: progress.CompletionPercentage,
+		Plans:                responsePlans,
+	}, nil
+}
+
+func toPlanProgressResponse(progress attempts.PlanProgress) *lpv1.PlanProgress {
+	var responseLessons []*lpv1.LessonProgress
+	for _, lProgress := range progress.Lessons {
+		responseLessons = append(responseLessons, &lpv1.LessonProgress{
+			LessonId:      lProgress.LessonID,
+			LessonName:    lProgress.LessonName,
+			Status:        convertToLessonStatus(lProgress.Status),
+			BestScore:     lProgress.BestScore,
+			AttemptsCount: lProgress.AttemptsCount,
+			LastAttemptAt: optionalTimestamp(lProgress.LastAttemptAt),
+		})
+	}
+
+	return &lpv1.PlanProgress{
+		PlanId:               progress.PlanID,
+		PlanName:             progress.PlanName,
+		TotalLessons:         progress.TotalLessons,
+		PassedLessons:        progress.PassedLessons,
+		CompletionPercentage: progress.CompletionPercentage,
+		Lessons:              responseLessons,
+	}
+}
+
+func convertToLessonStatus(status string) lpv1.LessonStatus {
+	switch status {
+	case attempts.LessonStatusNotStarted:
+		return lpv1.LessonStatus_NOT_STARTED
+	case attempts.LessonStatusInProgress:
+		return lpv1.LessonStatus_IN_PROGRESS
+	case attempts.LessonStatusPassed:
+		return lpv1.LessonStatus_PASSED
+	case attempts.LessonStatusFailed:
+		return lpv1.LessonStatus_FAILED
+	default:
+		return lpv1.LessonStatus_LESSON_STATUS_UNSPECIFIED
+	}
+}
+
+func toLessonAttemptResponse(lAttempt attempts.LessonAttempt) *lpv1.LessonAttempt {
+	return &lpv1.LessonAttempt{
+		Id:              lAttempt.ID,
+		LessonId:        lAttempt.LessonID,
+		PlanId:          lAttempt.PlanID,
+		ChannelId:       lAttempt.ChannelID,
+		UserId:          lAttempt.UserID,
+		StartTime:       timestamppb.New(lAttempt.StartTime),
+		EndTime:         optionalTimestamp(lAttempt.EndTime),
+		IsComplete:      lAttempt.IsComplete,
+		IsSuccessful:    lAttempt.IsSuccessful,
+		PercentageScore: lAttempt.PercentageScore,
+	}
+}
+
+// optionalTimestamp returns nil for zero time so unset columns are not sent as epoch.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func convertFromMatchedPairs(pairs []*lpv1.MatchingPair) []attempts.MatchedPair {
+	var converted []attempts.MatchedPair
+	for _, pair := range pairs {
+		converted = append(converted, attempts.MatchedPair{Left: pair.GetLeft(), Right: pair.GetRight()})
+	}
+	return converted
+}
+
+func convertToOptionFeedback(feedback []attempts.OptionFeedback) []*lpv1.OptionFeedback {
+	var converted []*lpv1.OptionFeedback
+	for _, f := range feedback {
+		converted = append(converted, &lpv1.OptionFeedback{OptionId: f.OptionID, Feedback: f.Feedback})
+	}
+	return converted
+}
+
+// toQuestionDrawResponse returns nil for a question page attempt which was not drawn from a bank.
+func toQuestionDrawResponse(draw attempts.QuestionDraw) *lpv1.QuestionDraw {
+	if draw.Count == 0 {
+		return nil
+	}
+	return &lpv1.QuestionDraw{
+		BankId:     draw.BankID,
+		Count:      draw.Count,
+		Tag:        draw.Tag,
+		Difficulty: convertToDifficulty(draw.Difficulty),
+	}
+}
@@ -0,0 +1,245 @@
+package lp_handlers
+
+import (
+	"context"
+	"errors"
+
+	bankserv "github.com/DimTur/lp_learning_platform/internal/services/bank"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/banks"
+	lpv1 "github.com/DimTur/lp_learning_platform/pkg/server/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *serverAPI) CreateQuestionBank(ctx context.Context, req *lpv1.CreateQuestionBankRequest) (*lpv1.CreateQuestionBankResponse, error) {
+	bank := banks.CreateBank{
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		LessonID:       req.GetLessonId(),
+		ChannelID:      req.GetChannelId(),
+		CreatedBy:      req.GetCreatedBy(),
+		LastModifiedBy: req.GetCreatedBy(),
+	}
+
+	bankID, err := s.bankHandlers.CreateQuestionBank(ctx, bank)
+	if err != nil {
+		switch {
+		case errors.Is(err, bankserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, bankserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, bankserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.CreateQuestionBankResponse{
+		Id: bankID,
+	}, nil
+}
+
+func (s *serverAPI) GetQuestionBank(ctx context.Context, req *lpv1.GetQuestionBankRequest) (*lpv1.GetQuestionBankResponse, error) {
+	bank, err := s.bankHandlers.GetQuestionBank(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, bankserv.ErrBankNotFound) {
+			return nil, status.Error(codes.NotFound, "question bank not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responseItems []*lpv1.QuestionBankItem
+	for _, item := range bank.Items {
+		responseItems = append(responseItems, &lpv1.QuestionBankItem{
+			Id:         item.ID,
+			PageId:     item.PageID,
+			Tag:        item.Tag,
+			Difficulty: convertToDifficulty(item.Difficulty),
+		})
+	}
+
+	return &lpv1.GetQuestionBankResponse{
+		Bank:  toQuestionBankResponse(bank.Bank),
+		Items: responseItems,
+	}, nil
+}
+
+func (s *serverAPI) GetQuestionBanks(ctx context.Context, req *lpv1.GetQuestionBanksRequest) (*lpv1.GetQuestionBanksResponse, error) {
+	filter := banks.BanksFilter{
+		LessonID:  req.LessonId,
+		ChannelID: req.ChannelId,
+	}
+
+	qBanks, err := s.bankHandlers.GetQuestionBanks(ctx, filter, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		if errors.Is(err, bankserv.ErrInvalidCredentials) {
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responseBanks []*lpv1.QuestionBank
+	for _, bank := range qBanks {
+		responseBanks = append(responseBanks, toQuestionBankResponse(bank))
+	}
+
+	return &lpv1.GetQuestionBanksResponse{
+		Banks: responseBanks,
+	}, nil
+}
+
+func (s *serverAPI) DeleteQuestionBank(ctx context.Context, req *lpv1.DeleteQuestionBankRequest) (*lpv1.DeleteQuestionBankResponse, error) {
+	err := s.bankHandlers.DeleteQuestionBank(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, bankserv.ErrBankNotFound) {
+			return nil, status.Error(codes.NotFound, "question bank not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &lpv1.DeleteQuestionBankResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) AddQuestionToBank(ctx context.Context, req *lpv1.AddQuestionToBankRequest) (*lpv1.AddQuestionToBankResponse, error) {
+	item := banks.AddBankItem{
+		BankID:     req.GetBankId(),
+		PageID:     req.GetPageId(),
+		Tag:        req.GetTag(),
+		Difficulty: convertFromDifficulty(req.GetDifficulty()),
+	}
+
+	itemID, err := s.bankHandlers.AddQuestionToBank(ctx, item)
+	if err != nil {
+		switch {
+		case errors.Is(err, bankserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, bankserv.ErrBankNotFound):
+			return nil, status.Error(codes.NotFound, "question bank not found")
+		case errors.Is(err, bankserv.ErrPageNotFound):
+			return nil, status.Error(codes.NotFound, "question page not found")
+		case errors.Is(err, bankserv.ErrQuestionInBank):
+			return nil, status.Error(codes.AlreadyExists, "question already in bank")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.AddQuestionToBankResponse{
+		Id: itemID,
+	}, nil
+}
+
+func (s *serverAPI) RemoveQuestionFromBank(ctx context.Context, req *lpv1.RemoveQuestionFromBankRequest) (*lpv1.RemoveQuestionFromBankResponse, error) {
+	err := s.bankHandlers.RemoveQuestionFromBank(ctx, req.GetBankId(), req.GetPageId())
+	if err != nil {
+		if errors.Is(err, bankserv.ErrPageNotFound) {
+			return nil, status.Error(codes.NotFound, "question not found in bank")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &lpv1.RemoveQuestionFromBankResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) SetLessonQuestionDraws(ctx context.Context, req *lpv1.SetLessonQuestionDrawsRequest) (*lpv1.SetLessonQuestionDrawsResponse, error) {
+	draws := banks.SetLessonDraws{
+		LessonID: req.GetLessonId(),
+	}
+	for _, draw := range req.GetDraws() {
+		draws.Draws = append(draws.Draws, banks.QuestionDraw{
+			BankID:     draw.GetBankId(),
+			Count:      draw.GetCount(),
+			Tag:        draw.GetTag(),
+			Difficulty: convertFromDifficulty(draw.GetDifficulty()),
+		})
+	}
+
+	err := s.bankHandlers.SetLessonQuestionDraws(ctx, draws)
+	if err != nil {
+		switch {
+		case errors.Is(err, bankserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, bankserv.ErrBankNotFound):
+			return nil, status.Error(codes.NotFound, "question bank not found")
+		case errors.Is(err, bankserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.SetLessonQuestionDrawsResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) GetLessonQuestionDraws(ctx context.Context, req *lpv1.GetLessonQuestionDrawsRequest) (*lpv1.GetLessonQuestionDrawsResponse, error) {
+	draws, err := s.bankHandlers.GetLessonQuestionDraws(ctx, req.GetLessonId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responseDraws []*lpv1.QuestionDraw
+	for _, draw := range draws {
+		responseDraws = append(responseDraws, &lpv1.QuestionDraw{
+			BankId:     draw.BankID,
+			Count:      draw.Count,
+			Tag:        draw.Tag,
+			Difficulty: convertToDifficulty(draw.Difficulty),
+		})
+	}
+
+	return &lpv1.GetLessonQuestionDrawsResponse{
+		Draws: responseDraws,
+	}, nil
+}
+
+func toQuestionBankResponse(bank banks.Bank) *lpv1.QuestionBank {
+	return &lpv1.QuestionBank{
+		Id:             bank.ID,
+		Name:           bank.Name,
+		Description:    bank.Description,
+		LessonId:       bank.LessonID,
+		ChannelId:      bank.ChannelID,
+		CreatedBy:      bank.CreatedBy,
+		LastModifiedBy: bank.LastModifiedBy,
+		CreatedAt:      timestamppb.New(bank.CreatedAt),
+		Modified:       optionalTimestamp(bank.Modified),
+	}
+}
+
+func convertToDifficulty(difficulty string) lpv1.Difficulty {
+	switch difficulty {
+	case banks.DifficultyEasy:
+		return lpv1.Difficulty_EASY
+	case banks.DifficultyMedium:
+		return lpv1.Difficulty_MEDIUM
+	case banks.DifficultyHard:
+		return lpv1.Difficulty_HARD
+	default:
+		return lpv1.Difficulty_DIFFICULTY_UNSPECIFIED
+	}
+}
+
+func convertFromDifficulty(difficulty lpv1.Difficulty) string {
+	switch difficulty {
+	case lpv1.Difficulty_EASY:
+		return banks.DifficultyEasy
+	case lpv1.Difficulty_MEDIUM:
+		return banks.DifficultyMedium
+	case lpv1.Difficulty_HARD:
+		return banks.DifficultyHard
+	default:
+		return ""
+	}
+}
@@ -154,3 +154,31 @@ func (s *serverAPI) DeleteChannel(ctx context.Context, req *lpv1.DeleteChannelRe
 		Success: true,
 	}, nil
 }
+
+func (s *serverAPI) GetPlanChannels(ctx context.Context, req *lpv1.GetPlanChannelsRequest) (*lpv1.GetPlanChannelsResponse, error) {
+	channels, err := s.channelHandlers.GetPlanChannels(ctx, req.GetPlanId())
+	if err != nil {
+		if errors.Is(err, chanserv.ErrPlanNotFound) {
+			return nil, status.Error(codes.NotFound, "plan not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responseChannels []*lpv1.Channel
+	for _, channel := range channels {
+		responseChannels = append(responseChannels, &lpv1.Channel{
+			Id:             channel.ID,
+			Name:           channel.Name,
+			Description:    channel.Description,
+			CreatedBy:      channel.CreatedBy,
+			LastModifiedBy: channel.LastModifiedBy,
+			CreatedAt:      timestamppb.New(channel.CreatedAt),
+			Modified:       timestamppb.New(channel.Modified),
+		})
+	}
+
+	return &lpv1.GetPlanChannelsResponse{
+		Channels: responseChannels,
+	}, nil
+}
@@ -20,15 +20,33 @@ func (s *serverAPI) CreateLesson(ctx context.Context, req *lpv1.CreateLessonRequ
 		CreatedBy:      req.GetCreatedBy(),
 		LastModifiedBy: req.GetCreatedBy(),
 		PlanID:         req.GetPlanId(),
+
+		MaxAttempts:            req.GetMaxAttempts(),
+		AttemptCooldownSeconds: req.GetAttemptCooldownSeconds(),
+		TimeLimitSeconds:       req.GetTimeLimitSeconds(),
+		RequireAllPagesViewed:  req.GetRequireAllPagesViewed(),
+		ShuffleQuestions:       req.GetShuffleQuestions(),
+		ShuffleOptions:         req.GetShuffleOptions(),
+
+		Description:              req.GetDescription(),
+		EstimatedDurationMinutes: req.GetEstimatedDurationMinutes(),
+		Difficulty:               convertFromDifficulty(req.GetDifficulty()),
+		LearningObjectives:       req.GetLearningObjectives(),
+		CoverImageURL:            req.GetCoverImageUrl(),
 	}
 
 	lessonID, err := s.lessonHandlers.CreateLesson(ctx, lesson)
 	if err != nil {
-		if errors.Is(err, planserv.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, lessonserv.ErrLessonExitsts):
+			return nil, status.Error(codes.AlreadyExists, "lesson with this name already in plan")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &lpv1.CreateLessonResponse{
@@ -54,6 +72,20 @@ func (s *serverAPI) GetLesson(ctx context.Context, req *lpv1.GetLessonRequest) (
 			LastModifiedBy: lesson.LastModifiedBy,
 			CreatedAt:      timestamppb.New(lesson.CreatedAt),
 			Modified:       timestamppb.New(lesson.Modified),
+
+			MaxAttempts:            lesson.MaxAttempts,
+			AttemptCooldownSeconds: lesson.AttemptCooldownSeconds,
+			TimeLimitSeconds:       lesson.TimeLimitSeconds,
+			RequireAllPagesViewed:  lesson.RequireAllPagesViewed,
+			ShuffleQuestions:       lesson.ShuffleQuestions,
+			ShuffleOptions:         lesson.ShuffleOptions,
+			PagesVersion:           lesson.PagesVersion,
+
+			Description:              lesson.Description,
+			EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+			Difficulty:               convertToDifficulty(lesson.Difficulty),
+			LearningObjectives:       lesson.LearningObjectives,
+			CoverImageUrl:            lesson.CoverImageURL,
 		},
 	}, nil
 }
@@ -80,6 +112,20 @@ func (s *serverAPI) GetLessons(ctx context.Context, req *lpv1.GetLessonsRequest)
 			LastModifiedBy: lesson.LastModifiedBy,
 			CreatedAt:      timestamppb.New(lesson.CreatedAt),
 			Modified:       timestamppb.New(lesson.Modified),
+
+			MaxAttempts:            lesson.MaxAttempts,
+			AttemptCooldownSeconds: lesson.AttemptCooldownSeconds,
+			TimeLimitSeconds:       lesson.TimeLimitSeconds,
+			RequireAllPagesViewed:  lesson.RequireAllPagesViewed,
+			ShuffleQuestions:       lesson.ShuffleQuestions,
+			ShuffleOptions:         lesson.ShuffleOptions,
+			PagesVersion:           lesson.PagesVersion,
+
+			Description:              lesson.Description,
+			EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+			Difficulty:               convertToDifficulty(lesson.Difficulty),
+			LearningObjectives:       lesson.LearningObjectives,
+			CoverImageUrl:            lesson.CoverImageURL,
 		})
 	}
 
@@ -94,10 +140,34 @@ func (s *serverAPI) UpdateLesson(ctx context.Context, req *lpv1.UpdateLessonRequ
 		name = proto.String(req.GetName())
 	}
 
+	var difficulty *string
+	if req.Difficulty != nil {
+		difficulty = proto.String(convertFromDifficulty(req.GetDifficulty()))
+	}
+
+	var objectives *[]string
+	if req.LearningObjectives != nil {
+		replaced := append([]string{}, req.GetLearningObjectives().GetObjectives()...)
+		objectives = &replaced
+	}
+
 	updLesson := lessons.UpdateLessonRequest{
 		ID:             req.GetId(),
 		Name:           name,
 		LastModifiedBy: req.GetLastModifiedBy(),
+
+		MaxAttempts:            req.MaxAttempts,
+		AttemptCooldownSeconds: req.AttemptCooldownSeconds,
+		TimeLimitSeconds:       req.TimeLimitSeconds,
+		RequireAllPagesViewed:  req.RequireAllPagesViewed,
+		ShuffleQuestions:       req.ShuffleQuestions,
+		ShuffleOptions:         req.ShuffleOptions,
+
+		Description:              req.Description,
+		EstimatedDurationMinutes: req.EstimatedDurationMinutes,
+		Difficulty:               difficulty,
+		LearningObjectives:       objectives,
+		CoverImageURL:            req.CoverImageUrl,
 	}
 
 	id, err := s.lessonHandlers.UpdateLesson(ctx, updLesson)
@@ -105,6 +175,8 @@ func (s *serverAPI) UpdateLesson(ctx context.Context, req *lpv1.UpdateLessonRequ
 		switch {
 		case errors.Is(err, lessonserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "bad request")
+		case errors.Is(err, lessonserv.ErrLessonExitsts):
+			return nil, status.Error(codes.AlreadyExists, "lesson with this name already in plan")
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -131,3 +203,149 @@ func (s *serverAPI) DeleteLesson(ctx context.Context, req *lpv1.DeleteLessonRequ
 		Success: true,
 	}, nil
 }
+
+func (s *serverAPI) ReorderLessons(ctx context.Context, req *lpv1.ReorderLessonsRequest) (*lpv1.ReorderLessonsResponse, error) {
+	reorder := lessons.ReorderLessons{
+		PlanID:         req.GetPlanId(),
+		OrderedIDs:     req.GetOrderedIds(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.lessonHandlers.ReorderLessons(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, lessonserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "ordered ids must list every lesson of the plan once")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.ReorderLessonsResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) AttachLessonToPlan(ctx context.Context, req *lpv1.AttachLessonToPlanRequest) (*lpv1.AttachLessonToPlanResponse, error) {
+	attach := lessons.LessonInPlan{
+		LessonID:       req.GetLessonId(),
+		PlanID:         req.GetPlanId(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.lessonHandlers.AttachLessonToPlan(ctx, attach)
+	if err != nil {
+		switch {
+		case errors.Is(err, lessonserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, lessonserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, lessonserv.ErrLessonInPlan):
+			return nil, status.Error(codes.AlreadyExists, "lesson already in plan")
+		case errors.Is(err, lessonserv.ErrLessonExitsts):
+			return nil, status.Error(codes.AlreadyExists, "lesson with this name already in plan")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.AttachLessonToPlanResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DetachLessonFromPlan(ctx context.Context, req *lpv1.DetachLessonFromPlanRequest) (*lpv1.DetachLessonFromPlanResponse, error) {
+	detach := lessons.LessonInPlan{
+		LessonID:       req.GetLessonId(),
+		PlanID:         req.GetPlanId(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.lessonHandlers.DetachLessonFromPlan(ctx, detach)
+	if err != nil {
+		switch {
+		case errors.Is(err, lessonserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, lessonserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found in plan")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, lessonserv.ErrLastLessonPlan):
+			return nil, status.Error(codes.FailedPrecondition, "lesson is not in any other plan, delete the lesson instead")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.DetachLessonFromPlanResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) CloneLesson(ctx context.Context, req *lpv1.CloneLessonRequest) (*lpv1.CloneLessonResponse, error) {
+	clone := lessons.CloneLesson{
+		LessonID:  req.GetLessonId(),
+		PlanID:    req.GetPlanId(),
+		Name:      req.GetName(),
+		CreatedBy: req.GetCreatedBy(),
+	}
+
+	id, err := s.lessonHandlers.CloneLesson(ctx, clone)
+	if err != nil {
+		switch {
+		case errors.Is(err, lessonserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, lessonserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, lessonserv.ErrLessonExitsts):
+			return nil, status.Error(codes.AlreadyExists, "lesson with this name already in plan")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.CloneLessonResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *serverAPI) MoveLesson(ctx context.Context, req *lpv1.MoveLessonRequest) (*lpv1.MoveLessonResponse, error) {
+	if req.GetFromPlanId() == req.GetToPlanId() {
+		return nil, status.Error(codes.InvalidArgument, "lesson must be moved to another plan")
+	}
+
+	move := lessons.MoveLesson{
+		LessonID:       req.GetLessonId(),
+		FromPlanID:     req.GetFromPlanId(),
+		ToPlanID:       req.GetToPlanId(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.lessonHandlers.MoveLesson(ctx, move)
+	if err != nil {
+		switch {
+		case errors.Is(err, lessonserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, lessonserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found in plan")
+		case errors.Is(err, lessonserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, lessonserv.ErrLessonInPlan):
+			return nil, status.Error(codes.AlreadyExists, "lesson already in plan")
+		case errors.Is(err, lessonserv.ErrLessonExitsts):
+			return nil, status.Error(codes.AlreadyExists, "lesson with this name already in plan")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.MoveLessonResponse{
+		Success: true,
+	}, nil
+}
@@ -23,6 +23,7 @@ func (s *serverAPI) CreatePage(ctx context.Context, req *lpv1.CreatePageRequest)
 				LessonID:       pageReq.ImagePage.Base.LessonId,
 				CreatedBy:      pageReq.ImagePage.Base.CreatedBy,
 				LastModifiedBy: pageReq.ImagePage.Base.LastModifiedBy,
+				Position:       pageReq.ImagePage.Base.Position,
 				ContentType:    "image",
 			},
 			ImageFileUrl: pageReq.ImagePage.ImageFileUrl,
@@ -34,6 +35,7 @@ func (s *serverAPI) CreatePage(ctx context.Context, req *lpv1.CreatePageRequest)
 				LessonID:       pageReq.VideoPage.Base.LessonId,
 				CreatedBy:      pageReq.VideoPage.Base.CreatedBy,
 				LastModifiedBy: pageReq.VideoPage.Base.LastModifiedBy,
+				Position:       pageReq.VideoPage.Base.Position,
 				ContentType:    "video",
 			},
 			VideoFileUrl: pageReq.VideoPage.VideoFileUrl,
@@ -45,6 +47,7 @@ func (s *serverAPI) CreatePage(ctx context.Context, req *lpv1.CreatePageRequest)
 				LessonID:       pageReq.PdfPage.Base.LessonId,
 				CreatedBy:      pageReq.PdfPage.Base.CreatedBy,
 				LastModifiedBy: pageReq.PdfPage.Base.LastModifiedBy,
+				Position:       pageReq.PdfPage.Base.Position,
 				ContentType:    "pdf",
 			},
 			PdfFileUrl: pageReq.PdfPage.PdfFileUrl,
@@ -56,7 +59,14 @@ func (s *serverAPI) CreatePage(ctx context.Context, req *lpv1.CreatePageRequest)
 
 	pageID, err := s.pageHandlers.CreatePage(ctx, page)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create page: %v", err)
+		switch {
+		case errors.Is(err, pageserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		case errors.Is(err, pageserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to create page: %v", err)
+		}
 	}
 
 	return &lpv1.CreatePageResponse{
@@ -96,6 +106,7 @@ func (s *serverAPI) GetPage(ctx context.Context, req *lpv1.GetPageRequest) (*lpv
 					CreatedAt:      timestamppb.New(p.CreatedAt),
 					Modified:       timestamppb.New(p.Modified),
 					ContentType:    lpv1.ContentType_IMAGE,
+					Position:       p.Position,
 				},
 				ImageFileUrl: p.ImageFileUrl,
 				ImageName:    p.ImageName,
@@ -112,6 +123,7 @@ func (s *serverAPI) GetPage(ctx context.Context, req *lpv1.GetPageRequest) (*lpv
 					CreatedAt:      timestamppb.New(p.CreatedAt),
 					Modified:       timestamppb.New(p.Modified),
 					ContentType:    lpv1.ContentType_VIDEO,
+					Position:       p.Position,
 				},
 				VideoFileUrl: p.VideoFileUrl,
 				VideoName:    p.VideoName,
@@ -128,6 +140,7 @@ func (s *serverAPI) GetPage(ctx context.Context, req *lpv1.GetPageRequest) (*lpv
 					CreatedAt:      timestamppb.New(p.CreatedAt),
 					Modified:       timestamppb.New(p.Modified),
 					ContentType:    lpv1.ContentType_PDF,
+					Position:       p.Position,
 				},
 				PdfFileUrl: p.PdfFileUrl,
 				PdfName:    p.PdfName,
@@ -163,6 +176,7 @@ func (s *serverAPI) GetPages(ctx context.Context, req *lpv1.GetPagesRequest) (*l
 			CreatedAt:      timestamppb.New(page.CreatedAt),
 			Modified:       timestamppb.New(page.Modified),
 			ContentType:    convertToContentType(page.ContentType),
+			Position:       page.Position,
 		})
 	}
 
@@ -242,6 +256,62 @@ func (s *serverAPI) DeletePage(ctx context.Context, req *lpv1.DeletePageRequest)
 	}, nil
 }
 
+func (s *serverAPI) ReorderPages(ctx context.Context, req *lpv1.ReorderPagesRequest) (*lpv1.ReorderPagesResponse, error) {
+	reorder := pagestore.ReorderPages{
+		LessonID:       req.GetLessonId(),
+		OrderedIDs:     req.GetOrderedIds(),
+		Version:        req.GetVersion(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	version, err := s.pageHandlers.ReorderPages(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, pageserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "ordered ids must list every page of the lesson once")
+		case errors.Is(err, pageserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, pageserv.ErrPagesOrderChanged):
+			return nil, status.Error(codes.Aborted, "page order changed, reload pages and retry")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.ReorderPagesResponse{
+		Version: version,
+	}, nil
+}
+
+func (s *serverAPI) MovePage(ctx context.Context, req *lpv1.MovePageRequest) (*lpv1.MovePageResponse, error) {
+	move := pagestore.MovePage{
+		PageID:         req.GetPageId(),
+		TargetLessonID: req.GetTargetLessonId(),
+		Position:       req.Position,
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.pageHandlers.MovePage(ctx, move)
+	if err != nil {
+		switch {
+		case errors.Is(err, pageserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, pageserv.ErrPageNotFound):
+			return nil, status.Error(codes.NotFound, "page not found")
+		case errors.Is(err, pageserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		case errors.Is(err, pageserv.ErrPagesOrderChanged):
+			return nil, status.Error(codes.Aborted, "page moved concurrently, retry")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.MovePageResponse{
+		Success: true,
+	}, nil
+}
+
 func ContentTypeToString(contentType lpv1.ContentType) (string, error) {
 	switch contentType {
 	case lpv1.ContentType_IMAGE:
@@ -263,6 +333,8 @@ func convertToContentType(contentTypeStr string) lpv1.ContentType {
 		return lpv1.ContentType_VIDEO
 	case "pdf":
 		return lpv1.ContentType_PDF
+	case "question":
+		return lpv1.ContentType_QUESTION
 	default:
 		return lpv1.ContentType_CONTENT_TYPE_UNSPECIFIED
 	}
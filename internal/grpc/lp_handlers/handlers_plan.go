@@ -24,11 +24,14 @@ func (s *serverAPI) CreatePlan(ctx context.Context, req *lpv1.CreatePlanRequest)
 
 	planID, err := s.planHandlers.CreatePlan(ctx, plan)
 	if err != nil {
-		if errors.Is(err, planserv.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, planserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &lpv1.CreatePlanResponse{
@@ -155,3 +158,112 @@ func (s *serverAPI) DeletePlan(ctx context.Context, req *lpv1.DeletePlanRequest)
 		Success: true,
 	}, nil
 }
+
+func (s *serverAPI) ReorderPlans(ctx context.Context, req *lpv1.ReorderPlansRequest) (*lpv1.ReorderPlansResponse, error) {
+	reorder := plans.ReorderPlans{
+		ChannelID:      req.GetChannelId(),
+		OrderedIDs:     req.GetOrderedIds(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.planHandlers.ReorderPlans(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "ordered ids must list every plan of the channel once")
+		case errors.Is(err, planserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.ReorderPlansResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) AttachPlanToChannel(ctx context.Context, req *lpv1.AttachPlanToChannelRequest) (*lpv1.AttachPlanToChannelResponse, error) {
+	attach := plans.PlanInChannel{
+		PlanID:         req.GetPlanId(),
+		ChannelID:      req.GetChannelId(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.planHandlers.AttachPlanToChannel(ctx, attach)
+	if err != nil {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, planserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, planserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		case errors.Is(err, planserv.ErrPlanInChannel):
+			return nil, status.Error(codes.AlreadyExists, "plan already in channel")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.AttachPlanToChannelResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DetachPlanFromChannel(ctx context.Context, req *lpv1.DetachPlanFromChannelRequest) (*lpv1.DetachPlanFromChannelResponse, error) {
+	detach := plans.PlanInChannel{
+		PlanID:         req.GetPlanId(),
+		ChannelID:      req.GetChannelId(),
+		LastModifiedBy: req.GetLastModifiedBy(),
+	}
+
+	err := s.planHandlers.DetachPlanFromChannel(ctx, detach)
+	if err != nil {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, planserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found in channel")
+		case errors.Is(err, planserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		case errors.Is(err, planserv.ErrLastPlanChannel):
+			return nil, status.Error(codes.FailedPrecondition, "plan is not in any other channel, delete the plan instead")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.DetachPlanFromChannelResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ClonePlan(ctx context.Context, req *lpv1.ClonePlanRequest) (*lpv1.ClonePlanResponse, error) {
+	clone := plans.ClonePlan{
+		PlanID:    req.GetPlanId(),
+		Name:      req.GetName(),
+		ChannelID: req.GetChannelId(),
+		CreatedBy: req.GetCreatedBy(),
+	}
+
+	id, err := s.planHandlers.ClonePlan(ctx, clone)
+	if err != nil {
+		switch {
+		case errors.Is(err, planserv.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, planserv.ErrPlanNotFound):
+			return nil, status.Error(codes.NotFound, "plan not found")
+		case errors.Is(err, planserv.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, "channel not found")
+		case errors.Is(err, planserv.ErrPlanExitsts):
+			return nil, status.Error(codes.AlreadyExists, "plan with this name already exists")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &lpv1.ClonePlanResponse{
+		Id: id,
+	}, nil
+}
@@ -3,11 +3,9 @@ package lp_handlers
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	questionserv "github.com/DimTur/lp_learning_platform/internal/services/question"
 	questionstore "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
-	"github.com/DimTur/lp_learning_platform/internal/utils"
 	lpv1 "github.com/DimTur/lp_learning_platform/pkg/server/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,35 +13,74 @@ import (
 )
 
 func (s *serverAPI) CreateQuestionPage(ctx context.Context, req *lpv1.CreateQuestionPageRequest) (*lpv1.CreateQuestionPageResponse, error) {
-	if err := utils.ValidateCreateOptions(req); err != nil {
-		return nil, err
-	}
-
 	page := questionstore.CreateQuestionPage{
 		LessonID:       req.LessonId,
 		CreatedBy:      req.CreatedBy,
 		LastModifiedBy: req.LastModifiedBy,
 		ContentType:    "question",
-		QuestionType:   "multichoice",
+		Position:       req.Position,
 		Question:       req.Question,
-		OptionA:        req.OptionA,
-		OptionB:        req.OptionB,
-		OptionC:        req.GetOptionC(),
-		OptionD:        req.GetOptionD(),
-		OptionE:        req.GetOptionE(),
-		Answer:         req.Answer.String(),
+		Explanation:    req.GetExplanation(),
+		Options:        convertFromOptions(req.GetOptions()),
 	}
 
-	answer := req.GetAnswer()
-	fmt.Println(answer)
+	switch details := req.GetDetails().(type) {
+	case *lpv1.CreateQuestionPageRequest_ShortAnswer:
+		page.QuestionType = questionstore.QuestionTypeShortAnswer
+		page.ShortAnswer = &questionstore.ShortAnswerQuestion{
+			AcceptedAnswers: details.ShortAnswer.GetAcceptedAnswers(),
+			MatchMode:       convertFromMatchMode(details.ShortAnswer.GetMatchMode()),
+			TrimWhitespace:  details.ShortAnswer.GetTrimWhitespace(),
+		}
+	case *lpv1.CreateQuestionPageRequest_MultiSelect:
+		page.QuestionType = questionstore.QuestionTypeMultiSelect
+		page.MultiSelect = &questionstore.MultiSelectQuestion{
+			GradingMode: convertFromGradingMode(details.MultiSelect.GetGradingMode()),
+		}
+	case *lpv1.CreateQuestionPageRequest_TrueFalse:
+		page.QuestionType = questionstore.QuestionTypeTrueFalse
+		page.TrueFalse = &questionstore.TrueFalseQuestion{
+			Answer: details.TrueFalse.GetAnswer(),
+		}
+	case *lpv1.CreateQuestionPageRequest_Numeric:
+		page.QuestionType = questionstore.QuestionTypeNumeric
+		page.Numeric = &questionstore.NumericQuestion{
+			Answer:    details.Numeric.Answer,
+			Tolerance: details.Numeric.GetTolerance(),
+			MinValue:  details.Numeric.MinValue,
+			MaxValue:  details.Numeric.MaxValue,
+		}
+	case *lpv1.CreateQuestionPageRequest_FillBlanks:
+		page.QuestionType = questionstore.QuestionTypeFillBlanks
+		page.FillBlanks = &questionstore.FillBlanksQuestion{
+			Blanks:         convertFromFillBlanks(details.FillBlanks.GetBlanks()),
+			MatchMode:      convertFromMatchMode(details.FillBlanks.GetMatchMode()),
+			TrimWhitespace: details.FillBlanks.GetTrimWhitespace(),
+		}
+	case *lpv1.CreateQuestionPageRequest_Ordering:
+		page.QuestionType = questionstore.QuestionTypeOrdering
+		page.Ordering = &questionstore.OrderingQuestion{
+			Items: details.Ordering.GetItems(),
+		}
+	case *lpv1.CreateQuestionPageRequest_Matching:
+		page.QuestionType = questionstore.QuestionTypeMatching
+		page.Matching = &questionstore.MatchingQuestion{
+			Pairs: convertFromMatchingPairs(details.Matching.GetPairs()),
+		}
+	default:
+		page.QuestionType = questionstore.QuestionTypeMultichoice
+	}
 
 	pageID, err := s.questionHandlers.CreateQuestionPage(ctx, page)
 	if err != nil {
-		if errors.Is(err, questionserv.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, questionserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, questionserv.ErrLessonNotFound):
+			return nil, status.Error(codes.NotFound, "lesson not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &lpv1.CreateQuestionPageResponse{
@@ -52,7 +89,18 @@ func (s *serverAPI) CreateQuestionPage(ctx context.Context, req *lpv1.CreateQues
 }
 
 func (s *serverAPI) GetQuestionPage(ctx context.Context, req *lpv1.GetQuestionPageRequest) (*lpv1.GetQuestionPageResponse, error) {
-	page, err := s.questionHandlers.GetQuestionPageByID(ctx, req.GetId())
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Learners see correct answers only after they have answered the question.
+	var page questionstore.QuestionPage
+	if caller.isAuthor() {
+		page, err = s.questionHandlers.GetQuestionPageByID(ctx, req.GetId())
+	} else {
+		page, err = s.questionHandlers.GetLearnerQuestionPage(ctx, req.GetId(), caller.userID)
+	}
 	if err != nil {
 		if errors.Is(err, questionserv.ErrPageNotFound) {
 			return nil, status.Error(codes.NotFound, "page not found")
@@ -62,43 +110,104 @@ func (s *serverAPI) GetQuestionPage(ctx context.Context, req *lpv1.GetQuestionPa
 	}
 
 	return &lpv1.GetQuestionPageResponse{
-		QuestionPage: &lpv1.QuestionPage{
-			Id:             page.ID,
-			LessonId:       page.LessonID,
-			CreatedBy:      page.CreatedBy,
-			LastModifiedBy: page.LastModifiedBy,
-			CreatedAt:      timestamppb.New(page.CreatedAt),
-			Modified:       timestamppb.New(page.Modified),
-			ContentType:    lpv1.ContentType_QUESTION,
-			QuestionType:   lpv1.QuestionType_MULTICHOICE,
-			Question:       page.Question,
-			OptionA:        page.OptionA,
-			OptionB:        page.OptionB,
-			OptionC:        page.OptionC,
-			OptionD:        page.OptionD,
-			OptionE:        page.OptionE,
-			Answer:         page.Answer,
-		},
+		QuestionPage: toQuestionPageResponse(page),
 	}, nil
 }
 
-func (s *serverAPI) UpdateQuestionPage(ctx context.Context, req *lpv1.UpdateQuestionPageRequest) (*lpv1.UpdateQuestionPageResponse, error) {
-	if err := utils.ValidateUpdateOptions(req); err != nil {
+func (s *serverAPI) GetQuestionPages(ctx context.Context, req *lpv1.GetQuestionPagesRequest) (*lpv1.GetQuestionPagesResponse, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	answer := req.GetAnswer().String()
+	var pages []questionstore.QuestionPage
+	if caller.isAuthor() {
+		pages, err = s.questionHandlers.GetQuestionPages(ctx, req.GetLessonId(), req.GetLimit(), req.GetOffset())
+	} else {
+		pages, err = s.questionHandlers.GetLearnerQuestionPages(ctx, req.GetLessonId(), caller.userID, req.GetLimit(), req.GetOffset())
+	}
+	if err != nil {
+		if errors.Is(err, questionserv.ErrInvalidCredentials) {
+			return nil, status.Error(codes.InvalidArgument, "bad request")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var responsePages []*lpv1.QuestionPage
+	for _, page := range pages {
+		responsePages = append(responsePages, toQuestionPageResponse(page))
+	}
+
+	return &lpv1.GetQuestionPagesResponse{
+		QuestionPages: responsePages,
+	}, nil
+}
 
+func (s *serverAPI) UpdateQuestionPage(ctx context.Context, req *lpv1.UpdateQuestionPageRequest) (*lpv1.UpdateQuestionPageResponse, error) {
 	updQuestionPage := questionstore.UpdateQuestionPage{
 		ID:             req.GetId(),
 		LastModifiedBy: req.GetLastModifiedBy(),
 		Question:       req.Question,
-		OptionA:        req.OptionA,
-		OptionB:        req.OptionB,
-		OptionC:        req.OptionC,
-		OptionD:        req.OptionD,
-		OptionE:        req.OptionE,
-		Answer:         &answer,
+		Explanation:    req.Explanation,
+		Options:        convertFromOptions(req.GetOptions()),
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_ShortAnswer); ok {
+		updQuestionPage.ShortAnswer = &questionstore.UpdateShortAnswerQuestion{
+			AcceptedAnswers: details.ShortAnswer.GetAcceptedAnswers(),
+			TrimWhitespace:  details.ShortAnswer.TrimWhitespace,
+		}
+		if details.ShortAnswer.MatchMode != nil {
+			matchMode := convertFromMatchMode(details.ShortAnswer.GetMatchMode())
+			updQuestionPage.ShortAnswer.MatchMode = &matchMode
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_MultiSelect); ok {
+		updQuestionPage.MultiSelect = &questionstore.UpdateMultiSelectQuestion{}
+		if details.MultiSelect.GradingMode != nil {
+			gradingMode := convertFromGradingMode(details.MultiSelect.GetGradingMode())
+			updQuestionPage.MultiSelect.GradingMode = &gradingMode
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_TrueFalse); ok {
+		updQuestionPage.TrueFalse = &questionstore.UpdateTrueFalseQuestion{
+			Answer: details.TrueFalse.Answer,
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_Numeric); ok {
+		updQuestionPage.Numeric = &questionstore.UpdateNumericQuestion{
+			Answer:    details.Numeric.Answer,
+			Tolerance: details.Numeric.Tolerance,
+			MinValue:  details.Numeric.MinValue,
+			MaxValue:  details.Numeric.MaxValue,
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_FillBlanks); ok {
+		updQuestionPage.FillBlanks = &questionstore.UpdateFillBlanksQuestion{
+			Blanks:         convertFromFillBlanks(details.FillBlanks.GetBlanks()),
+			TrimWhitespace: details.FillBlanks.TrimWhitespace,
+		}
+		if details.FillBlanks.MatchMode != nil {
+			matchMode := convertFromMatchMode(details.FillBlanks.GetMatchMode())
+			updQuestionPage.FillBlanks.MatchMode = &matchMode
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_Ordering); ok {
+		updQuestionPage.Ordering = &questionstore.UpdateOrderingQuestion{
+			Items: details.Ordering.GetItems(),
+		}
+	}
+
+	if details, ok := req.GetDetails().(*lpv1.UpdateQuestionPageRequest_Matching); ok {
+		updQuestionPage.Matching = &questionstore.UpdateMatchingQuestion{
+			Pairs: convertFromMatchingPairs(details.Matching.GetPairs()),
+		}
 	}
 
 	id, err := s.questionHandlers.UpdateQuestionPage(ctx, updQuestionPage)
@@ -106,6 +215,10 @@ func (s *serverAPI) UpdateQuestionPage(ctx context.Context, req *lpv1.UpdateQues
 		switch {
 		case errors.Is(err, questionserv.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "bad request")
+		case errors.Is(err, questionserv.ErrPageNotFound):
+			return nil, status.Error(codes.NotFound, "page not found")
+		case errors.Is(err, questionserv.ErrOptionNotFound):
+			return nil, status.Error(codes.NotFound, "option not found")
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -115,3 +228,231 @@ func (s *serverAPI) UpdateQuestionPage(ctx context.Context, req *lpv1.UpdateQues
 		Id: id,
 	}, nil
 }
+
+func (s *serverAPI) DeleteQuestionPage(ctx context.Context, req *lpv1.DeleteQuestionPageRequest) (*lpv1.DeleteQuestionPageResponse, error) {
+	err := s.questionHandlers.DeleteQuestionPage(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, questionserv.ErrPageNotFound) {
+			return nil, status.Error(codes.NotFound, "page not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &lpv1.DeleteQuestionPageResponse{
+		Success: true,
+	}, nil
+}
+
+func toQuestionPageResponse(page questionstore.QuestionPage) *lpv1.QuestionPage {
+	questionPage := &lpv1.QuestionPage{
+		Id:             page.ID,
+		LessonId:       page.LessonID,
+		CreatedBy:      page.CreatedBy,
+		LastModifiedBy: page.LastModifiedBy,
+		CreatedAt:      timestamppb.New(page.CreatedAt),
+		Modified:       timestamppb.New(page.Modified),
+		ContentType:    lpv1.ContentType_QUESTION,
+		Position:       page.Position,
+		QuestionType:   convertToQuestionType(page.QuestionType),
+		Question:       page.Question,
+		Explanation:    page.Explanation,
+		Options:        convertToOptions(page.Options),
+	}
+
+	if page.ShortAnswer != nil {
+		questionPage.Details = &lpv1.QuestionPage_ShortAnswer{
+			ShortAnswer: &lpv1.ShortAnswerQuestion{
+				AcceptedAnswers: page.ShortAnswer.AcceptedAnswers,
+				MatchMode:       convertToMatchMode(page.ShortAnswer.MatchMode),
+				TrimWhitespace:  page.ShortAnswer.TrimWhitespace,
+			},
+		}
+	}
+
+	if page.MultiSelect != nil {
+		questionPage.Details = &lpv1.QuestionPage_MultiSelect{
+			MultiSelect: &lpv1.MultiSelectQuestion{
+				GradingMode: convertToGradingMode(page.MultiSelect.GradingMode),
+			},
+		}
+	}
+
+	if page.TrueFalse != nil {
+		questionPage.Details = &lpv1.QuestionPage_TrueFalse{
+			TrueFalse: &lpv1.TrueFalseQuestion{
+				Answer: page.TrueFalse.Answer,
+			},
+		}
+	}
+
+	if page.Numeric != nil {
+		questionPage.Details = &lpv1.QuestionPage_Numeric{
+			Numeric: &lpv1.NumericQuestion{
+				Answer:    page.Numeric.Answer,
+				Tolerance: page.Numeric.Tolerance,
+				MinValue:  page.Numeric.MinValue,
+				MaxValue:  page.Numeric.MaxValue,
+			},
+		}
+	}
+
+	if page.FillBlanks != nil {
+		questionPage.Details = &lpv1.QuestionPage_FillBlanks{
+			FillBlanks: &lpv1.FillBlanksQuestion{
+				Blanks:         convertToFillBlanks(page.FillBlanks.Blanks),
+				MatchMode:      convertToMatchMode(page.FillBlanks.MatchMode),
+				TrimWhitespace: page.FillBlanks.TrimWhitespace,
+			},
+		}
+	}
+
+	if page.Ordering != nil {
+		questionPage.Details = &lpv1.QuestionPage_Ordering{
+			Ordering: &lpv1.OrderingQuestion{
+				Items: page.Ordering.Items,
+			},
+		}
+	}
+
+	if page.Matching != nil {
+		questionPage.Details = &lpv1.QuestionPage_Matching{
+			Matching: &lpv1.MatchingQuestion{
+				Pairs: convertToMatchingPairs(page.Matching.Pairs),
+			},
+		}
+	}
+
+	return questionPage
+}
+
+func convertToQuestionType(questionTypeStr string) lpv1.QuestionType {
+	switch questionTypeStr {
+	case "multichoice":
+		return lpv1.QuestionType_MULTICHOICE
+	case "short_answer":
+		return lpv1.QuestionType_SHORT_ANSWER
+	case "multiselect":
+		return lpv1.QuestionType_MULTISELECT
+	case "true_false":
+		return lpv1.QuestionType_TRUE_FALSE
+	case "numeric":
+		return lpv1.QuestionType_NUMERIC
+	case "fill_blanks":
+		return lpv1.QuestionType_FILL_BLANKS
+	case "ordering":
+		return lpv1.QuestionType_ORDERING
+	case "matching":
+		return lpv1.QuestionType_MATCHING
+	default:
+		return lpv1.QuestionType_QUESTION_TYPE_UNSPECIFIED
+	}
+}
+
+func convertToMatchMode(matchModeStr string) lpv1.MatchMode {
+	switch matchModeStr {
+	case questionstore.MatchModeExact:
+		return lpv1.MatchMode_MATCH_EXACT
+	case questionstore.MatchModeCaseInsensitive:
+		return lpv1.MatchMode_MATCH_CASE_INSENSITIVE
+	case questionstore.MatchModeRegex:
+		return lpv1.MatchMode_MATCH_REGEX
+	default:
+		return lpv1.MatchMode_MATCH_MODE_UNSPECIFIED
+	}
+}
+
+// convertFromMatchMode maps unspecified mode to exact match.
+func convertFromMatchMode(matchMode lpv1.MatchMode) string {
+	switch matchMode {
+	case lpv1.MatchMode_MATCH_CASE_INSENSITIVE:
+		return questionstore.MatchModeCaseInsensitive
+	case lpv1.MatchMode_MATCH_REGEX:
+		return questionstore.MatchModeRegex
+	default:
+		return questionstore.MatchModeExact
+	}
+}
+
+func convertToGradingMode(gradingModeStr string) lpv1.GradingMode {
+	switch gradingModeStr {
+	case questionstore.GradingModeAllOrNothing:
+		return lpv1.GradingMode_ALL_OR_NOTHING
+	case questionstore.GradingModePartialCredit:
+		return lpv1.GradingMode_PARTIAL_CREDIT
+	case questionstore.GradingModePenalty:
+		return lpv1.GradingMode_PENALTY
+	default:
+		return lpv1.GradingMode_GRADING_MODE_UNSPECIFIED
+	}
+}
+
+// convertFromGradingMode maps unspecified mode to all-or-nothing.
+func convertFromGradingMode(gradingMode lpv1.GradingMode) string {
+	switch gradingMode {
+	case lpv1.GradingMode_PARTIAL_CREDIT:
+		return questionstore.GradingModePartialCredit
+	case lpv1.GradingMode_PENALTY:
+		return questionstore.GradingModePenalty
+	default:
+		return questionstore.GradingModeAllOrNothing
+	}
+}
+
+func convertToOptions(options []questionstore.Option) []*lpv1.Option {
+	var converted []*lpv1.Option
+	for _, option := range options {
+		converted = append(converted, &lpv1.Option{
+			Id:        option.ID,
+			Text:      option.Text,
+			IsCorrect: option.IsCorrect,
+			Feedback:  option.Feedback,
+		})
+	}
+	return converted
+}
+
+func convertFromOptions(options []*lpv1.Option) []questionstore.Option {
+	var converted []questionstore.Option
+	for _, option := range options {
+		converted = append(converted, questionstore.Option{
+			ID:        option.GetId(),
+			Text:      option.GetText(),
+			IsCorrect: option.GetIsCorrect(),
+			Feedback:  option.GetFeedback(),
+		})
+	}
+	return converted
+}
+
+func convertToFillBlanks(blanks []questionstore.FillBlank) []*lpv1.FillBlank {
+	var converted []*lpv1.FillBlank
+	for _, blank := range blanks {
+		converted = append(converted, &lpv1.FillBlank{AcceptedAnswers: blank.AcceptedAnswers})
+	}
+	return converted
+}
+
+func convertFromFillBlanks(blanks []*lpv1.FillBlank) []questionstore.FillBlank {
+	var converted []questionstore.FillBlank
+	for _, blank := range blanks {
+		converted = append(converted, questionstore.FillBlank{AcceptedAnswers: blank.GetAcceptedAnswers()})
+	}
+	return converted
+}
+
+func convertToMatchingPairs(pairs []questionstore.MatchingPair) []*lpv1.MatchingPair {
+	var converted []*lpv1.MatchingPair
+	for _, pair := range pairs {
+		converted = append(converted, &lpv1.MatchingPair{Left: pair.Left, Right: pair.Right})
+	}
+	return converted
+}
+
+func convertFromMatchingPairs(pairs []*lpv1.MatchingPair) []questionstore.MatchingPair {
+	var converted []questionstore.MatchingPair
+	for _, pair := range pairs {
+		converted = append(converted, questionstore.MatchingPair{Left: pair.GetLeft(), Right: pair.GetRight()})
+	}
+	return converted
+}
@@ -5,19 +5,52 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+	"github.com/DimTur/lp_learning_platform/internal/utils"
 	"github.com/go-playground/validator/v10"
 )
 
 type AttemptSaver interface {
 	CreateLessonAttempt(ctx context.Context, lAttempt attempts.CreateLessonAttempt) (int64, error)
+	GetOrCreateLessonAttempt(ctx context.Context, lAttempt attempts.CreateLessonAttempt) (attempts.CreatedLessonAttempt, error)
 	CreateQuestionPageAttempts(ctx context.Context, attempt attempts.CreateQuestionPageAttemptNew) error
+	UpdateQuestionPageAttempt(ctx context.Context, qpAttempt attempts.UpdateQuestionPageAttempt) error
+	CompleteLessonAttempt(ctx context.Context, lAttempt attempts.CompleteLessonAttempt) error
+	LockUserLessonAttempts(ctx context.Context, userID, lessonID int64) error
+	CreateContentPageAttempt(ctx context.Context, attempt attempts.CreateContentPageAttempt) error
+	UpdateContentPageAttempt(ctx context.Context, cpAttempt attempts.UpdateContentPageAttempt) (int64, error)
 }
 
 type AttemptProvider interface {
 	GetQuestionPages(ctx context.Context, lessonID int64) ([]attempts.QuestionPage, error)
+	GetQuestionDraws(ctx context.Context, lessonID int64) ([]attempts.QuestionDraw, error)
+	GetBankQuestionPages(ctx context.Context, draw attempts.QuestionDraw) ([]attempts.QuestionPage, error)
+	GetQuestionPageAttempt(ctx context.Context, lessonAttemptID, pageID int64) (attempts.QuestionPageAttempt, error)
+	GetLessonAttemptByID(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttempt, error)
+	GetQuestionAttemptsStats(ctx context.Context, lessonAttemptID int64) (attempts.QuestionAttemptsStats, error)
+	GetPageAttempts(ctx context.Context, lessonAttemptID int64) ([]attempts.PageAttempt, error)
+	ListLessonAttempts(ctx context.Context, filter attempts.ListAttemptsFilter, limit, offset int64) ([]attempts.LessonAttempt, error)
+	GetAttemptPolicy(ctx context.Context, lessonID int64) (attempts.AttemptPolicy, error)
+	IsLessonInPlan(ctx context.Context, lessonID, planID int64) (bool, error)
+	GetUserLessonAttemptsStats(ctx context.Context, userID, lessonID, planID, channelID int64) (attempts.UserLessonAttemptsStats, error)
+	GetExpiredLessonAttemptIDs(ctx context.Context, userID, lessonID, timeLimitSeconds int64) ([]int64, error)
+	GetContentPages(ctx context.Context, lessonID int64) ([]attempts.ContentPage, error)
+	GetContentPageAttempt(ctx context.Context, lessonAttemptID, pageID int64) (attempts.ContentPageAttempt, error)
+	GetContentPagesViewStats(ctx context.Context, lessonAttemptID int64) (attempts.ContentPagesViewStats, error)
+}
+
+type ProgressProvider interface {
+	GetPlanInfo(ctx context.Context, planID int64) (attempts.PlanInfo, error)
+	GetChannelPlansInfo(ctx context.Context, channelID int64) ([]attempts.PlanInfo, error)
+	GetLessonAttemptsSummaries(ctx context.Context, userID, planID int64, channelID *int64) ([]attempts.LessonAttemptsSummary, error)
+}
+
+type QuestionProvider interface {
+	GetQuestionPageByID(ctx context.Context, pageID int64) (questions.QuestionPage, error)
 }
 
 var (
@@ -26,13 +59,29 @@ var (
 	ErrAttemptExitsts     = errors.New("attempt already exists")
 	ErrAttemptNotFound    = errors.New("attempt not found")
 	ErrFailedToCreate     = errors.New("attempt creation failed")
+	ErrAttemptCompleted   = errors.New("attempt already completed")
+	ErrAlreadyAnswered    = errors.New("question already answered")
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrLessonNotFound     = errors.New("lesson not found")
+	ErrAttemptsExhausted  = errors.New("maximum number of attempts reached")
+	ErrAttemptCooldown    = errors.New("attempt cooldown has not passed")
+	ErrAttemptExpired     = errors.New("attempt time limit exceeded")
+	ErrPagesNotViewed     = errors.New("not all pages were viewed")
+	ErrPlanNotFound       = errors.New("plan not found")
+	ErrChannelNotFound    = errors.New("channel not found")
+	ErrAnswerMismatch     = errors.New("answer does not match question type")
+	ErrNotEnoughQuestions = errors.New("not enough questions in bank")
 )
 
 type AttemptHandlers struct {
-	log             *slog.Logger
-	validator       *validator.Validate
-	attemptSaver    AttemptSaver
-	attemptProvider AttemptProvider
+	log              *slog.Logger
+	validator        *validator.Validate
+	attemptSaver     AttemptSaver
+	attemptProvider  AttemptProvider
+	questionProv     QuestionProvider
+	progressProvider ProgressProvider
+	txManager        storage.TxManager
+	passThreshold    int64
 }
 
 func New(
@@ -40,17 +89,28 @@ func New(
 	validator *validator.Validate,
 	attemptSaver AttemptSaver,
 	attemptProvider AttemptProvider,
+	questionProv QuestionProvider,
+	progressProvider ProgressProvider,
+	txManager storage.TxManager,
+	passThreshold int64,
 ) *AttemptHandlers {
 	return &AttemptHandlers{
-		log:             log,
-		validator:       validator,
-		attemptSaver:    attemptSaver,
-		attemptProvider: attemptProvider,
+		log:              log,
+		validator:        validator,
+		attemptSaver:     attemptSaver,
+		attemptProvider:  attemptProvider,
+		questionProv:     questionProv,
+		progressProvider: progressProvider,
+		txManager:        txManager,
+		passThreshold:    passThreshold,
 	}
 }
 
 // CreateAttempt creates new attempt of the lesson in the system and returns attempt ID.
-func (ah *AttemptHandlers) CreateAttempt(ctx context.Context, attempt attempts.CreateLessonAttempt) (int64, error) {
+// In resume mode the incomplete attempt of the user for the lesson is returned if it exists.
+// The lesson attempt and all its page attempts are created in a single transaction
+// after the attempt policy of the lesson is checked.
+func (ah *AttemptHandlers) CreateAttempt(ctx context.Context, attempt attempts.CreateLessonAttempt) (attempts.CreatedLessonAttempt, error) {
 	const op = "lesson.CreateAttempt"
 
 	log := ah.log.With(
@@ -63,34 +123,145 @@ func (ah *AttemptHandlers) CreateAttempt(ctx context.Context, attempt attempts.C
 	err := ah.validator.Struct(attempt)
 	if err != nil {
 		log.Warn("invalid parameters", slog.String("err", err.Error()))
-		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
-	log.Info("creating attempt")
+	log.Info("creating attempt", slog.Bool("resume", attempt.Resume))
+
+	var created attempts.CreatedLessonAttempt
+	err = ah.txManager.WithTx(ctx, func(ctx context.Context) error {
+		err := ah.attemptSaver.LockUserLessonAttempts(ctx, attempt.UserID, attempt.LessonID)
+		if err != nil {
+			return err
+		}
+
+		// A shared lesson is taken within one of its plans, which the attempt records.
+		inPlan, err := ah.attemptProvider.IsLessonInPlan(ctx, attempt.LessonID, attempt.PlanId)
+		if err != nil {
+			return err
+		}
+		if !inPlan {
+			return fmt.Errorf("lesson %d is not in plan %d: %w", attempt.LessonID, attempt.PlanId, storage.ErrLessonNotFound)
+		}
+
+		err = ah.checkAttemptPolicy(ctx, attempt)
+		if err != nil {
+			return err
+		}
 
-	lAttemptID, err := ah.attemptSaver.CreateLessonAttempt(ctx, attempt)
+		if attempt.Resume {
+			created, err = ah.attemptSaver.GetOrCreateLessonAttempt(ctx, attempt)
+		} else {
+			created.ID, err = ah.attemptSaver.CreateLessonAttempt(ctx, attempt)
+		}
+		if err != nil {
+			return err
+		}
+
+		if created.Resumed {
+			return nil
+		}
+
+		return ah.createPageAttempts(ctx, created.ID, attempt.LessonID)
+	})
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			ah.log.Warn("invalid arguments", slog.String("err", err.Error()))
-			return 0, fmt.Errorf("%s: %w", op, err)
+			return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrAttemptExitsts):
+			ah.log.Warn("incomplete attempt already exists", slog.String("err", err.Error()))
+			return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, ErrAttemptExitsts)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			ah.log.Warn("lesson not found", slog.String("err", err.Error()))
+			return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, ErrAttemptsExhausted), errors.Is(err, ErrAttemptCooldown), errors.Is(err, ErrNotEnoughQuestions):
+			log.Warn("attempt policy violated", slog.String("err", err.Error()))
+			return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrPageNotFound), errors.Is(err, storage.ErrScanFailed):
+			ah.log.Warn("question pages not found", slog.String("err", err.Error()))
+			return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, ErrFailedToCreate)
 		}
 
-		log.Error("failed to save lesson attempt", slog.String("err", err.Error()))
-		return 0, fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to save attempt", slog.String("err", err.Error()))
+		return attempts.CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if created.Resumed {
+		log.Info("attempt resumed", slog.Int64("lesson attempt id", created.ID))
 	}
 
-	var qPages []attempts.QuestionPage
-	qPages, err = ah.attemptProvider.GetQuestionPages(ctx, attempt.LessonID)
+	return created, nil
+}
+
+// checkAttemptPolicy closes attempts of the user which ran out of time and checks
+// the attempts limit and the cooldown of the lesson. Resuming an open attempt of the
+// same plan and channel is always allowed, an open attempt elsewhere does not count.
+func (ah *AttemptHandlers) checkAttemptPolicy(ctx context.Context, attempt attempts.CreateLessonAttempt) error {
+	policy, err := ah.attemptProvider.GetAttemptPolicy(ctx, attempt.LessonID)
 	if err != nil {
-		if errors.Is(err, storage.ErrPageNotFound) || errors.Is(err, storage.ErrScanFailed) {
-			ah.log.Warn("question pages not found", slog.String("err", err.Error()))
-			return 0, fmt.Errorf("%s: %w", op, ErrFailedToCreate)
+		return err
+	}
+
+	if policy.TimeLimitSeconds > 0 {
+		expiredIDs, err := ah.attemptProvider.GetExpiredLessonAttemptIDs(ctx, attempt.UserID, attempt.LessonID, policy.TimeLimitSeconds)
+		if err != nil {
+			return err
 		}
 
-		log.Error("failed to get question pages", slog.String("err", err.Error()))
-		return 0, fmt.Errorf("%s: %w", op, err)
+		for _, id := range expiredIDs {
+			if _, err := ah.completeAttempt(ctx, id); err != nil {
+				return err
+			}
+			ah.log.Info("expired attempt closed", slog.Int64("lesson attempt id", id))
+		}
 	}
 
+	stats, err := ah.attemptProvider.GetUserLessonAttemptsStats(ctx, attempt.UserID, attempt.LessonID, attempt.PlanId, attempt.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	if attempt.Resume && stats.Open > 0 {
+		return nil
+	}
+
+	if policy.MaxAttempts > 0 && stats.Total >= policy.MaxAttempts {
+		return ErrAttemptsExhausted
+	}
+
+	if policy.AttemptCooldownSeconds > 0 && !stats.LastEndTime.IsZero() {
+		cooldown := time.Duration(policy.AttemptCooldownSeconds) * time.Second
+		if wait := cooldown - time.Since(stats.LastEndTime); wait > 0 {
+			return fmt.Errorf("%w: retry in %s", ErrAttemptCooldown, wait.Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+// createPageAttempts creates page attempts for every content page of the lesson
+// and for its question pages or the questions drawn from banks.
+// The order of pages and question options is fixed for the attempt here,
+// shuffled if the lesson asks for it, so a resumed attempt keeps the same order.
+func (ah *AttemptHandlers) createPageAttempts(ctx context.Context, lAttemptID, lessonID int64) error {
+	policy, err := ah.attemptProvider.GetAttemptPolicy(ctx, lessonID)
+	if err != nil {
+		return err
+	}
+
+	cPages, err := ah.attemptProvider.GetContentPages(ctx, lessonID)
+	if err != nil {
+		return err
+	}
+
+	qPages, err := ah.lessonQuestionPages(ctx, lessonID, cPages)
+	if err != nil {
+		return err
+	}
+
+	positions := pagePositions(qPages, cPages, policy.ShuffleQuestions)
+
 	for _, qPage := range qPages {
 		err := ah.attemptSaver.CreateQuestionPageAttempts(
 			ctx,
@@ -98,24 +269,444 @@ func (ah *AttemptHandlers) CreateAttempt(ctx context.Context, attempt attempts.C
 				CreateAbstractPageAttempt: attempts.CreateAbstractPageAttempt{
 					LessonAttemptID: lAttemptID,
 					ContentType:     qPage.ContentType,
+					Position:        positions[qPage.PageID],
 				},
 				CreateAbstractQuestionAttempt: attempts.CreateAbstractQuestionAttempt{
 					QuestionType: qPage.QuestionType,
 				},
 				CreateQuestionPageAttempt: attempts.CreateQuestionPageAttempt{
-					PageID: qPage.QuestionPageID,
+					PageID:      qPage.QuestionPageID,
+					OptionOrder: optionOrder(qPage.OptionIDs, policy.ShuffleOptions),
+					Draw:        qPage.Draw,
 				},
 			},
 		)
 		if err != nil {
-			if errors.Is(err, storage.ErrInvalidCredentials) {
-				ah.log.Warn("invalid arguments", slog.String("err", err.Error()))
-				return 0, fmt.Errorf("%s: %w", op, err)
+			return err
+		}
+	}
+
+	for _, cPage := range cPages {
+		err := ah.attemptSaver.CreateContentPageAttempt(
+			ctx,
+			attempts.CreateContentPageAttempt{
+				CreateAbstractPageAttempt: attempts.CreateAbstractPageAttempt{
+					LessonAttemptID: lAttemptID,
+					ContentType:     cPage.ContentType,
+					Position:        positions[cPage.PageID],
+				},
+				PageID: cPage.PageID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SubmitQuestionAnswer saves user answer for the question page within the lesson attempt,
+// checks it against the correct answer and returns the result. A question can be
+// answered once per attempt.
+func (ah *AttemptHandlers) SubmitQuestionAnswer(ctx context.Context, answer attempts.SubmitQuestionAnswer) (attempts.QuestionAnswerResult, error) {
+	const op = "attempt.SubmitQuestionAnswer"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson attempt id", answer.LessonAttemptID),
+		slog.Int64("page id", answer.PageID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(answer)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return attempts.QuestionAnswerResult{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("submitting answer")
+
+	var (
+		result  attempts.QuestionAnswerResult
+		expired bool
+	)
+	err = ah.txManager.WithTx(ctx, func(ctx context.Context) error {
+		qpAttempt, err := ah.attemptProvider.GetQuestionPageAttempt(ctx, answer.LessonAttemptID, answer.PageID)
+		if err != nil {
+			if errors.Is(err, storage.ErrAttemptNotFound) {
+				ah.log.Warn("question page attempt not found", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, ErrAttemptNotFound)
+			}
+
+			log.Error("failed to get question page attempt", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if qpAttempt.IsComplete {
+			log.Warn("attempt already completed")
+			return fmt.Errorf("%s: %w", op, ErrAttemptCompleted)
+		}
+
+		// The explanation and feedback are returned with the first answer,
+		// so the answer can't be changed afterwards.
+		if qpAttempt.UserAnswer != "" {
+			log.Warn("question already answered")
+			return fmt.Errorf("%s: %w", op, ErrAlreadyAnswered)
+		}
+
+		expired, err = ah.isAttemptExpired(ctx, qpAttempt.LessonAttemptID)
+		if err != nil {
+			log.Error("failed to check attempt time limit", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if expired {
+			// The attempt is closed with the answers given in time, so the
+			// transaction must be committed before the error is returned.
+			if _, err := ah.completeAttempt(ctx, qpAttempt.LessonAttemptID); err != nil {
+				log.Error("failed to close expired attempt", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			return nil
+		}
+
+		qPage, err := ah.questionProv.GetQuestionPageByID(ctx, answer.PageID)
+		if err != nil {
+			if errors.Is(err, storage.ErrPageNotFound) {
+				ah.log.Warn("question page not found", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, ErrQuestionNotFound)
 			}
 
-			log.Error("failed to save attempt", slog.String("err", err.Error()))
-			return 0, fmt.Errorf("%s: %w", op, err)
+			log.Error("failed to get question page", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		userAnswer, score, err := gradeAnswer(qPage, answer)
+		if err != nil {
+			log.Warn("failed to grade answer", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
 		}
+		isSuccessful := score == maxScore
+
+		err = ah.attemptSaver.UpdateQuestionPageAttempt(ctx, attempts.UpdateQuestionPageAttempt{
+			ID:                qpAttempt.ID,
+			QuestionAttemptID: qpAttempt.QuestionAttemptID,
+			PageAttemptID:     qpAttempt.PageAttemptID,
+			UserAnswer:        userAnswer,
+			IsSuccessful:      isSuccessful,
+			Score:             score,
+		})
+		if err != nil {
+			log.Error("failed to save answer", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		result = attempts.QuestionAnswerResult{
+			ID:           qpAttempt.ID,
+			IsSuccessful: isSuccessful,
+			Score:        score,
+			Explanation:  qPage.Explanation,
+			Feedback:     answerFeedback(qPage, answer),
+		}
+		return nil
+	})
+	if err != nil {
+		return attempts.QuestionAnswerResult{}, err
+	}
+
+	if expired {
+		log.Warn("attempt time limit exceeded, attempt closed")
+		return attempts.QuestionAnswerResult{}, fmt.Errorf("%s: %w", op, ErrAttemptExpired)
+	}
+
+	log.Info("answer submitted", slog.Bool("is successful", result.IsSuccessful))
+
+	return result, nil
+}
+
+// CompleteAttempt closes the lesson attempt, computes its percentage score
+// from the graded question attempts and compares it to the pass threshold.
+func (ah *AttemptHandlers) CompleteAttempt(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttemptResult, error) {
+	const op = "attempt.CompleteAttempt"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson attempt id", lessonAttemptID),
+	)
+
+	log.Info("completing attempt")
+
+	var result attempts.LessonAttemptResult
+	err := ah.txManager.WithTx(ctx, func(ctx context.Context) error {
+		lAttempt, err := ah.attemptProvider.GetLessonAttemptByID(ctx, lessonAttemptID)
+		if err != nil {
+			if errors.Is(err, storage.ErrAttemptNotFound) {
+				ah.log.Warn("attempt not found", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, ErrAttemptNotFound)
+			}
+
+			log.Error("failed to get attempt", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if lAttempt.IsComplete {
+			log.Warn("attempt already completed")
+			return fmt.Errorf("%s: %w", op, ErrAttemptCompleted)
+		}
+
+		allViewed, err := ah.allPagesViewed(ctx, lAttempt)
+		if err != nil {
+			log.Error("failed to check viewed pages", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !allViewed {
+			log.Warn("not all pages were viewed")
+			return fmt.Errorf("%s: %w", op, ErrPagesNotViewed)
+		}
+
+		result, err = ah.completeAttempt(ctx, lessonAttemptID)
+		if err != nil {
+			if errors.Is(err, storage.ErrAttemptCompleted) {
+				ah.log.Warn("attempt already completed", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, ErrAttemptCompleted)
+			}
+
+			log.Error("failed to complete attempt", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return attempts.LessonAttemptResult{}, err
+	}
+
+	log.Info("attempt completed", slog.Int64("score", result.PercentageScore))
+
+	return result, nil
+}
+
+// MarkPageViewed marks the content page as viewed within the lesson attempt
+// and saves the progress on it if given.
+func (ah *AttemptHandlers) MarkPageViewed(ctx context.Context, viewed attempts.MarkPageViewed) (attempts.ContentPageAttempt, error) {
+	const op = "attempt.MarkPageViewed"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson attempt id", viewed.LessonAttemptID),
+		slog.Int64("page id", viewed.PageID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(viewed)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return attempts.ContentPageAttempt{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("marking page as viewed")
+
+	var (
+		cpAttempt attempts.ContentPageAttempt
+		expired   bool
+	)
+	err = ah.txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		cpAttempt, err = ah.attemptProvider.GetContentPageAttempt(ctx, viewed.LessonAttemptID, viewed.PageID)
+		if err != nil {
+			if errors.Is(err, storage.ErrAttemptNotFound) {
+				ah.log.Warn("content page attempt not found", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, ErrAttemptNotFound)
+			}
+
+			log.Error("failed to get content page attempt", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if cpAttempt.IsComplete {
+			log.Warn("attempt already completed")
+			return fmt.Errorf("%s: %w", op, ErrAttemptCompleted)
+		}
+
+		expired, err = ah.isAttemptExpired(ctx, cpAttempt.LessonAttemptID)
+		if err != nil {
+			log.Error("failed to check attempt time limit", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if expired {
+			if _, err := ah.completeAttempt(ctx, cpAttempt.LessonAttemptID); err != nil {
+				log.Error("failed to close expired attempt", slog.String("err", err.Error()))
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			return nil
+		}
+
+		cpAttempt.Progress, err = ah.attemptSaver.UpdateContentPageAttempt(ctx, attempts.UpdateContentPageAttempt{
+			ID:            cpAttempt.ID,
+			PageAttemptID: cpAttempt.PageAttemptID,
+			Progress:      viewed.Progress,
+		})
+		if err != nil {
+			log.Error("failed to save page view", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		cpAttempt.IsViewed = true
+
+		return nil
+	})
+	if err != nil {
+		return attempts.ContentPageAttempt{}, err
+	}
+
+	if expired {
+		log.Warn("attempt time limit exceeded, attempt closed")
+		return attempts.ContentPageAttempt{}, fmt.Errorf("%s: %w", op, ErrAttemptExpired)
+	}
+
+	log.Info("page marked as viewed", slog.Int64("progress", cpAttempt.Progress))
+
+	return cpAttempt, nil
+}
+
+// completeAttempt computes the score of the lesson attempt from the graded
+// question attempts and closes it.
+func (ah *AttemptHandlers) completeAttempt(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttemptResult, error) {
+	stats, err := ah.attemptProvider.GetQuestionAttemptsStats(ctx, lessonAttemptID)
+	if err != nil {
+		return attempts.LessonAttemptResult{}, err
+	}
+
+	score := percentageScore(stats)
+
+	completed := attempts.CompleteLessonAttempt{
+		ID:              lessonAttemptID,
+		IsSuccessful:    score >= ah.passThreshold,
+		PercentageScore: score,
+	}
+
+	err = ah.attemptSaver.CompleteLessonAttempt(ctx, completed)
+	if err != nil {
+		return attempts.LessonAttemptResult{}, err
+	}
+
+	return attempts.LessonAttemptResult{
+		ID:              lessonAttemptID,
+		PercentageScore: completed.PercentageScore,
+		IsSuccessful:    completed.IsSuccessful,
+	}, nil
+}
+
+// allPagesViewed reports whether the lesson attempt satisfies the viewing requirement of the lesson.
+func (ah *AttemptHandlers) allPagesViewed(ctx context.Context, lAttempt attempts.LessonAttempt) (bool, error) {
+	policy, err := ah.attemptProvider.GetAttemptPolicy(ctx, lAttempt.LessonID)
+	if err != nil {
+		return false, err
+	}
+
+	if !policy.RequireAllPagesViewed {
+		return true, nil
+	}
+
+	stats, err := ah.attemptProvider.GetContentPagesViewStats(ctx, lAttempt.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return stats.Viewed == stats.Total, nil
+}
+
+// isAttemptExpired reports whether the time limit of the lesson is exceeded for the attempt.
+func (ah *AttemptHandlers) isAttemptExpired(ctx context.Context, lessonAttemptID int64) (bool, error) {
+	lAttempt, err := ah.attemptProvider.GetLessonAttemptByID(ctx, lessonAttemptID)
+	if err != nil {
+		return false, err
+	}
+
+	policy, err := ah.attemptProvider.GetAttemptPolicy(ctx, lAttempt.LessonID)
+	if err != nil {
+		return false, err
+	}
+
+	if policy.TimeLimitSeconds == 0 {
+		return false, nil
+	}
+
+	timeLimit := time.Duration(policy.TimeLimitSeconds) * time.Second
+	return time.Since(lAttempt.StartTime) > timeLimit, nil
+}
+
+// GetAttempt gets lesson attempt by ID together with its page attempts and returns it.
+func (ah *AttemptHandlers) GetAttempt(ctx context.Context, lessonAttemptID int64) (attempts.LessonAttemptWithPages, error) {
+	const op = "attempt.GetAttempt"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson attempt id", lessonAttemptID),
+	)
+
+	log.Info("getting attempt")
+
+	var lAttempt attempts.LessonAttemptWithPages
+	var err error
+	lAttempt.LessonAttempt, err = ah.attemptProvider.GetLessonAttemptByID(ctx, lessonAttemptID)
+	if err != nil {
+		if errors.Is(err, storage.ErrAttemptNotFound) {
+			ah.log.Warn("attempt not found", slog.String("err", err.Error()))
+			return lAttempt, fmt.Errorf("%s: %w", op, ErrAttemptNotFound)
+		}
+
+		log.Error("failed to get attempt", slog.String("err", err.Error()))
+		return lAttempt, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lAttempt.PageAttempts, err = ah.attemptProvider.GetPageAttempts(ctx, lessonAttemptID)
+	if err != nil {
+		log.Error("failed to get page attempts", slog.String("err", err.Error()))
+		return lAttempt, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lAttempt, nil
+}
+
+// ListAttempts gets lesson attempts matching the filter and returns them.
+func (ah *AttemptHandlers) ListAttempts(ctx context.Context, filter attempts.ListAttemptsFilter, limit, offset int64) ([]attempts.LessonAttempt, error) {
+	const op = "attempt.ListAttempts"
+
+	log := ah.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("getting attempts")
+
+	// Validation
+	params := utils.PaginationQueryParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+	params.SetDefaults()
+
+	if err := ah.validator.Struct(params); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	lAttempts, err := ah.attemptProvider.ListLessonAttempts(ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		log.Error("failed to get attempts", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lAttempts, nil
+}
+
+// percentageScore returns the average score of question attempts in percent.
+// A lesson without questions is considered fully passed.
+func percentageScore(stats attempts.QuestionAttemptsStats) int64 {
+	if stats.Total == 0 {
+		return maxScore
 	}
-	return lAttemptID, nil
+	return stats.ScoreSum / stats.Total
 }
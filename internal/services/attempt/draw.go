@@ -0,0 +1,84 @@
+package attempt
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+)
+
+// lessonQuestionPages returns question pages for a new attempt of the lesson.
+// A lesson without draw rules takes all its question pages. Otherwise every rule
+// draws its number of random questions from the bank, skipping questions drawn
+// by earlier rules. Drawn pages carry their rule, so the draw is recorded
+// on the page attempts.
+func (ah *AttemptHandlers) lessonQuestionPages(ctx context.Context, lessonID int64, cPages []attempts.ContentPage) ([]attempts.QuestionPage, error) {
+	ownPages, err := ah.attemptProvider.GetQuestionPages(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	draws, err := ah.attemptProvider.GetQuestionDraws(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(draws) == 0 {
+		return ownPages, nil
+	}
+
+	var drawn []attempts.QuestionPage
+	drawnIDs := make(map[int64]bool)
+	for _, draw := range draws {
+		bankPages, err := ah.attemptProvider.GetBankQuestionPages(ctx, draw)
+		if err != nil {
+			return nil, err
+		}
+
+		var candidates []attempts.QuestionPage
+		for _, qPage := range bankPages {
+			if !drawnIDs[qPage.PageID] {
+				candidates = append(candidates, qPage)
+			}
+		}
+
+		if int64(len(candidates)) < draw.Count {
+			return nil, fmt.Errorf("%w: bank %d has %d of %d questions", ErrNotEnoughQuestions, draw.BankID, len(candidates), draw.Count)
+		}
+
+		for _, i := range rand.Perm(len(candidates))[:draw.Count] {
+			drawnIDs[candidates[i].PageID] = true
+			drawn = append(drawn, candidates[i])
+		}
+	}
+
+	return placeDrawnPages(drawn, ownPages, cPages), nil
+}
+
+// placeDrawnPages sets positions of the drawn pages within the lesson, as they come
+// with positions from the lessons they were authored in. Drawn pages take the places
+// of the lesson's own question pages in draw order, the rest follow the last page
+// of the lesson.
+func placeDrawnPages(drawn, ownPages []attempts.QuestionPage, cPages []attempts.ContentPage) []attempts.QuestionPage {
+	var last int64 = -1
+	for _, qPage := range ownPages {
+		last = max(last, qPage.Position)
+	}
+	for _, cPage := range cPages {
+		last = max(last, cPage.Position)
+	}
+
+	placed := make([]attempts.QuestionPage, 0, len(drawn))
+	for i, qPage := range drawn {
+		if i < len(ownPages) {
+			qPage.Position = ownPages[i].Position
+		} else {
+			last++
+			qPage.Position = last
+		}
+		placed = append(placed, qPage)
+	}
+
+	return placed
+}
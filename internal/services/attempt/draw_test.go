@@ -0,0 +1,220 @@
+package attempt
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+)
+
+// drawProvider serves question pages of a lesson and its question banks.
+// Methods not used by the draw panic through the nil embedded interface.
+type drawProvider struct {
+	AttemptProvider
+	ownPages  []attempts.QuestionPage
+	draws     []attempts.QuestionDraw
+	bankPages map[int64][]attempts.QuestionPage
+}
+
+func (p drawProvider) GetQuestionPages(context.Context, int64) ([]attempts.QuestionPage, error) {
+	return p.ownPages, nil
+}
+
+func (p drawProvider) GetQuestionDraws(context.Context, int64) ([]attempts.QuestionDraw, error) {
+	return p.draws, nil
+}
+
+func (p drawProvider) GetBankQuestionPages(_ context.Context, draw attempts.QuestionDraw) ([]attempts.QuestionPage, error) {
+	var qPages []attempts.QuestionPage
+	for _, qPage := range p.bankPages[draw.BankID] {
+		qPage.Draw = draw
+		qPages = append(qPages, qPage)
+	}
+	return qPages, nil
+}
+
+func bankPages(pageIDs ...int64) []attempts.QuestionPage {
+	qPages := make([]attempts.QuestionPage, 0, len(pageIDs))
+	for _, id := range pageIDs {
+		// Positions come from the lessons the pages were authored in.
+		qPages = append(qPages, attempts.QuestionPage{PageID: id, Position: 0})
+	}
+	return qPages
+}
+
+func TestLessonQuestionPages(t *testing.T) {
+	ownPages := []attempts.QuestionPage{{PageID: 1, Position: 1}, {PageID: 2, Position: 3}}
+	cPages := []attempts.ContentPage{{PageID: 10, Position: 0}, {PageID: 11, Position: 2}, {PageID: 12, Position: 4}}
+
+	tests := []struct {
+		name      string
+		draws     []attempts.QuestionDraw
+		bankPages map[int64][]attempts.QuestionPage
+		wantCount map[int64]int
+		wantErr   error
+	}{
+		{
+			name: "no draws",
+		},
+		{
+			name:      "one rule",
+			draws:     []attempts.QuestionDraw{{BankID: 1, Count: 2}},
+			bankPages: map[int64][]attempts.QuestionPage{1: bankPages(101, 102, 103)},
+			wantCount: map[int64]int{1: 2},
+		},
+		{
+			name:      "more drawn than own question pages",
+			draws:     []attempts.QuestionDraw{{BankID: 1, Count: 2}, {BankID: 2, Count: 2, Tag: "sql", Difficulty: "hard"}},
+			bankPages: map[int64][]attempts.QuestionPage{1: bankPages(101, 102), 2: bankPages(201, 202, 203)},
+			wantCount: map[int64]int{1: 2, 2: 2},
+		},
+		{
+			name:      "rules sharing questions",
+			draws:     []attempts.QuestionDraw{{BankID: 1, Count: 2}, {BankID: 2, Count: 1}},
+			bankPages: map[int64][]attempts.QuestionPage{1: bankPages(101, 102), 2: bankPages(101, 102, 103)},
+			wantCount: map[int64]int{1: 2, 2: 1},
+		},
+		{
+			name:      "not enough questions",
+			draws:     []attempts.QuestionDraw{{BankID: 1, Count: 3}},
+			bankPages: map[int64][]attempts.QuestionPage{1: bankPages(101, 102)},
+			wantErr:   ErrNotEnoughQuestions,
+		},
+		{
+			name:      "not enough questions left by earlier rules",
+			draws:     []attempts.QuestionDraw{{BankID: 1, Count: 2}, {BankID: 2, Count: 1}},
+			bankPages: map[int64][]attempts.QuestionPage{1: bankPages(101, 102), 2: bankPages(101, 102)},
+			wantErr:   ErrNotEnoughQuestions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ah := &AttemptHandlers{attemptProvider: drawProvider{
+				ownPages:  ownPages,
+				draws:     tt.draws,
+				bankPages: tt.bankPages,
+			}}
+
+			got, err := ah.lessonQuestionPages(context.Background(), 1, cPages)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("lessonQuestionPages() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(tt.draws) == 0 {
+				if !reflect.DeepEqual(got, ownPages) {
+					t.Errorf("lessonQuestionPages() = %v, want %v", got, ownPages)
+				}
+				return
+			}
+
+			count := make(map[int64]int)
+			seen := make(map[int64]bool)
+			for _, qPage := range got {
+				if seen[qPage.PageID] {
+					t.Errorf("page %d drawn twice", qPage.PageID)
+				}
+				seen[qPage.PageID] = true
+
+				if !slices.Contains(tt.draws, qPage.Draw) {
+					t.Errorf("page %d has draw rule %+v, want one of %+v", qPage.PageID, qPage.Draw, tt.draws)
+				}
+				if !slices.ContainsFunc(tt.bankPages[qPage.Draw.BankID], func(p attempts.QuestionPage) bool { return p.PageID == qPage.PageID }) {
+					t.Errorf("page %d is not in bank %d", qPage.PageID, qPage.Draw.BankID)
+				}
+				count[qPage.Draw.BankID]++
+			}
+
+			for bankID, want := range tt.wantCount {
+				if count[bankID] != want {
+					t.Errorf("drawn %d questions from bank %d, want %d", count[bankID], bankID, want)
+				}
+			}
+
+			positions := pagePositions(got, cPages, false)
+			for _, cPage := range cPages {
+				if positions[cPage.PageID] != cPage.Position+1 {
+					t.Errorf("content page %d at %d, want %d", cPage.PageID, positions[cPage.PageID], cPage.Position+1)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaceDrawnPages(t *testing.T) {
+	ownPages := []attempts.QuestionPage{{PageID: 1, Position: 1}, {PageID: 2, Position: 3}}
+	cPages := []attempts.ContentPage{{PageID: 10, Position: 0}, {PageID: 11, Position: 2}, {PageID: 12, Position: 4}}
+
+	tests := []struct {
+		name     string
+		drawn    []int64
+		ownPages []attempts.QuestionPage
+		cPages   []attempts.ContentPage
+		want     []int64
+	}{
+		{
+			name:     "fewer than own question pages",
+			drawn:    []int64{101},
+			ownPages: ownPages,
+			cPages:   cPages,
+			want:     []int64{1},
+		},
+		{
+			name:     "as many as own question pages",
+			drawn:    []int64{101, 102},
+			ownPages: ownPages,
+			cPages:   cPages,
+			want:     []int64{1, 3},
+		},
+		{
+			name:     "more than own question pages",
+			drawn:    []int64{101, 102, 103, 104},
+			ownPages: ownPages,
+			cPages:   cPages,
+			want:     []int64{1, 3, 5, 6},
+		},
+		{
+			name:   "no own question pages",
+			drawn:  []int64{101, 102},
+			cPages: cPages,
+			want:   []int64{5, 6},
+		},
+		{
+			name:  "empty lesson",
+			drawn: []int64{101, 102},
+			want:  []int64{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Drawn pages come with positions of their source lessons, which collide with the lesson pages.
+			var drawn []attempts.QuestionPage
+			for _, id := range tt.drawn {
+				drawn = append(drawn, attempts.QuestionPage{PageID: id, Position: 2})
+			}
+
+			got := placeDrawnPages(drawn, tt.ownPages, tt.cPages)
+
+			var gotPositions []int64
+			for i, qPage := range got {
+				if qPage.PageID != tt.drawn[i] {
+					t.Fatalf("placeDrawnPages() page %d = %d, want %d", i, qPage.PageID, tt.drawn[i])
+				}
+				gotPositions = append(gotPositions, qPage.Position)
+			}
+			if !slices.Equal(gotPositions, tt.want) {
+				t.Errorf("placeDrawnPages() positions = %v, want %v", gotPositions, tt.want)
+			}
+			if drawn[0].Position != 2 {
+				t.Errorf("placeDrawnPages() changed drawn pages")
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package attempt
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+)
+
+// maxScore is the score of a fully correct answer.
+const maxScore = 100
+
+// gradeAnswer checks the user answer against the question page. It returns the
+// answer to be saved in the question page attempt and its score in percent.
+func gradeAnswer(qPage questions.QuestionPage, answer attempts.SubmitQuestionAnswer) (string, int64, error) {
+	switch qPage.QuestionType {
+	case questions.QuestionTypeMultichoice:
+		option, ok := findOption(qPage.Options, answer.OptionID)
+		if !ok {
+			return "", 0, ErrAnswerMismatch
+		}
+		return strconv.FormatInt(option.ID, 10), boolScore(option.IsCorrect), nil
+	case questions.QuestionTypeShortAnswer:
+		if answer.TextAnswer == "" || qPage.ShortAnswer == nil {
+			return "", 0, ErrAnswerMismatch
+		}
+		return answer.TextAnswer, boolScore(matchShortAnswer(qPage.ShortAnswer, answer.TextAnswer)), nil
+	case questions.QuestionTypeMultiSelect:
+		if len(answer.SelectedOptionIDs) == 0 || qPage.MultiSelect == nil {
+			return "", 0, ErrAnswerMismatch
+		}
+		selected := append([]int64(nil), answer.SelectedOptionIDs...)
+		sort.Slice(selected, func(i, j int) bool { return selected[i] < selected[j] })
+		userAnswer := make([]string, 0, len(selected))
+		for i, optionID := range selected {
+			if _, ok := findOption(qPage.Options, optionID); !ok {
+				return "", 0, ErrAnswerMismatch
+			}
+			// Every option may be selected once, otherwise repeated picks would count as extra hits.
+			if i > 0 && selected[i-1] == optionID {
+				return "", 0, ErrAnswerMismatch
+			}
+			userAnswer = append(userAnswer, strconv.FormatInt(optionID, 10))
+		}
+		return strings.Join(userAnswer, ","), scoreMultiSelect(qPage, selected), nil
+	case questions.QuestionTypeTrueFalse:
+		if answer.BoolAnswer == nil || qPage.TrueFalse == nil {
+			return "", 0, ErrAnswerMismatch
+		}
+		return strconv.FormatBool(*answer.BoolAnswer), boolScore(*answer.BoolAnswer == qPage.TrueFalse.Answer), nil
+	case questions.QuestionTypeNumeric:
+		if answer.NumericAnswer == nil || qPage.Numeric == nil {
+			return "", 0, ErrAnswerMismatch
+		}
+		return strconv.FormatFloat(*answer.NumericAnswer, 'g', -1, 64), boolScore(matchNumeric(qPage.Numeric, *answer.NumericAnswer)), nil
+	case questions.QuestionTypeFillBlanks:
+		if qPage.FillBlanks == nil || len(answer.BlankAnswers) != len(qPage.FillBlanks.Blanks) {
+			return "", 0, ErrAnswerMismatch
+		}
+		// Blank answers may contain any separator, so they are saved as a JSON array.
+		userAnswer, err := json.Marshal(answer.BlankAnswers)
+		if err != nil {
+			return "", 0, err
+		}
+		return string(userAnswer), scoreFillBlanks(qPage.FillBlanks, answer.BlankAnswers), nil
+	case questions.QuestionTypeOrdering:
+		if qPage.Ordering == nil || hasDuplicates(answer.OrderedItems) || !sameItems(qPage.Ordering.Items, answer.OrderedItems) {
+			return "", 0, ErrAnswerMismatch
+		}
+		userAnswer, err := json.Marshal(answer.OrderedItems)
+		if err != nil {
+			return "", 0, err
+		}
+		return string(userAnswer), scoreOrdering(qPage.Ordering, answer.OrderedItems), nil
+	case questions.QuestionTypeMatching:
+		if qPage.Matching == nil || !validMatchedPairs(qPage.Matching, answer.MatchedPairs) {
+			return "", 0, ErrAnswerMismatch
+		}
+		userAnswer, err := json.Marshal(answer.MatchedPairs)
+		if err != nil {
+			return "", 0, err
+		}
+		return string(userAnswer), scoreMatching(qPage.Matching, answer.MatchedPairs), nil
+	default:
+		return "", 0, ErrAnswerMismatch
+	}
+}
+
+// clampScore keeps the score within [0, maxScore].
+func clampScore(score int64) int64 {
+	return max(0, min(score, maxScore))
+}
+
+func boolScore(isCorrect bool) int64 {
+	if isCorrect {
+		return maxScore
+	}
+	return 0
+}
+
+// matchShortAnswer reports whether the text matches any of the accepted answers.
+// In regex mode accepted answers are patterns which must match the whole text.
+func matchShortAnswer(question *questions.ShortAnswerQuestion, text string) bool {
+	return matchText(question.AcceptedAnswers, question.MatchMode, question.TrimWhitespace, text)
+}
+
+// matchText reports whether the text matches any of the accepted answers in the given match mode.
+func matchText(acceptedAnswers []string, matchMode string, trimWhitespace bool, text string) bool {
+	if trimWhitespace {
+		text = normalizeWhitespace(text)
+	}
+
+	for _, accepted := range acceptedAnswers {
+		switch matchMode {
+		case questions.MatchModeRegex:
+			re, err := regexp.Compile(`^(?:` + accepted + `)$`)
+			if err != nil {
+				continue
+			}
+			if re.MatchString(text) {
+				return true
+			}
+		case questions.MatchModeCaseInsensitive:
+			if trimWhitespace {
+				accepted = normalizeWhitespace(accepted)
+			}
+			if strings.EqualFold(text, accepted) {
+				return true
+			}
+		default:
+			if trimWhitespace {
+				accepted = normalizeWhitespace(accepted)
+			}
+			if text == accepted {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// normalizeWhitespace trims the text and collapses inner runs of whitespace into a single space.
+func normalizeWhitespace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// matchNumeric reports whether the value is within the accepted range if it is set,
+// otherwise whether it deviates from the correct value by no more than the tolerance.
+func matchNumeric(question *questions.NumericQuestion, value float64) bool {
+	if question.MinValue != nil && question.MaxValue != nil {
+		return *question.MinValue <= value && value <= *question.MaxValue
+	}
+	if question.Answer == nil {
+		return false
+	}
+	return math.Abs(value-*question.Answer) <= question.Tolerance
+}
+
+// scoreFillBlanks gives an equal share of the score for every correctly filled blank.
+func scoreFillBlanks(question *questions.FillBlanksQuestion, answers []string) int64 {
+	if len(question.Blanks) == 0 {
+		return 0
+	}
+
+	var hits int64
+	for i, blank := range question.Blanks {
+		if matchText(blank.AcceptedAnswers, question.MatchMode, question.TrimWhitespace, answers[i]) {
+			hits++
+		}
+	}
+
+	return clampScore(hits * maxScore / int64(len(question.Blanks)))
+}
+
+// sameItems reports whether the answer is a permutation of the question items.
+func sameItems(items, answer []string) bool {
+	if len(items) != len(answer) {
+		return false
+	}
+
+	counts := make(map[string]int, len(items))
+	for _, item := range items {
+		counts[item]++
+	}
+	for _, item := range answer {
+		if counts[item] == 0 {
+			return false
+		}
+		counts[item]--
+	}
+
+	return true
+}
+
+// hasDuplicates reports whether any item occurs more than once.
+func hasDuplicates(items []string) bool {
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item] {
+			return true
+		}
+		seen[item] = true
+	}
+	return false
+}
+
+// validMatchedPairs reports whether the answer has at least one pair, uses every left
+// and right item at most once and refers only to left items of the question.
+func validMatchedPairs(question *questions.MatchingQuestion, answer []attempts.MatchedPair) bool {
+	if len(answer) == 0 {
+		return false
+	}
+
+	lefts := make(map[string]bool, len(question.Pairs))
+	for _, pair := range question.Pairs {
+		lefts[pair.Left] = true
+	}
+
+	usedLefts := make([]string, 0, len(answer))
+	usedRights := make([]string, 0, len(answer))
+	for _, pair := range answer {
+		if !lefts[pair.Left] {
+			return false
+		}
+		usedLefts = append(usedLefts, pair.Left)
+		usedRights = append(usedRights, pair.Right)
+	}
+
+	return !hasDuplicates(usedLefts) && !hasDuplicates(usedRights)
+}
+
+// scoreOrdering gives an equal share of the score for every item placed at its correct position.
+func scoreOrdering(question *questions.OrderingQuestion, answer []string) int64 {
+	if len(question.Items) == 0 {
+		return 0
+	}
+
+	var hits int64
+	for i, item := range question.Items {
+		if answer[i] == item {
+			hits++
+		}
+	}
+
+	return clampScore(hits * maxScore / int64(len(question.Items)))
+}
+
+// scoreMatching gives an equal share of the score for every correct pair.
+// Pairs with unknown right items are just not counted.
+func scoreMatching(question *questions.MatchingQuestion, answer []attempts.MatchedPair) int64 {
+	if len(question.Pairs) == 0 {
+		return 0
+	}
+
+	correct := make(map[string]string, len(question.Pairs))
+	for _, pair := range question.Pairs {
+		correct[pair.Left] = pair.Right
+	}
+
+	var hits int64
+	for _, pair := range answer {
+		if right, ok := correct[pair.Left]; ok && right == pair.Right {
+			hits++
+		}
+	}
+
+	return clampScore(hits * maxScore / int64(len(question.Pairs)))
+}
+
+// scoreMultiSelect scores the selected options according to the grading mode:
+//   - all or nothing: full score only if exactly the correct options are selected;
+//   - partial credit: share of options which are correctly selected or left out;
+//   - penalty: share of correct options selected, minus one share per wrong pick.
+func scoreMultiSelect(qPage questions.QuestionPage, selected []int64) int64 {
+	correct := make(map[int64]bool, len(qPage.Options))
+	for _, option := range qPage.Options {
+		if option.IsCorrect {
+			correct[option.ID] = true
+		}
+	}
+
+	var hits, misses int64
+	for _, optionID := range selected {
+		if correct[optionID] {
+			hits++
+		} else {
+			misses++
+		}
+	}
+	totalCorrect := int64(len(correct))
+
+	switch qPage.MultiSelect.GradingMode {
+	case questions.GradingModePartialCredit:
+		options := int64(len(qPage.Options))
+		if options == 0 {
+			return 0
+		}
+		rightlyLeftOut := options - totalCorrect - misses
+		if hits+rightlyLeftOut <= 0 {
+			return 0
+		}
+		return clampScore((hits + rightlyLeftOut) * maxScore / options)
+	case questions.GradingModePenalty:
+		if hits <= misses || totalCorrect == 0 {
+			return 0
+		}
+		return clampScore((hits - misses) * maxScore / totalCorrect)
+	default:
+		return boolScore(hits == totalCorrect && misses == 0)
+	}
+}
+
+// answerFeedback returns feedback of the options chosen by the user which have it.
+func answerFeedback(qPage questions.QuestionPage, answer attempts.SubmitQuestionAnswer) []attempts.OptionFeedback {
+	var chosen []int64
+	switch qPage.QuestionType {
+	case questions.QuestionTypeMultichoice:
+		chosen = []int64{answer.OptionID}
+	case questions.QuestionTypeMultiSelect:
+		chosen = answer.SelectedOptionIDs
+	}
+
+	var feedback []attempts.OptionFeedback
+	for _, optionID := range chosen {
+		option, ok := findOption(qPage.Options, optionID)
+		if !ok || option.Feedback == "" {
+			continue
+		}
+		feedback = append(feedback, attempts.OptionFeedback{
+			OptionID: option.ID,
+			Feedback: option.Feedback,
+		})
+	}
+
+	return feedback
+}
+
+// findOption returns the option of the question with the given ID.
+func findOption(options []questions.Option, optionID int64) (questions.Option, bool) {
+	for _, option := range options {
+		if option.ID == optionID {
+			return option, true
+		}
+	}
+	return questions.Option{}, false
+}
@@ -0,0 +1,692 @@
+package attempt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestGradeAnswer(t *testing.T) {
+	options := []questions.Option{
+		{ID: 1, IsCorrect: true},
+		{ID: 2},
+		{ID: 3, IsCorrect: true},
+	}
+
+	tests := []struct {
+		name       string
+		qPage      questions.QuestionPage
+		answer     attempts.SubmitQuestionAnswer
+		wantAnswer string
+		wantScore  int64
+		wantErr    error
+	}{
+		{
+			name:       "multichoice correct",
+			qPage:      questions.QuestionPage{QuestionType: questions.QuestionTypeMultichoice, Options: options},
+			answer:     attempts.SubmitQuestionAnswer{OptionID: 1},
+			wantAnswer: "1",
+			wantScore:  100,
+		},
+		{
+			name:       "multichoice wrong",
+			qPage:      questions.QuestionPage{QuestionType: questions.QuestionTypeMultichoice, Options: options},
+			answer:     attempts.SubmitQuestionAnswer{OptionID: 2},
+			wantAnswer: "2",
+			wantScore:  0,
+		},
+		{
+			name:    "multichoice unknown option",
+			qPage:   questions.QuestionPage{QuestionType: questions.QuestionTypeMultichoice, Options: options},
+			answer:  attempts.SubmitQuestionAnswer{OptionID: 4},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "short answer",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeShortAnswer,
+				ShortAnswer:  &questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeExact},
+			},
+			answer:     attempts.SubmitQuestionAnswer{TextAnswer: "Paris"},
+			wantAnswer: "Paris",
+			wantScore:  100,
+		},
+		{
+			name: "short answer empty",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeShortAnswer,
+				ShortAnswer:  &questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeExact},
+			},
+			answer:  attempts.SubmitQuestionAnswer{OptionID: 1},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "multi-select sorts selected options",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMultiSelect,
+				Options:      options,
+				MultiSelect:  &questions.MultiSelectQuestion{GradingMode: questions.GradingModeAllOrNothing},
+			},
+			answer:     attempts.SubmitQuestionAnswer{SelectedOptionIDs: []int64{3, 1}},
+			wantAnswer: "1,3",
+			wantScore:  100,
+		},
+		{
+			name: "multi-select unknown option",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMultiSelect,
+				Options:      options,
+				MultiSelect:  &questions.MultiSelectQuestion{GradingMode: questions.GradingModeAllOrNothing},
+			},
+			answer:  attempts.SubmitQuestionAnswer{SelectedOptionIDs: []int64{1, 4}},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "true/false correct",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeTrueFalse,
+				TrueFalse:    &questions.TrueFalseQuestion{Answer: false},
+			},
+			answer:     attempts.SubmitQuestionAnswer{BoolAnswer: ptr(false)},
+			wantAnswer: "false",
+			wantScore:  100,
+		},
+		{
+			name: "true/false without answer",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeTrueFalse,
+				TrueFalse:    &questions.TrueFalseQuestion{Answer: true},
+			},
+			answer:  attempts.SubmitQuestionAnswer{TextAnswer: "true"},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "numeric",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeNumeric,
+				Numeric:      &questions.NumericQuestion{Answer: ptr(3.14), Tolerance: 0.01},
+			},
+			answer:     attempts.SubmitQuestionAnswer{NumericAnswer: ptr(3.141)},
+			wantAnswer: "3.141",
+			wantScore:  100,
+		},
+		{
+			name: "fill blanks",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeFillBlanks,
+				FillBlanks: &questions.FillBlanksQuestion{
+					Blanks:    []questions.FillBlank{{AcceptedAnswers: []string{"a"}}, {AcceptedAnswers: []string{"b"}}},
+					MatchMode: questions.MatchModeExact,
+				},
+			},
+			answer:     attempts.SubmitQuestionAnswer{BlankAnswers: []string{"a", "c"}},
+			wantAnswer: `["a","c"]`,
+			wantScore:  50,
+		},
+		{
+			name: "fill blanks count mismatch",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeFillBlanks,
+				FillBlanks: &questions.FillBlanksQuestion{
+					Blanks:    []questions.FillBlank{{AcceptedAnswers: []string{"a"}}, {AcceptedAnswers: []string{"b"}}},
+					MatchMode: questions.MatchModeExact,
+				},
+			},
+			answer:  attempts.SubmitQuestionAnswer{BlankAnswers: []string{"a"}},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "ordering",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeOrdering,
+				Ordering:     &questions.OrderingQuestion{Items: []string{"a", "b", "c", "d"}},
+			},
+			answer:     attempts.SubmitQuestionAnswer{OrderedItems: []string{"a", "b", "d", "c"}},
+			wantAnswer: `["a","b","d","c"]`,
+			wantScore:  50,
+		},
+		{
+			name: "ordering with unknown item",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeOrdering,
+				Ordering:     &questions.OrderingQuestion{Items: []string{"a", "b"}},
+			},
+			answer:  attempts.SubmitQuestionAnswer{OrderedItems: []string{"a", "c"}},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name: "matching",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMatching,
+				Matching:     &questions.MatchingQuestion{Pairs: []questions.MatchingPair{{Left: "a", Right: "1"}, {Left: "b", Right: "2"}}},
+			},
+			answer:     attempts.SubmitQuestionAnswer{MatchedPairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "3"}}},
+			wantAnswer: `[{"left":"a","right":"1"},{"left":"b","right":"3"}]`,
+			wantScore:  50,
+		},
+		{
+			name: "matching without pairs",
+			qPage: questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMatching,
+				Matching:     &questions.MatchingQuestion{Pairs: []questions.MatchingPair{{Left: "a", Right: "1"}, {Left: "b", Right: "2"}}},
+			},
+			answer:  attempts.SubmitQuestionAnswer{TextAnswer: "a"},
+			wantErr: ErrAnswerMismatch,
+		},
+		{
+			name:    "unknown question type",
+			qPage:   questions.QuestionPage{QuestionType: "essay"},
+			answer:  attempts.SubmitQuestionAnswer{TextAnswer: "text"},
+			wantErr: ErrAnswerMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAnswer, gotScore, err := gradeAnswer(tt.qPage, tt.answer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotAnswer != tt.wantAnswer || gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() = (%q, %d), want (%q, %d)", gotAnswer, gotScore, tt.wantAnswer, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerMultiSelect(t *testing.T) {
+	// Options 1 and 3 are correct.
+	options := []questions.Option{
+		{ID: 1, IsCorrect: true},
+		{ID: 2},
+		{ID: 3, IsCorrect: true},
+	}
+
+	tests := []struct {
+		name        string
+		gradingMode string
+		selected    []int64
+		wantAnswer  string
+		wantScore   int64
+		wantErr     error
+	}{
+		{name: "all or nothing one correct", gradingMode: questions.GradingModeAllOrNothing, selected: []int64{1}, wantAnswer: "1", wantScore: 0},
+		{name: "partial credit one correct", gradingMode: questions.GradingModePartialCredit, selected: []int64{1}, wantAnswer: "1", wantScore: 66},
+		{name: "penalty one correct", gradingMode: questions.GradingModePenalty, selected: []int64{1}, wantAnswer: "1", wantScore: 50},
+		{name: "all or nothing repeated option", gradingMode: questions.GradingModeAllOrNothing, selected: []int64{1, 1}, wantErr: ErrAnswerMismatch},
+		{name: "partial credit repeated option", gradingMode: questions.GradingModePartialCredit, selected: []int64{1, 1}, wantErr: ErrAnswerMismatch},
+		{name: "penalty repeated option", gradingMode: questions.GradingModePenalty, selected: []int64{1, 1}, wantErr: ErrAnswerMismatch},
+		{name: "partial credit repeated option with all correct", gradingMode: questions.GradingModePartialCredit, selected: []int64{1, 1, 3}, wantErr: ErrAnswerMismatch},
+		{name: "penalty repeated option with all correct", gradingMode: questions.GradingModePenalty, selected: []int64{3, 1, 1}, wantErr: ErrAnswerMismatch},
+		{name: "no options selected", gradingMode: questions.GradingModeAllOrNothing, wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qPage := questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMultiSelect,
+				Options:      options,
+				MultiSelect:  &questions.MultiSelectQuestion{GradingMode: tt.gradingMode},
+			}
+			gotAnswer, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{SelectedOptionIDs: tt.selected})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotAnswer != tt.wantAnswer || gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() = (%q, %d), want (%q, %d)", gotAnswer, gotScore, tt.wantAnswer, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerTrueFalse(t *testing.T) {
+	tests := []struct {
+		name       string
+		correct    bool
+		answer     *bool
+		wantAnswer string
+		wantScore  int64
+		wantErr    error
+	}{
+		{name: "true is correct", correct: true, answer: ptr(true), wantAnswer: "true", wantScore: 100},
+		{name: "false is wrong", correct: true, answer: ptr(false), wantAnswer: "false", wantScore: 0},
+		{name: "true is wrong", correct: false, answer: ptr(true), wantAnswer: "true", wantScore: 0},
+		{name: "no answer", correct: true, wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qPage := questions.QuestionPage{
+				QuestionType: questions.QuestionTypeTrueFalse,
+				TrueFalse:    &questions.TrueFalseQuestion{Answer: tt.correct},
+			}
+			gotAnswer, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{BoolAnswer: tt.answer})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotAnswer != tt.wantAnswer || gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() = (%q, %d), want (%q, %d)", gotAnswer, gotScore, tt.wantAnswer, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerNumeric(t *testing.T) {
+	tests := []struct {
+		name       string
+		question   questions.NumericQuestion
+		answer     *float64
+		wantAnswer string
+		wantScore  int64
+		wantErr    error
+	}{
+		{name: "within tolerance", question: questions.NumericQuestion{Answer: ptr(9.81), Tolerance: 0.05}, answer: ptr(9.8), wantAnswer: "9.8", wantScore: 100},
+		{name: "out of tolerance", question: questions.NumericQuestion{Answer: ptr(9.81), Tolerance: 0.05}, answer: ptr(9.7), wantAnswer: "9.7", wantScore: 0},
+		{name: "within range", question: questions.NumericQuestion{MinValue: ptr(-1.0), MaxValue: ptr(1.0)}, answer: ptr(-0.5), wantAnswer: "-0.5", wantScore: 100},
+		{name: "large value", question: questions.NumericQuestion{Answer: ptr(1e21)}, answer: ptr(1e21), wantAnswer: "1e+21", wantScore: 100},
+		{name: "no answer", question: questions.NumericQuestion{Answer: ptr(1.0)}, wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qPage := questions.QuestionPage{
+				QuestionType: questions.QuestionTypeNumeric,
+				Numeric:      &tt.question,
+			}
+			gotAnswer, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{NumericAnswer: tt.answer})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotAnswer != tt.wantAnswer || gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() = (%q, %d), want (%q, %d)", gotAnswer, gotScore, tt.wantAnswer, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerFillBlanks(t *testing.T) {
+	blanks := []questions.FillBlank{
+		{AcceptedAnswers: []string{"Paris"}},
+		{AcceptedAnswers: []string{"Seine", "La Seine"}},
+		{AcceptedAnswers: []string{"1[0-9]{3}"}},
+	}
+
+	tests := []struct {
+		name           string
+		matchMode      string
+		trimWhitespace bool
+		answers        []string
+		wantAnswer     string
+		wantScore      int64
+		wantErr        error
+	}{
+		{
+			name:       "all blanks",
+			matchMode:  questions.MatchModeExact,
+			answers:    []string{"Paris", "La Seine", "1[0-9]{3}"},
+			wantAnswer: `["Paris","La Seine","1[0-9]{3}"]`,
+			wantScore:  100,
+		},
+		{
+			name:       "exact is case sensitive",
+			matchMode:  questions.MatchModeExact,
+			answers:    []string{"paris", "Seine", "x"},
+			wantAnswer: `["paris","Seine","x"]`,
+			wantScore:  33,
+		},
+		{
+			name:           "case insensitive with trimmed whitespace",
+			matchMode:      questions.MatchModeCaseInsensitive,
+			trimWhitespace: true,
+			answers:        []string{" paris ", "la   seine", "1789"},
+			wantAnswer:     `[" paris ","la   seine","1789"]`,
+			wantScore:      66,
+		},
+		{
+			name:       "regex",
+			matchMode:  questions.MatchModeRegex,
+			answers:    []string{"Paris", "Seine", "1789"},
+			wantAnswer: `["Paris","Seine","1789"]`,
+			wantScore:  100,
+		},
+		{
+			name:       "regex matches whole blank",
+			matchMode:  questions.MatchModeRegex,
+			answers:    []string{"Paris, France", "Seine", "17890"},
+			wantAnswer: `["Paris, France","Seine","17890"]`,
+			wantScore:  33,
+		},
+		{
+			name:       "separators in answers",
+			matchMode:  questions.MatchModeExact,
+			answers:    []string{"Paris,Seine", "", "\"1\""},
+			wantAnswer: `["Paris,Seine","","\"1\""]`,
+			wantScore:  0,
+		},
+		{name: "too few answers", matchMode: questions.MatchModeExact, answers: []string{"Paris", "Seine"}, wantErr: ErrAnswerMismatch},
+		{name: "too many answers", matchMode: questions.MatchModeExact, answers: []string{"Paris", "Seine", "1789", "x"}, wantErr: ErrAnswerMismatch},
+		{name: "no answers", matchMode: questions.MatchModeExact, wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qPage := questions.QuestionPage{
+				QuestionType: questions.QuestionTypeFillBlanks,
+				FillBlanks: &questions.FillBlanksQuestion{
+					Blanks:         blanks,
+					MatchMode:      tt.matchMode,
+					TrimWhitespace: tt.trimWhitespace,
+				},
+			}
+			gotAnswer, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{BlankAnswers: tt.answers})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotAnswer != tt.wantAnswer || gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() = (%q, %d), want (%q, %d)", gotAnswer, gotScore, tt.wantAnswer, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerOrdering(t *testing.T) {
+	qPage := questions.QuestionPage{
+		QuestionType: questions.QuestionTypeOrdering,
+		Ordering:     &questions.OrderingQuestion{Items: []string{"a", "b", "c"}},
+	}
+
+	tests := []struct {
+		name      string
+		items     []string
+		wantScore int64
+		wantErr   error
+	}{
+		{name: "correct order", items: []string{"a", "b", "c"}, wantScore: 100},
+		{name: "one item in place", items: []string{"a", "c", "b"}, wantScore: 33},
+		{name: "reversed order", items: []string{"c", "b", "a"}, wantScore: 33},
+		{name: "no item in place", items: []string{"b", "c", "a"}, wantScore: 0},
+		{name: "repeated item", items: []string{"a", "a", "b"}, wantErr: ErrAnswerMismatch},
+		{name: "repeated item in place", items: []string{"a", "b", "b"}, wantErr: ErrAnswerMismatch},
+		{name: "missing item", items: []string{"a", "b"}, wantErr: ErrAnswerMismatch},
+		{name: "extra item", items: []string{"a", "b", "c", "d"}, wantErr: ErrAnswerMismatch},
+		{name: "no items", wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{OrderedItems: tt.items})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() score = %d, want %d", gotScore, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestGradeAnswerMatching(t *testing.T) {
+	qPage := questions.QuestionPage{
+		QuestionType: questions.QuestionTypeMatching,
+		Matching: &questions.MatchingQuestion{Pairs: []questions.MatchingPair{
+			{Left: "a", Right: "1"},
+			{Left: "b", Right: "2"},
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		pairs     []attempts.MatchedPair
+		wantScore int64
+		wantErr   error
+	}{
+		{name: "all pairs", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "2"}}, wantScore: 100},
+		{name: "one pair", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}}, wantScore: 50},
+		{name: "swapped pairs", pairs: []attempts.MatchedPair{{Left: "a", Right: "2"}, {Left: "b", Right: "1"}}, wantScore: 0},
+		{name: "unknown right item", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "3"}}, wantScore: 50},
+		{name: "repeated pair", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "a", Right: "1"}}, wantErr: ErrAnswerMismatch},
+		{
+			name:    "repeated pair with all pairs",
+			pairs:   []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "a", Right: "1"}, {Left: "b", Right: "2"}},
+			wantErr: ErrAnswerMismatch,
+		},
+		{name: "repeated left item", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "a", Right: "2"}}, wantErr: ErrAnswerMismatch},
+		{name: "repeated right item", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "1"}}, wantErr: ErrAnswerMismatch},
+		{name: "unknown left item", pairs: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "c", Right: "2"}}, wantErr: ErrAnswerMismatch},
+		{name: "no pairs", wantErr: ErrAnswerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, gotScore, err := gradeAnswer(qPage, attempts.SubmitQuestionAnswer{MatchedPairs: tt.pairs})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("gradeAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotScore != tt.wantScore {
+				t.Errorf("gradeAnswer() score = %d, want %d", gotScore, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestMatchShortAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		question questions.ShortAnswerQuestion
+		text     string
+		want     bool
+	}{
+		{
+			name:     "exact",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeExact},
+			text:     "Paris",
+			want:     true,
+		},
+		{
+			name:     "exact is case sensitive",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeExact},
+			text:     "paris",
+			want:     false,
+		},
+		{
+			name:     "exact keeps whitespace",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeExact},
+			text:     " Paris ",
+			want:     false,
+		},
+		{
+			name:     "case insensitive",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris"}, MatchMode: questions.MatchModeCaseInsensitive},
+			text:     "PARIS",
+			want:     true,
+		},
+		{
+			name: "case insensitive with trimmed whitespace",
+			question: questions.ShortAnswerQuestion{
+				AcceptedAnswers: []string{"New York"},
+				MatchMode:       questions.MatchModeCaseInsensitive,
+				TrimWhitespace:  true,
+			},
+			text: "  new   york ",
+			want: true,
+		},
+		{
+			name:     "any accepted answer",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{"Paris", "Lutetia"}, MatchMode: questions.MatchModeExact},
+			text:     "Lutetia",
+			want:     true,
+		},
+		{
+			name:     "regex matches whole text",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{`colou?r`}, MatchMode: questions.MatchModeRegex},
+			text:     "color",
+			want:     true,
+		},
+		{
+			name:     "regex does not match part of text",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{`colou?r`}, MatchMode: questions.MatchModeRegex},
+			text:     "colors",
+			want:     false,
+		},
+		{
+			name:     "regex alternation is anchored",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{`red|blue`}, MatchMode: questions.MatchModeRegex},
+			text:     "dark blue",
+			want:     false,
+		},
+		{
+			name:     "invalid regex is skipped",
+			question: questions.ShortAnswerQuestion{AcceptedAnswers: []string{`(`, `a+`}, MatchMode: questions.MatchModeRegex},
+			text:     "aaa",
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchShortAnswer(&tt.question, tt.text); got != tt.want {
+				t.Errorf("matchShortAnswer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchNumeric(t *testing.T) {
+	tests := []struct {
+		name     string
+		question questions.NumericQuestion
+		value    float64
+		want     bool
+	}{
+		{name: "exact value", question: questions.NumericQuestion{Answer: ptr(2.5)}, value: 2.5, want: true},
+		{name: "within tolerance", question: questions.NumericQuestion{Answer: ptr(10.0), Tolerance: 0.5}, value: 9.5, want: true},
+		{name: "out of tolerance", question: questions.NumericQuestion{Answer: ptr(10.0), Tolerance: 0.5}, value: 10.6, want: false},
+		{name: "range lower bound", question: questions.NumericQuestion{MinValue: ptr(1.0), MaxValue: ptr(2.0)}, value: 1, want: true},
+		{name: "range upper bound", question: questions.NumericQuestion{MinValue: ptr(1.0), MaxValue: ptr(2.0)}, value: 2, want: true},
+		{name: "out of range", question: questions.NumericQuestion{MinValue: ptr(1.0), MaxValue: ptr(2.0)}, value: 2.1, want: false},
+		{
+			name:     "range wins over answer",
+			question: questions.NumericQuestion{Answer: ptr(5.0), MinValue: ptr(1.0), MaxValue: ptr(2.0)},
+			value:    5,
+			want:     false,
+		},
+		{name: "no answer", question: questions.NumericQuestion{}, value: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchNumeric(&tt.question, tt.value); got != tt.want {
+				t.Errorf("matchNumeric() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreMultiSelect(t *testing.T) {
+	// Options 1 and 2 are correct, 3 and 4 are wrong.
+	options := []questions.Option{
+		{ID: 1, IsCorrect: true},
+		{ID: 2, IsCorrect: true},
+		{ID: 3},
+		{ID: 4},
+	}
+
+	tests := []struct {
+		name        string
+		gradingMode string
+		options     []questions.Option
+		selected    []int64
+		want        int64
+	}{
+		{name: "all or nothing exact", gradingMode: questions.GradingModeAllOrNothing, options: options, selected: []int64{1, 2}, want: 100},
+		{name: "all or nothing missing option", gradingMode: questions.GradingModeAllOrNothing, options: options, selected: []int64{1}, want: 0},
+		{name: "all or nothing extra option", gradingMode: questions.GradingModeAllOrNothing, options: options, selected: []int64{1, 2, 3}, want: 0},
+		{name: "all or nothing no correct selections", gradingMode: questions.GradingModeAllOrNothing, options: options, selected: []int64{3, 4}, want: 0},
+		{name: "partial credit exact", gradingMode: questions.GradingModePartialCredit, options: options, selected: []int64{1, 2}, want: 100},
+		{name: "partial credit missing option", gradingMode: questions.GradingModePartialCredit, options: options, selected: []int64{1}, want: 75},
+		{name: "partial credit extra option", gradingMode: questions.GradingModePartialCredit, options: options, selected: []int64{1, 2, 3}, want: 75},
+		{name: "partial credit no correct selections", gradingMode: questions.GradingModePartialCredit, options: options, selected: []int64{3, 4}, want: 0},
+		{name: "partial credit no options", gradingMode: questions.GradingModePartialCredit, selected: []int64{1}, want: 0},
+		{name: "penalty exact", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1, 2}, want: 100},
+		{name: "penalty missing option", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1}, want: 50},
+		{name: "penalty wrong pick", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1, 2, 3}, want: 50},
+		{name: "penalty hits cancelled out", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1, 3}, want: 0},
+		{name: "penalty does not go below zero", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1, 3, 4}, want: 0},
+		{name: "penalty no correct selections", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{3}, want: 0},
+		{name: "partial credit is capped", gradingMode: questions.GradingModePartialCredit, options: options, selected: []int64{1, 1, 2}, want: 100},
+		{name: "penalty is capped", gradingMode: questions.GradingModePenalty, options: options, selected: []int64{1, 1, 2}, want: 100},
+		{
+			name:        "penalty no correct options",
+			gradingMode: questions.GradingModePenalty,
+			options:     []questions.Option{{ID: 3}, {ID: 4}},
+			selected:    []int64{3},
+			want:        0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qPage := questions.QuestionPage{
+				QuestionType: questions.QuestionTypeMultiSelect,
+				Options:      tt.options,
+				MultiSelect:  &questions.MultiSelectQuestion{GradingMode: tt.gradingMode},
+			}
+			if got := scoreMultiSelect(qPage, tt.selected); got != tt.want {
+				t.Errorf("scoreMultiSelect() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreMatching(t *testing.T) {
+	question := &questions.MatchingQuestion{Pairs: []questions.MatchingPair{
+		{Left: "a", Right: "1"},
+		{Left: "b", Right: "2"},
+		{Left: "c", Right: "3"},
+	}}
+
+	tests := []struct {
+		name   string
+		answer []attempts.MatchedPair
+		want   int64
+	}{
+		{name: "all pairs", answer: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "2"}, {Left: "c", Right: "3"}}, want: 100},
+		{name: "some pairs", answer: []attempts.MatchedPair{{Left: "a", Right: "1"}, {Left: "b", Right: "3"}}, want: 33},
+		{name: "unknown items", answer: []attempts.MatchedPair{{Left: "x", Right: "1"}, {Left: "a", Right: "y"}}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scoreMatching(question, tt.answer); got != tt.want {
+				t.Errorf("scoreMatching() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentageScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats attempts.QuestionAttemptsStats
+		want  int64
+	}{
+		{name: "no questions", stats: attempts.QuestionAttemptsStats{}, want: 100},
+		{name: "all correct", stats: attempts.QuestionAttemptsStats{Total: 2, Successful: 2, ScoreSum: 200}, want: 100},
+		{name: "partial scores", stats: attempts.QuestionAttemptsStats{Total: 3, Successful: 1, ScoreSum: 150}, want: 50},
+		{name: "none correct", stats: attempts.QuestionAttemptsStats{Total: 2}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentageScore(tt.stats); got != tt.want {
+				t.Errorf("percentageScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
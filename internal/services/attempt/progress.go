@@ -0,0 +1,153 @@
+package attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+)
+
+// GetPlanProgress rolls lesson attempts of the user up into the progress on the plan.
+func (ah *AttemptHandlers) GetPlanProgress(ctx context.Context, userID, planID int64) (attempts.PlanProgress, error) {
+	const op = "attempt.GetPlanProgress"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("user id", userID),
+		slog.Int64("plan id", planID),
+	)
+
+	// Validation
+	if userID <= 0 || planID <= 0 {
+		log.Warn("invalid parameters")
+		return attempts.PlanProgress{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("getting plan progress")
+
+	plan, err := ah.progressProvider.GetPlanInfo(ctx, planID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPlanNotFound) {
+			ah.log.Warn("plan not found", slog.String("err", err.Error()))
+			return attempts.PlanProgress{}, fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		}
+
+		log.Error("failed to get plan", slog.String("err", err.Error()))
+		return attempts.PlanProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	progress, err := ah.planProgress(ctx, userID, plan, nil)
+	if err != nil {
+		log.Error("failed to get plan progress", slog.String("err", err.Error()))
+		return attempts.PlanProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return progress, nil
+}
+
+// GetChannelProgress rolls lesson attempts of the user up into the progress on
+// every plan of the channel and on the channel as a whole.
+func (ah *AttemptHandlers) GetChannelProgress(ctx context.Context, userID, channelID int64) (attempts.ChannelProgress, error) {
+	const op = "attempt.GetChannelProgress"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.Int64("user id", userID),
+		slog.Int64("channel id", channelID),
+	)
+
+	// Validation
+	if userID <= 0 || channelID <= 0 {
+		log.Warn("invalid parameters")
+		return attempts.ChannelProgress{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("getting channel progress")
+
+	plans, err := ah.progressProvider.GetChannelPlansInfo(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, storage.ErrChannelNotFound) {
+			ah.log.Warn("channel not found", slog.String("err", err.Error()))
+			return attempts.ChannelProgress{}, fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		}
+
+		log.Error("failed to get channel plans", slog.String("err", err.Error()))
+		return attempts.ChannelProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	progress := attempts.ChannelProgress{
+		ChannelID: channelID,
+	}
+	for _, plan := range plans {
+		pProgress, err := ah.planProgress(ctx, userID, plan, &channelID)
+		if err != nil {
+			log.Error("failed to get plan progress", slog.String("err", err.Error()))
+			return attempts.ChannelProgress{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		progress.TotalLessons += pProgress.TotalLessons
+		progress.PassedLessons += pProgress.PassedLessons
+		progress.Plans = append(progress.Plans, pProgress)
+	}
+	progress.CompletionPercentage = completionPercentage(progress.PassedLessons, progress.TotalLessons)
+
+	return progress, nil
+}
+
+func (ah *AttemptHandlers) planProgress(ctx context.Context, userID int64, plan attempts.PlanInfo, channelID *int64) (attempts.PlanProgress, error) {
+	summaries, err := ah.progressProvider.GetLessonAttemptsSummaries(ctx, userID, plan.ID, channelID)
+	if err != nil {
+		return attempts.PlanProgress{}, err
+	}
+
+	progress := attempts.PlanProgress{
+		PlanID:       plan.ID,
+		PlanName:     plan.Name,
+		TotalLessons: int64(len(summaries)),
+	}
+	for _, summary := range summaries {
+		status := lessonStatus(summary)
+		if status == attempts.LessonStatusPassed {
+			progress.PassedLessons++
+		}
+
+		progress.Lessons = append(progress.Lessons, attempts.LessonProgress{
+			LessonID:      summary.LessonID,
+			LessonName:    summary.LessonName,
+			Status:        status,
+			BestScore:     summary.BestScore,
+			AttemptsCount: summary.AttemptsCount,
+			LastAttemptAt: summary.LastAttemptAt,
+		})
+	}
+	progress.CompletionPercentage = completionPercentage(progress.PassedLessons, progress.TotalLessons)
+
+	return progress, nil
+}
+
+// lessonStatus derives the status of the lesson from the attempts of the user.
+// A passed lesson stays passed even if the user makes a new attempt.
+func lessonStatus(summary attempts.LessonAttemptsSummary) string {
+	switch {
+	case summary.Passed:
+		return attempts.LessonStatusPassed
+	case summary.OpenAttempts > 0:
+		return attempts.LessonStatusInProgress
+	case summary.AttemptsCount > 0:
+		return attempts.LessonStatusFailed
+	default:
+		return attempts.LessonStatusNotStarted
+	}
+}
+
+// completionPercentage returns the share of passed lessons. There is nothing
+// to complete in an empty plan, so it is reported as 0%.
+func completionPercentage(passed, total int64) int64 {
+	if total == 0 {
+		return 0
+	}
+	return passed * 100 / total
+}
@@ -0,0 +1,73 @@
+package attempt
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+)
+
+// pagePositions returns positions of the lesson pages within a new attempt keyed by page ID.
+// Pages keep their order within the lesson. When shuffle is set, question pages are permuted
+// among the slots taken by question pages, so content pages stay in place.
+func pagePositions(qPages []attempts.QuestionPage, cPages []attempts.ContentPage, shuffle bool) map[int64]int64 {
+	type orderedPage struct {
+		id       int64
+		position int64
+	}
+
+	questionIDs := make(map[int64]bool, len(qPages))
+	ordered := make([]orderedPage, 0, len(qPages)+len(cPages))
+	for _, qPage := range qPages {
+		questionIDs[qPage.PageID] = true
+		ordered = append(ordered, orderedPage{id: qPage.PageID, position: qPage.Position})
+	}
+	for _, cPage := range cPages {
+		ordered = append(ordered, orderedPage{id: cPage.PageID, position: cPage.Position})
+	}
+	slices.SortFunc(ordered, func(a, b orderedPage) int {
+		return cmp.Or(cmp.Compare(a.position, b.position), cmp.Compare(a.id, b.id))
+	})
+
+	pageIDs := make([]int64, len(ordered))
+	for i, page := range ordered {
+		pageIDs[i] = page.id
+	}
+
+	if shuffle {
+		var slots []int
+		for i, id := range pageIDs {
+			if questionIDs[id] {
+				slots = append(slots, i)
+			}
+		}
+		rand.Shuffle(len(slots), func(i, j int) {
+			pageIDs[slots[i]], pageIDs[slots[j]] = pageIDs[slots[j]], pageIDs[slots[i]]
+		})
+	}
+
+	positions := make(map[int64]int64, len(pageIDs))
+	for i, id := range pageIDs {
+		positions[id] = int64(i + 1)
+	}
+
+	return positions
+}
+
+// optionOrder returns option IDs of the question in the order they are shown in the attempt.
+// Answers always refer to option IDs, so grading does not depend on the order.
+func optionOrder(optionIDs []int64, shuffle bool) []int64 {
+	if len(optionIDs) == 0 {
+		return nil
+	}
+
+	order := slices.Clone(optionIDs)
+	if shuffle {
+		rand.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+	}
+
+	return order
+}
@@ -0,0 +1,124 @@
+package attempt
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
+)
+
+func TestPagePositions(t *testing.T) {
+	tests := []struct {
+		name   string
+		qPages []attempts.QuestionPage
+		cPages []attempts.ContentPage
+		want   map[int64]int64
+	}{
+		{
+			name: "no pages",
+			want: map[int64]int64{},
+		},
+		{
+			name:   "sorted by position",
+			qPages: []attempts.QuestionPage{{PageID: 10, Position: 3}, {PageID: 11, Position: 1}},
+			cPages: []attempts.ContentPage{{PageID: 20, Position: 2}},
+			want:   map[int64]int64{11: 1, 20: 2, 10: 3},
+		},
+		{
+			name:   "equal positions sorted by page ID",
+			qPages: []attempts.QuestionPage{{PageID: 12, Position: 1}},
+			cPages: []attempts.ContentPage{{PageID: 5, Position: 1}, {PageID: 7, Position: 0}},
+			want:   map[int64]int64{7: 1, 5: 2, 12: 3},
+		},
+		{
+			name:   "gaps in positions are closed",
+			qPages: []attempts.QuestionPage{{PageID: 1, Position: 10}},
+			cPages: []attempts.ContentPage{{PageID: 2, Position: 40}},
+			want:   map[int64]int64{1: 1, 2: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pagePositions(tt.qPages, tt.cPages, false)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("pagePositions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPagePositionsShuffle(t *testing.T) {
+	// Content pages take positions 1, 3 and 6, question pages take the rest.
+	qPages := []attempts.QuestionPage{
+		{PageID: 101, Position: 2},
+		{PageID: 102, Position: 4},
+		{PageID: 103, Position: 5},
+		{PageID: 104, Position: 7},
+	}
+	cPages := []attempts.ContentPage{
+		{PageID: 201, Position: 1},
+		{PageID: 202, Position: 3},
+		{PageID: 203, Position: 6},
+	}
+	questionSlots := []int64{2, 4, 5, 7}
+
+	for range 50 {
+		got := pagePositions(qPages, cPages, true)
+
+		for _, cPage := range cPages {
+			if got[cPage.PageID] != cPage.Position {
+				t.Fatalf("content page %d moved to %d, want %d", cPage.PageID, got[cPage.PageID], cPage.Position)
+			}
+		}
+
+		var positions []int64
+		for _, qPage := range qPages {
+			positions = append(positions, got[qPage.PageID])
+		}
+		slices.Sort(positions)
+		if !slices.Equal(positions, questionSlots) {
+			t.Fatalf("question pages take positions %v, want %v", positions, questionSlots)
+		}
+	}
+}
+
+func TestOptionOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		optionIDs []int64
+		shuffle   bool
+	}{
+		{name: "nil", optionIDs: nil},
+		{name: "empty with shuffle", optionIDs: []int64{}, shuffle: true},
+		{name: "keeps order", optionIDs: []int64{3, 1, 2}},
+		{name: "shuffled", optionIDs: []int64{3, 1, 2, 5, 4}, shuffle: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := slices.Clone(tt.optionIDs)
+			got := optionOrder(tt.optionIDs, tt.shuffle)
+
+			if !slices.Equal(tt.optionIDs, input) {
+				t.Fatalf("optionOrder() changed input to %v, want %v", tt.optionIDs, input)
+			}
+			if len(tt.optionIDs) == 0 {
+				if got != nil {
+					t.Fatalf("optionOrder() = %v, want nil", got)
+				}
+				return
+			}
+			if !tt.shuffle && !slices.Equal(got, tt.optionIDs) {
+				t.Fatalf("optionOrder() = %v, want %v", got, tt.optionIDs)
+			}
+
+			sortedGot := slices.Sorted(slices.Values(got))
+			sortedWant := slices.Sorted(slices.Values(tt.optionIDs))
+			if !slices.Equal(sortedGot, sortedWant) {
+				t.Errorf("optionOrder() = %v, want a permutation of %v", got, tt.optionIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package bank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/banks"
+	"github.com/DimTur/lp_learning_platform/internal/utils"
+	"github.com/go-playground/validator/v10"
+)
+
+type BankSaver interface {
+	CreateBank(ctx context.Context, bank banks.CreateBank) (int64, error)
+	AddBankItem(ctx context.Context, item banks.AddBankItem) (int64, error)
+	SetLessonDraws(ctx context.Context, draws banks.SetLessonDraws) error
+}
+
+type BankProvider interface {
+	GetBankByID(ctx context.Context, bankID int64) (banks.BankWithItems, error)
+	GetBanks(ctx context.Context, filter banks.BanksFilter, limit, offset int64) ([]banks.Bank, error)
+	GetLessonDraws(ctx context.Context, lessonID int64) ([]banks.QuestionDraw, error)
+}
+
+type BankDel interface {
+	DeleteBank(ctx context.Context, id int64) error
+	RemoveBankItem(ctx context.Context, bankID, pageID int64) error
+}
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrBankNotFound       = errors.New("question bank not found")
+	ErrLessonNotFound     = errors.New("lesson not found")
+	ErrChannelNotFound    = errors.New("channel not found")
+	ErrPageNotFound       = errors.New("question page not found")
+	ErrQuestionInBank     = errors.New("question already in bank")
+)
+
+type BankHandlers struct {
+	log          *slog.Logger
+	validator    *validator.Validate
+	bankSaver    BankSaver
+	bankProvider BankProvider
+	bankDel      BankDel
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	bankSaver BankSaver,
+	bankProvider BankProvider,
+	bankDel BankDel,
+) *BankHandlers {
+	return &BankHandlers{
+		log:          log,
+		validator:    validator,
+		bankSaver:    bankSaver,
+		bankProvider: bankProvider,
+		bankDel:      bankDel,
+	}
+}
+
+// CreateQuestionBank creates new question bank of the lesson or the channel and returns bank ID.
+func (bh *BankHandlers) CreateQuestionBank(ctx context.Context, bank banks.CreateBank) (int64, error) {
+	const op = "bank.CreateQuestionBank"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.String("name", bank.Name),
+	)
+
+	// Validation
+	err := bh.validator.Struct(bank)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	now := time.Now()
+	bank.CreatedAt = now
+	bank.Modified = now
+
+	log.Info("creating question bank")
+
+	id, err := bh.bankSaver.CreateBank(ctx, bank)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
+			log.Warn("invalid arguments", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			log.Warn("channel not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		}
+
+		log.Error("failed to save question bank", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetQuestionBank gets question bank with its questions by ID and returns it.
+func (bh *BankHandlers) GetQuestionBank(ctx context.Context, bankID int64) (banks.BankWithItems, error) {
+	const op = "bank.GetQuestionBank"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("bank id", bankID),
+	)
+
+	log.Info("getting question bank")
+
+	bank, err := bh.bankProvider.GetBankByID(ctx, bankID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBankNotFound) {
+			log.Warn("question bank not found", slog.String("err", err.Error()))
+			return bank, fmt.Errorf("%s: %w", op, ErrBankNotFound)
+		}
+
+		log.Error("failed to get question bank", slog.String("err", err.Error()))
+		return bank, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bank, nil
+}
+
+// GetQuestionBanks gets question banks of the lesson or the channel and returns them.
+func (bh *BankHandlers) GetQuestionBanks(ctx context.Context, filter banks.BanksFilter, limit, offset int64) ([]banks.Bank, error) {
+	const op = "bank.GetQuestionBanks"
+
+	log := bh.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("getting question banks")
+
+	// Validation
+	params := utils.PaginationQueryParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+	params.SetDefaults()
+
+	if err := bh.validator.Struct(params); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	banks, err := bh.bankProvider.GetBanks(ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		log.Error("failed to get question banks", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return banks, nil
+}
+
+// DeleteQuestionBank deletes the question bank with its questions and draw rules.
+// Questions drawn from the bank in past attempts are kept.
+func (bh *BankHandlers) DeleteQuestionBank(ctx context.Context, bankID int64) error {
+	const op = "bank.DeleteQuestionBank"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("bank id", bankID),
+	)
+
+	log.Info("deleting question bank")
+
+	err := bh.bankDel.DeleteBank(ctx, bankID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBankNotFound) {
+			log.Warn("question bank not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrBankNotFound)
+		}
+
+		log.Error("failed to delete question bank", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddQuestionToBank adds the question page to the bank and returns ID of the bank item.
+func (bh *BankHandlers) AddQuestionToBank(ctx context.Context, item banks.AddBankItem) (int64, error) {
+	const op = "bank.AddQuestionToBank"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("bank id", item.BankID),
+		slog.Int64("page id", item.PageID),
+	)
+
+	// Validation
+	err := bh.validator.Struct(item)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("adding question to bank")
+
+	id, err := bh.bankSaver.AddBankItem(ctx, item)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBankNotFound):
+			log.Warn("question bank not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrBankNotFound)
+		case errors.Is(err, storage.ErrPageNotFound):
+			log.Warn("question page not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPageNotFound)
+		case errors.Is(err, storage.ErrQuestionInBank):
+			log.Warn("question already in bank", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrQuestionInBank)
+		}
+
+		log.Error("failed to add question to bank", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// RemoveQuestionFromBank removes the question page from the bank.
+func (bh *BankHandlers) RemoveQuestionFromBank(ctx context.Context, bankID, pageID int64) error {
+	const op = "bank.RemoveQuestionFromBank"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("bank id", bankID),
+		slog.Int64("page id", pageID),
+	)
+
+	log.Info("removing question from bank")
+
+	err := bh.bankDel.RemoveBankItem(ctx, bankID, pageID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPageNotFound) {
+			log.Warn("question not found in bank", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPageNotFound)
+		}
+
+		log.Error("failed to remove question from bank", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetLessonQuestionDraws replaces draw rules of the lesson. A lesson with draw rules
+// gets its question pages drawn from banks in every attempt instead of taking
+// all its question pages. An empty list turns drawing off.
+func (bh *BankHandlers) SetLessonQuestionDraws(ctx context.Context, draws banks.SetLessonDraws) error {
+	const op = "bank.SetLessonQuestionDraws"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", draws.LessonID),
+	)
+
+	// Validation
+	err := bh.validator.Struct(draws)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("setting lesson question draws", slog.Int("draws", len(draws.Draws)))
+
+	err = bh.bankSaver.SetLessonDraws(ctx, draws)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBankNotFound):
+			log.Warn("question bank not available for lesson", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrBankNotFound)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		}
+
+		log.Error("failed to set lesson question draws", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetLessonQuestionDraws gets draw rules of the lesson and returns them.
+func (bh *BankHandlers) GetLessonQuestionDraws(ctx context.Context, lessonID int64) ([]banks.QuestionDraw, error) {
+	const op = "bank.GetLessonQuestionDraws"
+
+	log := bh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", lessonID),
+	)
+
+	log.Info("getting lesson question draws")
+
+	draws, err := bh.bankProvider.GetLessonDraws(ctx, lessonID)
+	if err != nil {
+		log.Error("failed to get lesson question draws", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return draws, nil
+}
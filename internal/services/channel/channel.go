@@ -21,6 +21,7 @@ type ChannelSaver interface {
 type ChannelProvider interface {
 	GetChannelByID(ctx context.Context, channelID int64) (channels.ChannelWithPlans, error)
 	GetChannels(ctx context.Context, limit, offset int64) ([]channels.Channel, error)
+	GetPlanChannels(ctx context.Context, planID int64) ([]channels.Channel, error)
 }
 
 type ChannelDel interface {
@@ -32,6 +33,7 @@ var (
 	ErrInvalidChannelID   = errors.New("invalid channel id")
 	ErrChannelExitsts     = errors.New("channel already exists")
 	ErrChannelNotFound    = errors.New("channel not found")
+	ErrPlanNotFound       = errors.New("plan not found")
 )
 
 type ChannelHandlers struct {
@@ -190,7 +192,8 @@ func (chh *ChannelHandlers) UpdateChannel(ctx context.Context, updChannel channe
 	return id, nil
 }
 
-// DeleteChannel
+// DeleteChannel deletes the channel. Its plans are deleted only when they
+// are not attached to any other channel.
 func (chh *ChannelHandlers) DeleteChannel(ctx context.Context, channelID int64) error {
 	const op = "channel.DeleteChannel"
 
@@ -214,3 +217,28 @@ func (chh *ChannelHandlers) DeleteChannel(ctx context.Context, channelID int64)
 
 	return nil
 }
+
+// GetPlanChannels gets channels the plan is attached to and returns them.
+func (chh *ChannelHandlers) GetPlanChannels(ctx context.Context, planID int64) ([]channels.Channel, error) {
+	const op = "channel.GetPlanChannels"
+
+	log := chh.log.With(
+		slog.String("op", op),
+		slog.Int64("plan id", planID),
+	)
+
+	log.Info("getting plan channels")
+
+	channels, err := chh.channelProvider.GetPlanChannels(ctx, planID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPlanNotFound) {
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		}
+
+		log.Error("failed to get plan channels", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return channels, nil
+}
@@ -16,6 +16,10 @@ import (
 type LessonSaver interface {
 	CreateLesson(ctx context.Context, lesson lessons.CreateLesson) (int64, error)
 	UpdateLesson(ctx context.Context, updLesson lessons.UpdateLessonRequest) (int64, error)
+	ReorderLessons(ctx context.Context, reorder lessons.ReorderLessons) error
+	AttachLessonToPlan(ctx context.Context, attach lessons.LessonInPlan) error
+	CloneLesson(ctx context.Context, clone lessons.CloneLesson) (int64, error)
+	MoveLesson(ctx context.Context, move lessons.MoveLesson) error
 }
 
 type LessonProvider interface {
@@ -24,6 +28,7 @@ type LessonProvider interface {
 }
 type LessonDel interface {
 	DeleteLesson(ctx context.Context, id int64) error
+	DetachLessonFromPlan(ctx context.Context, detach lessons.LessonInPlan) error
 }
 
 var (
@@ -31,6 +36,9 @@ var (
 	ErrInvalidLessonID    = errors.New("invalid lesson id")
 	ErrLessonExitsts      = errors.New("lesson already exists")
 	ErrLessonNotFound     = errors.New("lesson not found")
+	ErrPlanNotFound       = errors.New("plan not found")
+	ErrLessonInPlan       = errors.New("lesson already in plan")
+	ErrLastLessonPlan     = errors.New("lesson is not in any other plan")
 )
 
 type LessonHandlers struct {
@@ -81,9 +89,16 @@ func (lh *LessonHandlers) CreateLesson(ctx context.Context, lesson lessons.Creat
 
 	id, err := lh.lessonSaver.CreateLesson(ctx, lesson)
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			lh.log.Warn("invalid arguments", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			lh.log.Warn("plan not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrLessonExitsts):
+			lh.log.Warn("lesson name taken in plan", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonExitsts)
 		}
 
 		log.Error("failed to save lesson", slog.String("err", err.Error()))
@@ -177,9 +192,13 @@ func (lh *LessonHandlers) UpdateLesson(ctx context.Context, updLesson lessons.Up
 
 	id, err := lh.lessonSaver.UpdateLesson(ctx, updLesson)
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			lh.log.Warn("invalid credentials", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrLessonExitsts):
+			lh.log.Warn("lesson name taken in plan", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonExitsts)
 		}
 
 		log.Error("failed to update lesson", slog.String("err", err.Error()))
@@ -215,3 +234,210 @@ func (lh *LessonHandlers) DeleteLesson(ctx context.Context, lessonID int64) erro
 
 	return nil
 }
+
+// ReorderLessons sets the order of the plan lessons.
+func (lh *LessonHandlers) ReorderLessons(ctx context.Context, reorder lessons.ReorderLessons) error {
+	const op = "lessons.ReorderLessons"
+
+	log := lh.log.With(
+		slog.String("op", op),
+		slog.Int64("plan id", reorder.PlanID),
+	)
+
+	// Validation
+	err := lh.validator.Struct(reorder)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("reordering lessons")
+
+	err = lh.lessonSaver.ReorderLessons(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
+			log.Warn("ordered ids don't match plan lessons", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		}
+
+		log.Error("failed to reorder lessons", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AttachLessonToPlan adds the existing lesson to the end of the plan,
+// so one lesson can be shared by several plans.
+func (lh *LessonHandlers) AttachLessonToPlan(ctx context.Context, attach lessons.LessonInPlan) error {
+	const op = "lessons.AttachLessonToPlan"
+
+	log := lh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", attach.LessonID),
+		slog.Int64("plan id", attach.PlanID),
+	)
+
+	// Validation
+	err := lh.validator.Struct(attach)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("attaching lesson to plan")
+
+	err = lh.lessonSaver.AttachLessonToPlan(ctx, attach)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrLessonInPlan):
+			log.Warn("lesson already in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonInPlan)
+		case errors.Is(err, storage.ErrLessonExitsts):
+			log.Warn("lesson name taken in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonExitsts)
+		}
+
+		log.Error("failed to attach lesson to plan", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DetachLessonFromPlan removes the lesson from the plan. The lesson itself and
+// attempts taken in the plan are kept.
+func (lh *LessonHandlers) DetachLessonFromPlan(ctx context.Context, detach lessons.LessonInPlan) error {
+	const op = "lessons.DetachLessonFromPlan"
+
+	log := lh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", detach.LessonID),
+		slog.Int64("plan id", detach.PlanID),
+	)
+
+	// Validation
+	err := lh.validator.Struct(detach)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("detaching lesson from plan")
+
+	err = lh.lessonDel.DetachLessonFromPlan(ctx, detach)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrLastLessonPlan):
+			log.Warn("lesson is not in any other plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLastLessonPlan)
+		}
+
+		log.Error("failed to detach lesson from plan", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CloneLesson copies the lesson with its pages and questions to the end of the plan
+// and returns ID of the copy. The caller becomes the author of the copy.
+func (lh *LessonHandlers) CloneLesson(ctx context.Context, clone lessons.CloneLesson) (int64, error) {
+	const op = "lessons.CloneLesson"
+
+	log := lh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", clone.LessonID),
+		slog.Int64("plan id", clone.PlanID),
+	)
+
+	// Validation
+	err := lh.validator.Struct(clone)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("cloning lesson")
+
+	id, err := lh.lessonSaver.CloneLesson(ctx, clone)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrLessonExitsts):
+			log.Warn("lesson name taken in plan", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonExitsts)
+		}
+
+		log.Error("failed to clone lesson", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// MoveLesson moves the lesson from one plan to the end of another one.
+// Pages of the lesson are kept, past attempts stay recorded for the source plan.
+func (lh *LessonHandlers) MoveLesson(ctx context.Context, move lessons.MoveLesson) error {
+	const op = "lessons.MoveLesson"
+
+	log := lh.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", move.LessonID),
+		slog.Int64("from plan id", move.FromPlanID),
+		slog.Int64("to plan id", move.ToPlanID),
+	)
+
+	// Validation
+	err := lh.validator.Struct(move)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("moving lesson")
+
+	err = lh.lessonSaver.MoveLesson(ctx, move)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrLessonInPlan):
+			log.Warn("lesson already in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonInPlan)
+		case errors.Is(err, storage.ErrLessonExitsts):
+			log.Warn("lesson name taken in plan", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonExitsts)
+		}
+
+		log.Error("failed to move lesson", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
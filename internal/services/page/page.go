@@ -15,6 +15,8 @@ import (
 type PageSaver interface {
 	CreatePage(ctx context.Context, page pages.CreatePage) (int64, error)
 	UpdatePage(ctx context.Context, updPage pages.UpdatePage) (int64, error)
+	ReorderPages(ctx context.Context, reorder pages.ReorderPages) (int64, error)
+	MovePage(ctx context.Context, move pages.MovePage) error
 }
 
 type PageProvider interface {
@@ -31,6 +33,8 @@ var (
 	ErrPageExitsts        = errors.New("page already exists")
 	ErrPageNotFound       = errors.New("page not found")
 	ErrUnContType         = errors.New("unsupported content type")
+	ErrLessonNotFound     = errors.New("lesson not found")
+	ErrPagesOrderChanged  = errors.New("page order changed")
 )
 
 type PageHandlers struct {
@@ -78,9 +82,13 @@ func (ph *PageHandlers) CreatePage(ctx context.Context, page pages.CreatePage) (
 
 	id, err := ph.pageSaver.CreatePage(ctx, page)
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			ph.log.Warn("invalid arguments", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			ph.log.Warn("lesson not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
 		}
 
 		log.Error("failed to save page", slog.String("err", err.Error()))
@@ -189,6 +197,87 @@ func (ph *PageHandlers) UpdatePage(ctx context.Context, updPage pages.UpdatePage
 	return id, nil
 }
 
+// ReorderPages sets the order of the lesson pages and returns the new version of page order.
+// The version must match the current one, otherwise the order was changed concurrently.
+func (ph *PageHandlers) ReorderPages(ctx context.Context, reorder pages.ReorderPages) (int64, error) {
+	const op = "page.ReorderPages"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("lesson id", reorder.LessonID),
+	)
+
+	// Validation
+	err := ph.validator.Struct(reorder)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("reordering pages")
+
+	version, err := ph.pageSaver.ReorderPages(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
+			log.Warn("ordered ids don't match lesson pages", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPagesOrderChanged):
+			log.Warn("page order changed concurrently", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPagesOrderChanged)
+		}
+
+		log.Error("failed to reorder pages", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+// MovePage moves the page to the target lesson at the given position.
+// Attempts which reference the page stay valid.
+func (ph *PageHandlers) MovePage(ctx context.Context, move pages.MovePage) error {
+	const op = "page.MovePage"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("page id", move.PageID),
+		slog.Int64("target lesson id", move.TargetLessonID),
+	)
+
+	// Validation
+	err := ph.validator.Struct(move)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("moving page")
+
+	err = ph.pageSaver.MovePage(ctx, move)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPageNotFound):
+			log.Warn("page not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPageNotFound)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			log.Warn("lesson not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLessonNotFound)
+		case errors.Is(err, storage.ErrPagesOrderChanged):
+			log.Warn("page moved concurrently", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPagesOrderChanged)
+		}
+
+		log.Error("failed to move page", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 // DeletePage
 func (ph *PageHandlers) DeletePage(ctx context.Context, pageID int64) error {
 	const op = "page.DeletePage"
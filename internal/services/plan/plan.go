@@ -16,6 +16,9 @@ import (
 type PlanSaver interface {
 	CreatePlan(ctx context.Context, plan plans.CreatePlan) (int64, error)
 	UpdatePlan(ctx context.Context, updPlan plans.UpdatePlanRequest) (int64, error)
+	ReorderPlans(ctx context.Context, reorder plans.ReorderPlans) error
+	AttachPlanToChannel(ctx context.Context, attach plans.PlanInChannel) error
+	ClonePlan(ctx context.Context, clone plans.ClonePlan) (int64, error)
 }
 
 type PlanProvider interface {
@@ -24,6 +27,7 @@ type PlanProvider interface {
 }
 type PlanDel interface {
 	DeletePlan(ctx context.Context, id int64) error
+	DetachPlanFromChannel(ctx context.Context, detach plans.PlanInChannel) error
 }
 
 var (
@@ -31,6 +35,9 @@ var (
 	ErrInvalidPlanID      = errors.New("invalid plan id")
 	ErrPlanExitsts        = errors.New("plan already exists")
 	ErrPlanNotFound       = errors.New("plan not found")
+	ErrChannelNotFound    = errors.New("channel not found")
+	ErrPlanInChannel      = errors.New("plan already in channel")
+	ErrLastPlanChannel    = errors.New("plan is not in any other channel")
 )
 
 type PlanHandlers struct {
@@ -83,9 +90,13 @@ func (ph *PlanHandlers) CreatePlan(ctx context.Context, plan plans.CreatePlan) (
 
 	id, err := ph.planSaver.CreatePlan(ctx, plan)
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			ph.log.Warn("invalid arguments", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			ph.log.Warn("channel not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrChannelNotFound)
 		}
 
 		log.Error("failed to save plan", slog.String("err", err.Error()))
@@ -216,3 +227,162 @@ func (ph *PlanHandlers) DeletePlan(ctx context.Context, planID int64) error {
 
 	return nil
 }
+
+// ReorderPlans sets the order of the channel plans.
+func (ph *PlanHandlers) ReorderPlans(ctx context.Context, reorder plans.ReorderPlans) error {
+	const op = "plans.ReorderPlans"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("channel id", reorder.ChannelID),
+	)
+
+	// Validation
+	err := ph.validator.Struct(reorder)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("reordering plans")
+
+	err = ph.planSaver.ReorderPlans(ctx, reorder)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
+			log.Warn("ordered ids don't match channel plans", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			log.Warn("channel not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		}
+
+		log.Error("failed to reorder plans", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AttachPlanToChannel adds the existing plan to the end of the channel,
+// so one plan can be shared by several channels.
+func (ph *PlanHandlers) AttachPlanToChannel(ctx context.Context, attach plans.PlanInChannel) error {
+	const op = "plan.AttachPlanToChannel"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("plan id", attach.PlanID),
+		slog.Int64("channel id", attach.ChannelID),
+	)
+
+	// Validation
+	err := ph.validator.Struct(attach)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("attaching plan to channel")
+
+	err = ph.planSaver.AttachPlanToChannel(ctx, attach)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			log.Warn("channel not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		case errors.Is(err, storage.ErrPlanInChannel):
+			log.Warn("plan already in channel", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanInChannel)
+		}
+
+		log.Error("failed to attach plan to channel", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DetachPlanFromChannel removes the plan from the channel. The plan itself and
+// its lessons are kept, a plan which is in no other channel can't be detached.
+func (ph *PlanHandlers) DetachPlanFromChannel(ctx context.Context, detach plans.PlanInChannel) error {
+	const op = "plan.DetachPlanFromChannel"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("plan id", detach.PlanID),
+		slog.Int64("channel id", detach.ChannelID),
+	)
+
+	// Validation
+	err := ph.validator.Struct(detach)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("detaching plan from channel")
+
+	err = ph.planDel.DetachPlanFromChannel(ctx, detach)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found in channel", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			log.Warn("channel not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		case errors.Is(err, storage.ErrLastPlanChannel):
+			log.Warn("plan is not in any other channel", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLastPlanChannel)
+		}
+
+		log.Error("failed to detach plan from channel", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClonePlan copies the plan with its lessons, pages and questions to the end
+// of the channel and returns ID of the copy. The caller becomes the author of the copy.
+func (ph *PlanHandlers) ClonePlan(ctx context.Context, clone plans.ClonePlan) (int64, error) {
+	const op = "plan.ClonePlan"
+
+	log := ph.log.With(
+		slog.String("op", op),
+		slog.Int64("plan id", clone.PlanID),
+		slog.String("name", clone.Name),
+	)
+
+	// Validation
+	err := ph.validator.Struct(clone)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("cloning plan")
+
+	id, err := ph.planSaver.ClonePlan(ctx, clone)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPlanNotFound):
+			log.Warn("plan not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPlanNotFound)
+		case errors.Is(err, storage.ErrChannelNotFound):
+			log.Warn("channel not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrChannelNotFound)
+		case errors.Is(err, storage.ErrPlanExitsts):
+			log.Warn("plan name taken", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrPlanExitsts)
+		}
+
+		log.Error("failed to clone plan", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,145 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+)
+
+// GetLearnerQuestionPage gets question page as it is shown to the learner.
+// The explanation, option feedback and correct answers are hidden until
+// the learner has answered the question in their latest attempt which contains it.
+func (qph QuestionPageHandlers) GetLearnerQuestionPage(ctx context.Context, pageID, userID int64) (questions.QuestionPage, error) {
+	const op = "question.GetLearnerQuestionPage"
+
+	log := qph.log.With(
+		slog.String("op", op),
+		slog.Int64("pageID", pageID),
+		slog.Int64("userID", userID),
+	)
+
+	log.Info("getting question page for learner")
+
+	questionPage, err := qph.questionPageProvider.GetQuestionPageByID(ctx, pageID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPageNotFound) {
+			qph.log.Warn("question page not found", slog.String("err", err.Error()))
+			return questionPage, ErrPageNotFound
+		}
+
+		log.Error("failed to get question page", slog.String("err", err.Error()))
+		return questionPage, fmt.Errorf("%s: %w", op, err)
+	}
+
+	answered, err := qph.questionPageProvider.HasUserAnswered(ctx, pageID, userID)
+	if err != nil {
+		log.Error("failed to check user answer", slog.String("err", err.Error()))
+		return questions.QuestionPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !answered {
+		hideAnswers(&questionPage)
+	}
+
+	return questionPage, nil
+}
+
+// GetLearnerQuestionPages gets question pages of the lesson as they are shown to the learner.
+// Answers are hidden on every page the learner has not answered yet.
+func (qph QuestionPageHandlers) GetLearnerQuestionPages(ctx context.Context, lessonID, userID int64, limit, offset int64) ([]questions.QuestionPage, error) {
+	const op = "question.GetLearnerQuestionPages"
+
+	log := qph.log.With(
+		slog.String("op", op),
+		slog.Int64("lessonID", lessonID),
+		slog.Int64("userID", userID),
+	)
+
+	questionPages, err := qph.GetQuestionPages(ctx, lessonID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(questionPages) == 0 {
+		return questionPages, nil
+	}
+
+	pageIDs := make([]int64, 0, len(questionPages))
+	for _, page := range questionPages {
+		pageIDs = append(pageIDs, page.ID)
+	}
+
+	answered, err := qph.questionPageProvider.GetAnsweredPageIDs(ctx, pageIDs, userID)
+	if err != nil {
+		log.Error("failed to check user answers", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range questionPages {
+		if !answered[questionPages[i].ID] {
+			hideAnswers(&questionPages[i])
+		}
+	}
+
+	return questionPages, nil
+}
+
+// hideAnswers removes everything that gives the answer away while keeping
+// what the learner needs to answer the question.
+func hideAnswers(page *questions.QuestionPage) {
+	page.Explanation = ""
+
+	options := make([]questions.Option, 0, len(page.Options))
+	for _, option := range page.Options {
+		options = append(options, questions.Option{
+			ID:   option.ID,
+			Text: option.Text,
+		})
+	}
+	page.Options = options
+
+	if page.ShortAnswer != nil {
+		page.ShortAnswer = &questions.ShortAnswerQuestion{
+			MatchMode:      page.ShortAnswer.MatchMode,
+			TrimWhitespace: page.ShortAnswer.TrimWhitespace,
+		}
+	}
+
+	page.TrueFalse = nil
+	page.Numeric = nil
+
+	if page.FillBlanks != nil {
+		page.FillBlanks = &questions.FillBlanksQuestion{
+			Blanks:         make([]questions.FillBlank, len(page.FillBlanks.Blanks)),
+			MatchMode:      page.FillBlanks.MatchMode,
+			TrimWhitespace: page.FillBlanks.TrimWhitespace,
+		}
+	}
+
+	// Items in the correct order are the answer, so they are given sorted.
+	if page.Ordering != nil {
+		items := append([]string(nil), page.Ordering.Items...)
+		sort.Strings(items)
+		page.Ordering = &questions.OrderingQuestion{Items: items}
+	}
+
+	// Right items are sorted separately, so the pairs no longer match.
+	if page.Matching != nil {
+		rights := make([]string, 0, len(page.Matching.Pairs))
+		for _, pair := range page.Matching.Pairs {
+			rights = append(rights, pair.Right)
+		}
+		sort.Strings(rights)
+
+		pairs := make([]questions.MatchingPair, 0, len(page.Matching.Pairs))
+		for i, pair := range page.Matching.Pairs {
+			pairs = append(pairs, questions.MatchingPair{Left: pair.Left, Right: rights[i]})
+		}
+		page.Matching = &questions.MatchingQuestion{Pairs: pairs}
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"regexp"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
 	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+	"github.com/DimTur/lp_learning_platform/internal/utils"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -18,6 +20,13 @@ type QuestionPageSaver interface {
 
 type QuestionPageProvider interface {
 	GetQuestionPageByID(ctx context.Context, pageID int64) (questionPage questions.QuestionPage, err error)
+	GetQuestionPages(ctx context.Context, lessonID int64, limit, offset int64) (questionPages []questions.QuestionPage, err error)
+	HasUserAnswered(ctx context.Context, pageID, userID int64) (answered bool, err error)
+	GetAnsweredPageIDs(ctx context.Context, pageIDs []int64, userID int64) (answered map[int64]bool, err error)
+}
+
+type QuestionPageDel interface {
+	DeleteQuestionPage(ctx context.Context, pageID int64) error
 }
 
 var (
@@ -25,6 +34,8 @@ var (
 	ErrInvalidPageID      = errors.New("invalid page id")
 	ErrPageExitsts        = errors.New("page already exists")
 	ErrPageNotFound       = errors.New("page not found")
+	ErrOptionNotFound     = errors.New("option not found")
+	ErrLessonNotFound     = errors.New("lesson not found")
 )
 
 type QuestionPageHandlers struct {
@@ -32,6 +43,7 @@ type QuestionPageHandlers struct {
 	validator            *validator.Validate
 	questionPageSaver    QuestionPageSaver
 	questionPageProvider QuestionPageProvider
+	questionPageDel      QuestionPageDel
 }
 
 func New(
@@ -39,12 +51,14 @@ func New(
 	validator *validator.Validate,
 	questionPageSaver QuestionPageSaver,
 	questionPageProvider QuestionPageProvider,
+	questionPageDel QuestionPageDel,
 ) *QuestionPageHandlers {
 	return &QuestionPageHandlers{
 		log:                  log,
 		validator:            validator,
 		questionPageSaver:    questionPageSaver,
 		questionPageProvider: questionPageProvider,
+		questionPageDel:      questionPageDel,
 	}
 }
 
@@ -64,13 +78,41 @@ func (qph QuestionPageHandlers) CreateQuestionPage(ctx context.Context, question
 		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
+	if err := validateOptions(questionPage.QuestionType, questionPage.Options); err != nil {
+		log.Warn("invalid options", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if questionPage.ShortAnswer != nil && questionPage.ShortAnswer.MatchMode == questions.MatchModeRegex {
+		if err := validatePatterns(questionPage.ShortAnswer.AcceptedAnswers); err != nil {
+			log.Warn("invalid answer pattern", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+	}
+
+	if questionPage.Numeric != nil && !validRange(questionPage.Numeric.MinValue, questionPage.Numeric.MaxValue) {
+		log.Warn("invalid numeric range")
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if questionPage.FillBlanks != nil && questionPage.FillBlanks.MatchMode == questions.MatchModeRegex {
+		if err := validateBlankPatterns(questionPage.FillBlanks.Blanks); err != nil {
+			log.Warn("invalid blank pattern", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+	}
+
 	log.Info("creating question page")
 
 	id, err := qph.questionPageSaver.CreateQuestionPage(ctx, questionPage)
 	if err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
+		switch {
+		case errors.Is(err, storage.ErrInvalidCredentials):
 			qph.log.Warn("invalid arguments", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
+		case errors.Is(err, storage.ErrLessonNotFound):
+			qph.log.Warn("lesson not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrLessonNotFound)
 		}
 
 		log.Error("failed to save question page", slog.String("err", err.Error()))
@@ -128,8 +170,53 @@ func (qph QuestionPageHandlers) UpdateQuestionPage(ctx context.Context, updPage
 		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
+	if updPage.Numeric != nil && !validRange(updPage.Numeric.MinValue, updPage.Numeric.MaxValue) {
+		log.Warn("invalid numeric range")
+		return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	// Options and answer patterns are checked against the stored question,
+	// as the update may change them without the question type or match mode.
+	if len(updPage.Options) > 0 || updPage.ShortAnswer != nil || updPage.FillBlanks != nil {
+		page, err := qph.questionPageProvider.GetQuestionPageByID(ctx, updPage.ID)
+		if err != nil {
+			if errors.Is(err, storage.ErrPageNotFound) {
+				log.Warn("question page not found", slog.String("err", err.Error()))
+				return 0, fmt.Errorf("%s: %w", op, ErrPageNotFound)
+			}
+
+			log.Error("failed to get question page", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if updPage.ShortAnswer != nil {
+			if err := validatePatterns(updatedAnswerPatterns(page.ShortAnswer, updPage.ShortAnswer)); err != nil {
+				log.Warn("invalid answer pattern", slog.String("err", err.Error()))
+				return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			}
+		}
+
+		if updPage.FillBlanks != nil {
+			if err := validateBlankPatterns(updatedBlankPatterns(page.FillBlanks, updPage.FillBlanks)); err != nil {
+				log.Warn("invalid blank pattern", slog.String("err", err.Error()))
+				return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			}
+		}
+
+		if len(updPage.Options) > 0 {
+			if err := validateOptions(page.QuestionType, updPage.Options); err != nil {
+				log.Warn("invalid options", slog.String("err", err.Error()))
+				return 0, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			}
+		}
+	}
+
 	id, err := qph.questionPageSaver.UpdateQuestionPage(ctx, updPage)
 	if err != nil {
+		if errors.Is(err, storage.ErrOptionNotFound) {
+			qph.log.Warn("option not found", slog.String("err", err.Error()))
+			return 0, fmt.Errorf("%s: %w", op, ErrOptionNotFound)
+		}
 		if errors.Is(err, storage.ErrInvalidCredentials) {
 			qph.log.Warn("invalid credentials", slog.String("err", err.Error()))
 			return 0, fmt.Errorf("%s: %w", op, err)
@@ -143,3 +230,172 @@ func (qph QuestionPageHandlers) UpdateQuestionPage(ctx context.Context, updPage
 
 	return id, nil
 }
+
+// GetQuestionPages gets question pages of the lesson with their full content and returns them.
+func (qph QuestionPageHandlers) GetQuestionPages(ctx context.Context, lessonID int64, limit, offset int64) ([]questions.QuestionPage, error) {
+	const op = "question.GetQuestionPages"
+
+	log := qph.log.With(
+		slog.String("op", op),
+		slog.Int64("lessonID", lessonID),
+	)
+
+	log.Info("getting question pages")
+
+	// Validation
+	params := utils.PaginationQueryParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+	params.SetDefaults()
+
+	if err := qph.validator.Struct(params); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	questionPages, err := qph.questionPageProvider.GetQuestionPages(ctx, lessonID, params.Limit, params.Offset)
+	if err != nil {
+		log.Error("failed to get question pages", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return questionPages, nil
+}
+
+// DeleteQuestionPage deletes the question page together with its question.
+func (qph QuestionPageHandlers) DeleteQuestionPage(ctx context.Context, pageID int64) error {
+	const op = "question.DeleteQuestionPage"
+
+	log := qph.log.With(
+		slog.String("op", op),
+		slog.Int64("pageID", pageID),
+	)
+
+	log.Info("deleting question page")
+
+	err := qph.questionPageDel.DeleteQuestionPage(ctx, pageID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPageNotFound) {
+			log.Warn("question page not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrPageNotFound)
+		}
+
+		log.Error("failed to delete question page", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// validatePatterns checks that accepted answers of a regex short-answer question compile.
+func validatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBlankPatterns checks that accepted answers of every regex blank compile.
+func validateBlankPatterns(blanks []questions.FillBlank) error {
+	for _, blank := range blanks {
+		if err := validatePatterns(blank.AcceptedAnswers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updatedAnswerPatterns returns accepted answers of the short-answer question after
+// the update if it is matched by regex then. Missing match mode and answers are
+// taken from the stored question.
+func updatedAnswerPatterns(stored *questions.ShortAnswerQuestion, upd *questions.UpdateShortAnswerQuestion) []string {
+	var matchMode string
+	var acceptedAnswers []string
+	if stored != nil {
+		matchMode = stored.MatchMode
+		acceptedAnswers = stored.AcceptedAnswers
+	}
+	if upd.MatchMode != nil {
+		matchMode = *upd.MatchMode
+	}
+	if len(upd.AcceptedAnswers) > 0 {
+		acceptedAnswers = upd.AcceptedAnswers
+	}
+
+	if matchMode != questions.MatchModeRegex {
+		return nil
+	}
+	return acceptedAnswers
+}
+
+// updatedBlankPatterns returns blanks of the fill-in-the-blank question after
+// the update if they are matched by regex then. Missing match mode and blanks are
+// taken from the stored question.
+func updatedBlankPatterns(stored *questions.FillBlanksQuestion, upd *questions.UpdateFillBlanksQuestion) []questions.FillBlank {
+	var matchMode string
+	var blanks []questions.FillBlank
+	if stored != nil {
+		matchMode = stored.MatchMode
+		blanks = stored.Blanks
+	}
+	if upd.MatchMode != nil {
+		matchMode = *upd.MatchMode
+	}
+	if len(upd.Blanks) > 0 {
+		blanks = upd.Blanks
+	}
+
+	if matchMode != questions.MatchModeRegex {
+		return nil
+	}
+	return blanks
+}
+
+// validRange reports whether the numeric range is not inverted.
+// A range with an open bound is checked by the storage.
+func validRange(minValue, maxValue *float64) bool {
+	if minValue == nil || maxValue == nil {
+		return true
+	}
+	return *minValue <= *maxValue
+}
+
+// validateOptions checks that options fit the question type: a multichoice question
+// has exactly one correct option, a multi-select one has at least one, and questions
+// of other types have no options. IDs of existing options must not repeat.
+func validateOptions(questionType string, options []questions.Option) error {
+	var correct int
+	seen := make(map[int64]bool, len(options))
+	for _, option := range options {
+		if option.IsCorrect {
+			correct++
+		}
+		if option.ID == 0 {
+			continue
+		}
+		if seen[option.ID] {
+			return fmt.Errorf("option %d is repeated", option.ID)
+		}
+		seen[option.ID] = true
+	}
+
+	switch questionType {
+	case questions.QuestionTypeMultichoice:
+		if correct != 1 {
+			return errors.New("multichoice question must have exactly one correct option")
+		}
+	case questions.QuestionTypeMultiSelect:
+		if correct == 0 {
+			return errors.New("multi-select question must have at least one correct option")
+		}
+	default:
+		if len(options) > 0 {
+			return fmt.Errorf("%s question has no options", questionType)
+		}
+	}
+
+	return nil
+}
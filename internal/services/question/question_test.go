@@ -0,0 +1,77 @@
+package question
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/questions"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestUpdatedAnswerPatterns(t *testing.T) {
+	regexQuestion := &questions.ShortAnswerQuestion{AcceptedAnswers: []string{"a+"}, MatchMode: questions.MatchModeRegex}
+	exactQuestion := &questions.ShortAnswerQuestion{AcceptedAnswers: []string{"("}, MatchMode: questions.MatchModeExact}
+
+	tests := []struct {
+		name   string
+		stored *questions.ShortAnswerQuestion
+		upd    questions.UpdateShortAnswerQuestion
+		want   []string
+	}{
+		{
+			name:   "new answers of stored regex question",
+			stored: regexQuestion,
+			upd:    questions.UpdateShortAnswerQuestion{AcceptedAnswers: []string{"("}},
+			want:   []string{"("},
+		},
+		{
+			name:   "stored answers of question switched to regex",
+			stored: exactQuestion,
+			upd:    questions.UpdateShortAnswerQuestion{MatchMode: ptr(questions.MatchModeRegex)},
+			want:   []string{"("},
+		},
+		{
+			name:   "regex question switched to exact",
+			stored: regexQuestion,
+			upd:    questions.UpdateShortAnswerQuestion{AcceptedAnswers: []string{"("}, MatchMode: ptr(questions.MatchModeExact)},
+		},
+		{
+			name:   "new answers of stored exact question",
+			stored: exactQuestion,
+			upd:    questions.UpdateShortAnswerQuestion{AcceptedAnswers: []string{"("}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := updatedAnswerPatterns(tt.stored, &tt.upd)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("updatedAnswerPatterns() = %v, want %v", got, tt.want)
+			}
+			if tt.want != nil && validatePatterns(got) == nil {
+				t.Errorf("validatePatterns(%v) = nil, want error", got)
+			}
+		})
+	}
+}
+
+func TestUpdatedBlankPatterns(t *testing.T) {
+	invalid := []questions.FillBlank{{AcceptedAnswers: []string{"("}}}
+	stored := &questions.FillBlanksQuestion{
+		Blanks:    []questions.FillBlank{{AcceptedAnswers: []string{"a+"}}},
+		MatchMode: questions.MatchModeRegex,
+	}
+
+	got := updatedBlankPatterns(stored, &questions.UpdateFillBlanksQuestion{Blanks: invalid})
+	if validateBlankPatterns(got) == nil {
+		t.Errorf("validateBlankPatterns(%v) = nil, want error", got)
+	}
+
+	got = updatedBlankPatterns(stored, &questions.UpdateFillBlanksQuestion{Blanks: invalid, MatchMode: ptr(questions.MatchModeExact)})
+	if got != nil {
+		t.Errorf("updatedBlankPatterns() = %v, want nil", got)
+	}
+}
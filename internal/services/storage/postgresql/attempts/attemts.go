@@ -4,19 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type AttemptsPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewAttemptsStorage(db *pgxpool.Pool) *AttemptsPostgresStorage {
-	return &AttemptsPostgresStorage{db: db}
+	return &AttemptsPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const (
@@ -24,6 +29,20 @@ const (
 	INSERT INTO attempt_lessonattempt(lesson_id, plan_id, channel_id, user_id)
 	VALUES ($1, $2, $3, $4)
 	RETURNING id`
+	createLessonAttemptIfNotOpenQuery = `
+	INSERT INTO attempt_lessonattempt(lesson_id, plan_id, channel_id, user_id)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (user_id, lesson_id, plan_id, channel_id) WHERE is_complete = false DO NOTHING
+	RETURNING id`
+	getOpenLessonAttemptIDQuery = `
+	SELECT id
+	FROM attempt_lessonattempt
+	WHERE
+		lesson_id = $1 AND
+		plan_id = $2 AND
+		channel_id = $3 AND
+		user_id = $4 AND
+		is_complete = false`
 )
 
 func (a *AttemptsPostgresStorage) CreateLessonAttempt(ctx context.Context, lAttempt CreateLessonAttempt) (int64, error) {
@@ -31,7 +50,7 @@ func (a *AttemptsPostgresStorage) CreateLessonAttempt(ctx context.Context, lAtte
 
 	var id int64
 
-	err := a.db.QueryRow(
+	err := postgresql.Conn(ctx, a.db).QueryRow(
 		ctx,
 		createLessonAttemptQuery,
 		lAttempt.LessonID,
@@ -43,7 +62,7 @@ func (a *AttemptsPostgresStorage) CreateLessonAttempt(ctx context.Context, lAtte
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == "23505" { // unique violation code
-				return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrAttemptExitsts)
 			}
 		}
 		return 0, fmt.Errorf("%s: %w", op, err)
@@ -52,18 +71,64 @@ func (a *AttemptsPostgresStorage) CreateLessonAttempt(ctx context.Context, lAtte
 	return id, nil
 }
 
+// GetOrCreateLessonAttempt returns the incomplete attempt of the user for the lesson
+// or creates a new one. The partial unique index on incomplete attempts makes
+// concurrent calls resolve to the same attempt.
+func (a *AttemptsPostgresStorage) GetOrCreateLessonAttempt(ctx context.Context, lAttempt CreateLessonAttempt) (CreatedLessonAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.GetOrCreateLessonAttempt"
+
+	var id int64
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(
+		ctx,
+		createLessonAttemptIfNotOpenQuery,
+		lAttempt.LessonID,
+		lAttempt.PlanId,
+		lAttempt.ChannelID,
+		lAttempt.UserID,
+	).Scan(&id)
+	if err == nil {
+		return CreatedLessonAttempt{ID: id}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = postgresql.Conn(ctx, a.db).QueryRow(
+		ctx,
+		getOpenLessonAttemptIDQuery,
+		lAttempt.LessonID,
+		lAttempt.PlanId,
+		lAttempt.ChannelID,
+		lAttempt.UserID,
+	).Scan(&id)
+	if err != nil {
+		return CreatedLessonAttempt{}, fmt.Errorf("%s: %w", op, storage.ErrAttemptNotFound)
+	}
+
+	return CreatedLessonAttempt{ID: id, Resumed: true}, nil
+}
+
 const (
 	createAbstractQuestionAttemptQuery = `
 	INSERT INTO question_abstractquestionattempt(question_type, page_attempt_id)
 	VALUES ($1, $2)
 	RETURNING id`
 	createAbstractPageAttemptQuery = `
-	INSERT INTO pages_abstractpageattempt(lesson_attempt_id, content_type)
-	VALUES ($1, $2)
+	INSERT INTO pages_abstractpageattempt(lesson_attempt_id, content_type, position)
+	VALUES ($1, $2, NULLIF($3, 0))
 	RETURNING id`
 	createQuestionAttemptQuery = `
-	INSERT INTO question_questionpageattempt(page_id, question_attempt_id)
-	VALUES ($1, $2)`
+	INSERT INTO question_questionpageattempt(
+		page_id,
+		question_attempt_id,
+		option_order,
+		bank_id,
+		draw_count,
+		draw_tag,
+		draw_difficulty
+	)
+	VALUES ($1, $2, $3, NULLIF($4, 0), NULLIF($5, 0), NULLIF($6, ''), NULLIF($7, ''))`
 )
 
 func (a *AttemptsPostgresStorage) CreateQuestionAttempt(ctx context.Context, qPageAttempt CreateQuestionPageAttempt) error {
@@ -71,11 +136,16 @@ func (a *AttemptsPostgresStorage) CreateQuestionAttempt(ctx context.Context, qPa
 
 	var id int64
 
-	err := a.db.QueryRow(
+	err := postgresql.Conn(ctx, a.db).QueryRow(
 		ctx,
 		createQuestionAttemptQuery,
 		qPageAttempt.PageID,
 		qPageAttempt.QuestionAttemptID,
+		qPageAttempt.OptionOrder,
+		qPageAttempt.Draw.BankID,
+		qPageAttempt.Draw.Count,
+		qPageAttempt.Draw.Tag,
+		qPageAttempt.Draw.Difficulty,
 	).Scan(&id)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -93,62 +163,64 @@ func (a *AttemptsPostgresStorage) CreateQuestionAttempt(ctx context.Context, qPa
 func (a *AttemptsPostgresStorage) CreateQuestionPageAttempts(ctx context.Context, attempt CreateQuestionPageAttemptNew) error {
 	const op = "storage.postgresql.attempts.attempts.CreateQuestionPageAttempts"
 
-	tx, err := a.db.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
-	defer func() {
+	return a.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, a.db)
+
+		var abPageAttID int64
+		err := conn.QueryRow(
+			ctx,
+			createAbstractPageAttemptQuery,
+			attempt.LessonAttemptID,
+			attempt.ContentType,
+			attempt.Position,
+		).Scan(&abPageAttID)
 		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
-			}
+			return a.checkPgError(err, op)
 		}
-	}()
-
-	var abPageAttID int64
-	err = tx.QueryRow(
-		ctx,
-		createAbstractPageAttemptQuery,
-		attempt.LessonAttemptID,
-		attempt.ContentType,
-	).Scan(&abPageAttID)
-	if err != nil {
-		return a.checkPgError(err, op)
-	}
-
-	var abQAttID int64
-	err = tx.QueryRow(
-		ctx,
-		createAbstractQuestionAttemptQuery,
-		attempt.QuestionType,
-		abPageAttID,
-	).Scan(&abQAttID)
-	if err != nil {
-		return a.checkPgError(err, op)
-	}
 
-	_, err = tx.Exec(
-		ctx,
-		createQuestionAttemptQuery,
-		attempt.PageID,
-		abQAttID,
-	)
-	if err != nil {
-		return a.checkPgError(err, op)
-	}
+		var abQAttID int64
+		err = conn.QueryRow(
+			ctx,
+			createAbstractQuestionAttemptQuery,
+			attempt.QuestionType,
+			abPageAttID,
+		).Scan(&abQAttID)
+		if err != nil {
+			return a.checkPgError(err, op)
+		}
 
-	if err = tx.Commit(ctx); err != nil {
-		return fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
-	}
+		_, err = conn.Exec(
+			ctx,
+			createQuestionAttemptQuery,
+			attempt.PageID,
+			abQAttID,
+			attempt.OptionOrder,
+			attempt.Draw.BankID,
+			attempt.Draw.Count,
+			attempt.Draw.Tag,
+			attempt.Draw.Difficulty,
+		)
+		if err != nil {
+			return a.checkPgError(err, op)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 const getQuestionPagesQuery = `
 	SELECT 
 		ap.content_type AS content_type,
 		aq.question_type AS question_type,
-		qp.id AS question_questionpage_id
+		qp.id AS question_questionpage_id,
+		ap.id AS page_id,
+		ap.position AS position,
+		ARRAY(
+			SELECT o.id
+			FROM question_option o
+			WHERE o.question_abstractquestion_id = aq.id
+			ORDER BY o.position
+		) AS option_ids
 	FROM 
 		pages_abstractpages ap
 	INNER JOIN
@@ -157,15 +229,15 @@ const getQuestionPagesQuery = `
 		question_abstractquestion aq ON qp.question_id = aq.id
 	WHERE 
 		ap.lesson_id = $1 AND
-		ap.content_type = 'question' AND
-		aq.question_type = 'multichoice'`
+		ap.content_type = 'question'
+	ORDER BY ap.position, ap.id`
 
 func (a *AttemptsPostgresStorage) GetQuestionPages(ctx context.Context, lessonID int64) ([]QuestionPage, error) {
 	const op = "storage.postgresql.attempts.attempts.GetQuestionPages"
 
 	var qPages []DBQuestionPage
 
-	rows, err := a.db.Query(ctx, getQuestionPagesQuery, lessonID)
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getQuestionPagesQuery, lessonID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
 	}
@@ -177,6 +249,9 @@ func (a *AttemptsPostgresStorage) GetQuestionPages(ctx context.Context, lessonID
 			&qPage.ContentType,
 			&qPage.QuestionType,
 			&qPage.QuestionPageID,
+			&qPage.PageID,
+			&qPage.Position,
+			&qPage.OptionIDs,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
 		}
@@ -189,12 +264,795 @@ func (a *AttemptsPostgresStorage) GetQuestionPages(ctx context.Context, lessonID
 
 	var mappedQPages []QuestionPage
 	for _, qPage := range qPages {
-		mappedQPages = append(mappedQPages, QuestionPage(qPage))
+		mappedQPages = append(mappedQPages, qPage.toQuestionPage())
 	}
 
 	return mappedQPages, nil
 }
 
+const getQuestionDrawsQuery = `
+	SELECT
+		bank_id,
+		count,
+		COALESCE(tag, ''),
+		COALESCE(difficulty, '')
+	FROM question_bankdraw
+	WHERE lesson_id = $1
+	ORDER BY position`
+
+func (a *AttemptsPostgresStorage) GetQuestionDraws(ctx context.Context, lessonID int64) ([]QuestionDraw, error) {
+	const op = "storage.postgresql.attempts.attempts.GetQuestionDraws"
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getQuestionDrawsQuery, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var draws []QuestionDraw
+	for rows.Next() {
+		var draw QuestionDraw
+		if err := rows.Scan(
+			&draw.BankID,
+			&draw.Count,
+			&draw.Tag,
+			&draw.Difficulty,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		draws = append(draws, draw)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return draws, nil
+}
+
+const getBankQuestionPagesQuery = `
+	SELECT
+		ap.content_type AS content_type,
+		aq.question_type AS question_type,
+		qp.id AS question_questionpage_id,
+		ap.id AS page_id,
+		ap.position AS position,
+		ARRAY(
+			SELECT o.id
+			FROM question_option o
+			WHERE o.question_abstractquestion_id = aq.id
+			ORDER BY o.position
+		) AS option_ids
+	FROM
+		question_bankitem bi
+	INNER JOIN
+		pages_abstractpages ap ON bi.page_id = ap.id
+	INNER JOIN
+		question_questionpage qp ON ap.id = qp.abstractpage_id
+	INNER JOIN
+		question_abstractquestion aq ON qp.question_id = aq.id
+	WHERE
+		bi.bank_id = $1 AND
+		($2 = '' OR bi.tag = $2) AND
+		($3 = '' OR bi.difficulty = $3)
+	ORDER BY ap.id`
+
+// GetBankQuestionPages returns question pages of the bank matching the draw rule.
+// The pages carry the rule, so it can be recorded on the page attempts.
+func (a *AttemptsPostgresStorage) GetBankQuestionPages(ctx context.Context, draw QuestionDraw) ([]QuestionPage, error) {
+	const op = "storage.postgresql.attempts.attempts.GetBankQuestionPages"
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getBankQuestionPagesQuery,
+		draw.BankID,
+		draw.Tag,
+		draw.Difficulty,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var qPages []QuestionPage
+	for rows.Next() {
+		var qPage DBQuestionPage
+		if err := rows.Scan(
+			&qPage.ContentType,
+			&qPage.QuestionType,
+			&qPage.QuestionPageID,
+			&qPage.PageID,
+			&qPage.Position,
+			&qPage.OptionIDs,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		mappedQPage := qPage.toQuestionPage()
+		mappedQPage.Draw = draw
+		qPages = append(qPages, mappedQPage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return qPages, nil
+}
+
+const createContentPageAttemptQuery = `
+	INSERT INTO pages_contentpageattempt(page_id, page_attempt_id)
+	VALUES ($1, $2)`
+
+func (a *AttemptsPostgresStorage) CreateContentPageAttempt(ctx context.Context, attempt CreateContentPageAttempt) error {
+	const op = "storage.postgresql.attempts.attempts.CreateContentPageAttempt"
+
+	return a.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, a.db)
+
+		var abPageAttID int64
+		err := conn.QueryRow(
+			ctx,
+			createAbstractPageAttemptQuery,
+			attempt.LessonAttemptID,
+			attempt.ContentType,
+			attempt.Position,
+		).Scan(&abPageAttID)
+		if err != nil {
+			return a.checkPgError(err, op)
+		}
+
+		_, err = conn.Exec(
+			ctx,
+			createContentPageAttemptQuery,
+			attempt.PageID,
+			abPageAttID,
+		)
+		if err != nil {
+			return a.checkPgError(err, op)
+		}
+
+		return nil
+	})
+}
+
+const getContentPagesQuery = `
+	SELECT
+		id AS page_id,
+		position,
+		content_type
+	FROM pages_abstractpages
+	WHERE
+		lesson_id = $1 AND
+		content_type <> 'question'
+	ORDER BY position, id`
+
+func (a *AttemptsPostgresStorage) GetContentPages(ctx context.Context, lessonID int64) ([]ContentPage, error) {
+	const op = "storage.postgresql.attempts.attempts.GetContentPages"
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getContentPagesQuery, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+	defer rows.Close()
+
+	var cPages []ContentPage
+	for rows.Next() {
+		var cPage ContentPage
+		if err := rows.Scan(
+			&cPage.PageID,
+			&cPage.Position,
+			&cPage.ContentType,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		cPages = append(cPages, cPage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cPages, nil
+}
+
+const getContentPageAttemptQuery = `
+	SELECT
+		cpa.id AS id,
+		apa.id AS page_attempt_id,
+		la.id AS lesson_attempt_id,
+		cpa.page_id AS page_id,
+		cpa.is_viewed AS is_viewed,
+		cpa.progress AS progress,
+		la.is_complete AS is_complete
+	FROM
+		attempt_lessonattempt la
+	INNER JOIN
+		pages_abstractpageattempt apa ON la.id = apa.lesson_attempt_id
+	INNER JOIN
+		pages_contentpageattempt cpa ON apa.id = cpa.page_attempt_id
+	WHERE
+		la.id = $1 AND
+		cpa.page_id = $2
+	FOR UPDATE OF la`
+
+func (a *AttemptsPostgresStorage) GetContentPageAttempt(ctx context.Context, lessonAttemptID, pageID int64) (ContentPageAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.GetContentPageAttempt"
+
+	var cpAttempt DBContentPageAttempt
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getContentPageAttemptQuery, lessonAttemptID, pageID).Scan(
+		&cpAttempt.ID,
+		&cpAttempt.PageAttemptID,
+		&cpAttempt.LessonAttemptID,
+		&cpAttempt.PageID,
+		&cpAttempt.IsViewed,
+		&cpAttempt.Progress,
+		&cpAttempt.IsComplete,
+	)
+	if err != nil {
+		return (ContentPageAttempt)(cpAttempt), fmt.Errorf("%s: %w", op, storage.ErrAttemptNotFound)
+	}
+
+	return (ContentPageAttempt)(cpAttempt), nil
+}
+
+const updateContentPageAttemptQuery = `
+	UPDATE pages_contentpageattempt
+	SET
+		is_viewed = true,
+		viewed_at = COALESCE(viewed_at, now()),
+		progress = GREATEST(progress, COALESCE($2, progress))
+	WHERE id = $1
+	RETURNING progress`
+
+// UpdateContentPageAttempt marks the content page attempt as viewed and returns
+// the saved progress. Progress never decreases.
+func (a *AttemptsPostgresStorage) UpdateContentPageAttempt(ctx context.Context, cpAttempt UpdateContentPageAttempt) (int64, error) {
+	const op = "storage.postgresql.attempts.attempts.UpdateContentPageAttempt"
+
+	var progress int64
+
+	err := a.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, a.db)
+
+		err := conn.QueryRow(
+			ctx,
+			updateContentPageAttemptQuery,
+			cpAttempt.ID,
+			cpAttempt.Progress,
+		).Scan(&progress)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, updateAbstractPageAttemptQuery, cpAttempt.PageAttemptID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return progress, nil
+}
+
+const getContentPagesViewStatsQuery = `
+	SELECT
+		COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE cpa.is_viewed) AS viewed
+	FROM
+		pages_abstractpageattempt apa
+	INNER JOIN
+		pages_contentpageattempt cpa ON apa.id = cpa.page_attempt_id
+	WHERE apa.lesson_attempt_id = $1`
+
+func (a *AttemptsPostgresStorage) GetContentPagesViewStats(ctx context.Context, lessonAttemptID int64) (ContentPagesViewStats, error) {
+	const op = "storage.postgresql.attempts.attempts.GetContentPagesViewStats"
+
+	var stats ContentPagesViewStats
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getContentPagesViewStatsQuery, lessonAttemptID).Scan(
+		&stats.Total,
+		&stats.Viewed,
+	)
+	if err != nil {
+		return stats, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+const getQuestionPageAttemptQuery = `
+	SELECT
+		qpa.id AS id,
+		aqa.id AS question_attempt_id,
+		apa.id AS page_attempt_id,
+		la.id AS lesson_attempt_id,
+		qp.abstractpage_id AS page_id,
+		aqa.question_type AS question_type,
+		COALESCE(qpa.user_answer, '') AS user_answer,
+		aqa.is_successful AS is_successful,
+		la.is_complete AS is_complete
+	FROM
+		attempt_lessonattempt la
+	INNER JOIN
+		pages_abstractpageattempt apa ON la.id = apa.lesson_attempt_id
+	INNER JOIN
+		question_abstractquestionattempt aqa ON apa.id = aqa.page_attempt_id
+	INNER JOIN
+		question_questionpageattempt qpa ON aqa.id = qpa.question_attempt_id
+	INNER JOIN
+		question_questionpage qp ON qpa.page_id = qp.id
+	WHERE
+		la.id = $1 AND
+		qp.abstractpage_id = $2
+	FOR UPDATE OF la`
+
+func (a *AttemptsPostgresStorage) GetQuestionPageAttempt(ctx context.Context, lessonAttemptID, pageID int64) (QuestionPageAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.GetQuestionPageAttempt"
+
+	var qpAttempt DBQuestionPageAttempt
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getQuestionPageAttemptQuery, lessonAttemptID, pageID).Scan(
+		&qpAttempt.ID,
+		&qpAttempt.QuestionAttemptID,
+		&qpAttempt.PageAttemptID,
+		&qpAttempt.LessonAttemptID,
+		&qpAttempt.PageID,
+		&qpAttempt.QuestionType,
+		&qpAttempt.UserAnswer,
+		&qpAttempt.IsSuccessful,
+		&qpAttempt.IsComplete,
+	)
+	if err != nil {
+		return (QuestionPageAttempt)(qpAttempt), fmt.Errorf("%s: %w", op, storage.ErrAttemptNotFound)
+	}
+
+	return (QuestionPageAttempt)(qpAttempt), nil
+}
+
+const (
+	updateQuestionPageAttemptQuery = `
+	UPDATE question_questionpageattempt
+	SET user_answer = $2
+	WHERE id = $1`
+	updateAbstractQuestionAttemptQuery = `
+	UPDATE question_abstractquestionattempt
+	SET
+		is_successful = $2,
+		score = $3,
+		modified = now()
+	WHERE id = $1`
+	updateAbstractPageAttemptQuery = `
+	UPDATE pages_abstractpageattempt
+	SET modified = now()
+	WHERE id = $1`
+)
+
+func (a *AttemptsPostgresStorage) UpdateQuestionPageAttempt(ctx context.Context, qpAttempt UpdateQuestionPageAttempt) error {
+	const op = "storage.postgresql.attempts.attempts.UpdateQuestionPageAttempt"
+
+	return a.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, a.db)
+
+		_, err := conn.Exec(ctx, updateQuestionPageAttemptQuery, qpAttempt.ID, qpAttempt.UserAnswer)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(
+			ctx,
+			updateAbstractQuestionAttemptQuery,
+			qpAttempt.QuestionAttemptID,
+			qpAttempt.IsSuccessful,
+			qpAttempt.Score,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, updateAbstractPageAttemptQuery, qpAttempt.PageAttemptID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const getLessonAttemptByIDQuery = `
+	SELECT
+		id,
+		lesson_id,
+		plan_id,
+		channel_id,
+		user_id,
+		start_time,
+		end_time,
+		is_complete,
+		is_successful,
+		COALESCE(percentage_score, 0)
+	FROM attempt_lessonattempt
+	WHERE id = $1`
+
+func (a *AttemptsPostgresStorage) GetLessonAttemptByID(ctx context.Context, lessonAttemptID int64) (LessonAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.GetLessonAttemptByID"
+
+	var lAttempt DBLessonAttempt
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getLessonAttemptByIDQuery, lessonAttemptID).Scan(
+		&lAttempt.ID,
+		&lAttempt.LessonID,
+		&lAttempt.PlanID,
+		&lAttempt.ChannelID,
+		&lAttempt.UserID,
+		&lAttempt.StartTime,
+		&lAttempt.EndTime,
+		&lAttempt.IsComplete,
+		&lAttempt.IsSuccessful,
+		&lAttempt.PercentageScore,
+	)
+	if err != nil {
+		return lAttempt.toLessonAttempt(), fmt.Errorf("%s: %w", op, storage.ErrAttemptNotFound)
+	}
+
+	return lAttempt.toLessonAttempt(), nil
+}
+
+const getQuestionAttemptsStatsQuery = `
+	SELECT
+		COUNT(aqa.id) AS total,
+		COUNT(aqa.id) FILTER (WHERE aqa.is_successful) AS successful,
+		COALESCE(SUM(COALESCE(aqa.score, CASE WHEN aqa.is_successful THEN 100 ELSE 0 END)), 0) AS score_sum
+	FROM
+		pages_abstractpageattempt apa
+	INNER JOIN
+		question_abstractquestionattempt aqa ON apa.id = aqa.page_attempt_id
+	WHERE apa.lesson_attempt_id = $1`
+
+func (a *AttemptsPostgresStorage) GetQuestionAttemptsStats(ctx context.Context, lessonAttemptID int64) (QuestionAttemptsStats, error) {
+	const op = "storage.postgresql.attempts.attempts.GetQuestionAttemptsStats"
+
+	var stats QuestionAttemptsStats
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getQuestionAttemptsStatsQuery, lessonAttemptID).Scan(
+		&stats.Total,
+		&stats.Successful,
+		&stats.ScoreSum,
+	)
+	if err != nil {
+		return stats, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+	}
+
+	return stats, nil
+}
+
+const completeLessonAttemptQuery = `
+	UPDATE attempt_lessonattempt
+	SET
+		end_time = now(),
+		is_complete = true,
+		is_successful = $2,
+		percentage_score = $3
+	WHERE id = $1 AND is_complete = false`
+
+func (a *AttemptsPostgresStorage) CompleteLessonAttempt(ctx context.Context, lAttempt CompleteLessonAttempt) error {
+	const op = "storage.postgresql.attempts.attempts.CompleteLessonAttempt"
+
+	res, err := postgresql.Conn(ctx, a.db).Exec(
+		ctx,
+		completeLessonAttemptQuery,
+		lAttempt.ID,
+		lAttempt.IsSuccessful,
+		lAttempt.PercentageScore,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrAttemptCompleted)
+	}
+
+	return nil
+}
+
+const getPageAttemptsQuery = `
+	SELECT
+		apa.id AS id,
+		COALESCE(qp.abstractpage_id, cpa.page_id) AS page_id,
+		apa.content_type AS content_type,
+		aqa.question_type AS question_type,
+		qpa.user_answer AS user_answer,
+		aqa.is_successful AS is_successful,
+		apa.created_at AS created_at,
+		apa.modified AS modified,
+		cpa.is_viewed AS is_viewed,
+		cpa.viewed_at AS viewed_at,
+		cpa.progress AS progress,
+		aqa.score AS score,
+		apa.position AS position,
+		qpa.option_order AS option_order,
+		qpa.bank_id AS bank_id,
+		qpa.draw_count AS draw_count,
+		qpa.draw_tag AS draw_tag,
+		qpa.draw_difficulty AS draw_difficulty
+	FROM
+		pages_abstractpageattempt apa
+	LEFT JOIN
+		question_abstractquestionattempt aqa ON apa.id = aqa.page_attempt_id
+	LEFT JOIN
+		question_questionpageattempt qpa ON aqa.id = qpa.question_attempt_id
+	LEFT JOIN
+		question_questionpage qp ON qpa.page_id = qp.id
+	LEFT JOIN
+		pages_contentpageattempt cpa ON apa.id = cpa.page_attempt_id
+	WHERE apa.lesson_attempt_id = $1
+	ORDER BY apa.position NULLS LAST, apa.id`
+
+func (a *AttemptsPostgresStorage) GetPageAttempts(ctx context.Context, lessonAttemptID int64) ([]PageAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.GetPageAttempts"
+
+	var pAttempts []DBPageAttempt
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getPageAttemptsQuery, lessonAttemptID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pAttempt DBPageAttempt
+		if err := rows.Scan(
+			&pAttempt.ID,
+			&pAttempt.PageID,
+			&pAttempt.ContentType,
+			&pAttempt.QuestionType,
+			&pAttempt.UserAnswer,
+			&pAttempt.IsSuccessful,
+			&pAttempt.CreatedAt,
+			&pAttempt.Modified,
+			&pAttempt.IsViewed,
+			&pAttempt.ViewedAt,
+			&pAttempt.Progress,
+			&pAttempt.Score,
+			&pAttempt.Position,
+			&pAttempt.OptionOrder,
+			&pAttempt.BankID,
+			&pAttempt.DrawCount,
+			&pAttempt.DrawTag,
+			&pAttempt.DrawDifficulty,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		pAttempts = append(pAttempts, pAttempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var mappedPAttempts []PageAttempt
+	for _, pAttempt := range pAttempts {
+		mappedPAttempts = append(mappedPAttempts, pAttempt.toPageAttempt())
+	}
+
+	return mappedPAttempts, nil
+}
+
+const listLessonAttemptsQuery = `
+	SELECT
+		id,
+		lesson_id,
+		plan_id,
+		channel_id,
+		user_id,
+		start_time,
+		end_time,
+		is_complete,
+		is_successful,
+		COALESCE(percentage_score, 0)
+	FROM attempt_lessonattempt
+	WHERE
+		($1::integer IS NULL OR user_id = $1) AND
+		($2::integer IS NULL OR lesson_id = $2) AND
+		($3::integer IS NULL OR plan_id = $3) AND
+		($4::integer IS NULL OR channel_id = $4)
+	ORDER BY start_time DESC, id DESC
+	LIMIT $5 OFFSET $6`
+
+func (a *AttemptsPostgresStorage) ListLessonAttempts(ctx context.Context, filter ListAttemptsFilter, limit, offset int64) ([]LessonAttempt, error) {
+	const op = "storage.postgresql.attempts.attempts.ListLessonAttempts"
+
+	var lAttempts []DBLessonAttempt
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(
+		ctx,
+		listLessonAttemptsQuery,
+		filter.UserID,
+		filter.LessonID,
+		filter.PlanID,
+		filter.ChannelID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lAttempt DBLessonAttempt
+		if err := rows.Scan(
+			&lAttempt.ID,
+			&lAttempt.LessonID,
+			&lAttempt.PlanID,
+			&lAttempt.ChannelID,
+			&lAttempt.UserID,
+			&lAttempt.StartTime,
+			&lAttempt.EndTime,
+			&lAttempt.IsComplete,
+			&lAttempt.IsSuccessful,
+			&lAttempt.PercentageScore,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		lAttempts = append(lAttempts, lAttempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var mappedLAttempts []LessonAttempt
+	for _, lAttempt := range lAttempts {
+		mappedLAttempts = append(mappedLAttempts, lAttempt.toLessonAttempt())
+	}
+
+	return mappedLAttempts, nil
+}
+
+const lockUserLessonAttemptsQuery = `
+	SELECT pg_advisory_xact_lock($1::integer, $2::integer)`
+
+// LockUserLessonAttempts serializes attempt creation of the user for the lesson
+// until the end of the current transaction.
+func (a *AttemptsPostgresStorage) LockUserLessonAttempts(ctx context.Context, userID, lessonID int64) error {
+	const op = "storage.postgresql.attempts.attempts.LockUserLessonAttempts"
+
+	_, err := postgresql.Conn(ctx, a.db).Exec(ctx, lockUserLessonAttemptsQuery, userID, lessonID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+const getAttemptPolicyQuery = `
+	SELECT
+		max_attempts,
+		attempt_cooldown_seconds,
+		time_limit_seconds,
+		require_all_pages_viewed,
+		shuffle_questions,
+		shuffle_options
+	FROM lessons
+	WHERE id = $1`
+
+func (a *AttemptsPostgresStorage) GetAttemptPolicy(ctx context.Context, lessonID int64) (AttemptPolicy, error) {
+	const op = "storage.postgresql.attempts.attempts.GetAttemptPolicy"
+
+	var policy AttemptPolicy
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getAttemptPolicyQuery, lessonID).Scan(
+		&policy.MaxAttempts,
+		&policy.AttemptCooldownSeconds,
+		&policy.TimeLimitSeconds,
+		&policy.RequireAllPagesViewed,
+		&policy.ShuffleQuestions,
+		&policy.ShuffleOptions,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return policy, fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+		}
+		return policy, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return policy, nil
+}
+
+const isLessonInPlanQuery = `
+	SELECT EXISTS(
+		SELECT 1
+		FROM plans_lessons
+		WHERE lesson_id = $1 AND plan_id = $2
+	)`
+
+// IsLessonInPlan reports whether the lesson is currently part of the plan.
+func (a *AttemptsPostgresStorage) IsLessonInPlan(ctx context.Context, lessonID, planID int64) (bool, error) {
+	const op = "storage.postgresql.attempts.attempts.IsLessonInPlan"
+
+	var inPlan bool
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, isLessonInPlanQuery, lessonID, planID).Scan(&inPlan)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return inPlan, nil
+}
+
+const getUserLessonAttemptsStatsQuery = `
+	SELECT
+		COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE is_complete = false AND plan_id = $3 AND channel_id = $4) AS open,
+		MAX(end_time) AS last_end_time
+	FROM attempt_lessonattempt
+	WHERE user_id = $1 AND lesson_id = $2`
+
+// GetUserLessonAttemptsStats counts attempts of the user for the lesson in every plan.
+// Only incomplete attempts in the given plan and channel are counted as open,
+// as those are the ones a new attempt may resume.
+func (a *AttemptsPostgresStorage) GetUserLessonAttemptsStats(ctx context.Context, userID, lessonID, planID, channelID int64) (UserLessonAttemptsStats, error) {
+	const op = "storage.postgresql.attempts.attempts.GetUserLessonAttemptsStats"
+
+	var stats DBUserLessonAttemptsStats
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getUserLessonAttemptsStatsQuery, userID, lessonID, planID, channelID).Scan(
+		&stats.Total,
+		&stats.Open,
+		&stats.LastEndTime,
+	)
+	if err != nil {
+		return UserLessonAttemptsStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return UserLessonAttemptsStats{
+		Total:       stats.Total,
+		Open:        stats.Open,
+		LastEndTime: stats.LastEndTime.Time,
+	}, nil
+}
+
+const getExpiredLessonAttemptIDsQuery = `
+	SELECT id
+	FROM attempt_lessonattempt
+	WHERE
+		user_id = $1
+		AND lesson_id = $2
+		AND is_complete = false
+		AND start_time + $3::integer * interval '1 second' <= now()
+	ORDER BY id`
+
+// GetExpiredLessonAttemptIDs returns incomplete attempts of the user for the lesson
+// which were started more than timeLimitSeconds ago.
+func (a *AttemptsPostgresStorage) GetExpiredLessonAttemptIDs(ctx context.Context, userID, lessonID, timeLimitSeconds int64) ([]int64, error) {
+	const op = "storage.postgresql.attempts.attempts.GetExpiredLessonAttemptIDs"
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getExpiredLessonAttemptIDsQuery, userID, lessonID, timeLimitSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
 func (a *AttemptsPostgresStorage) checkPgError(err error, op string) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -1,5 +1,10 @@
 package attempts
 
+import (
+	"database/sql"
+	"time"
+)
+
 type CreateAttempt interface {
 	GetCommonFields()
 	GetContentTypeSpecificFields() []interface{}
@@ -11,11 +16,18 @@ type CreateLessonAttempt struct {
 	PlanId    int64 `json:"plan_id" validate:"required"`
 	ChannelID int64 `json:"channel_id" validate:"required"`
 	UserID    int64 `json:"user_id" validate:"required"`
+	Resume    bool  `json:"resume"`
+}
+
+type CreatedLessonAttempt struct {
+	ID      int64
+	Resumed bool
 }
 
 type CreateAbstractPageAttempt struct {
 	LessonAttemptID int64  `json:"lesson_attempt_id" validate:"required"`
 	ContentType     string `json:"content_type" validate:"required"`
+	Position        int64  `json:"position"`
 }
 
 type CreateAbstractQuestionAttempt struct {
@@ -24,14 +36,22 @@ type CreateAbstractQuestionAttempt struct {
 }
 
 type CreateQuestionPageAttempt struct {
-	PageID            int64 `json:"page_id" validate:"required"`
-	QuestionAttemptID int64 `json:"question_attempt_id" validate:"required"`
+	PageID            int64   `json:"page_id" validate:"required"`
+	QuestionAttemptID int64   `json:"question_attempt_id" validate:"required"`
+	OptionOrder       []int64 `json:"option_order,omitempty"`
+	// Draw is the rule the question was drawn by, zero if the question is the lesson's own.
+	Draw QuestionDraw `json:"draw"`
 }
 
 type QuestionPage struct {
-	ContentType    string `json:"content_type" validate:"required"`
-	QuestionType   string `json:"question_type" validate:"required"`
-	QuestionPageID int64  `json:"question_questionpage_id" validate:"required"`
+	ContentType    string  `json:"content_type" validate:"required"`
+	QuestionType   string  `json:"question_type" validate:"required"`
+	QuestionPageID int64   `json:"question_questionpage_id" validate:"required"`
+	PageID         int64   `json:"page_id" validate:"required"`
+	Position       int64   `json:"position"`
+	OptionIDs      []int64 `json:"option_ids"`
+	// Draw is the rule the question was drawn by, zero if the question is the lesson's own.
+	Draw QuestionDraw `json:"draw"`
 }
 
 type CreateQuestionPageAttemptNew struct {
@@ -41,7 +61,372 @@ type CreateQuestionPageAttemptNew struct {
 }
 
 type DBQuestionPage struct {
-	ContentType    string `db:"content_type"`
-	QuestionType   string `db:"question_type"`
-	QuestionPageID int64  `db:"question_questionpage_id"`
+	ContentType    string  `db:"content_type"`
+	QuestionType   string  `db:"question_type"`
+	QuestionPageID int64   `db:"question_questionpage_id"`
+	PageID         int64   `db:"page_id"`
+	Position       int64   `db:"position"`
+	OptionIDs      []int64 `db:"option_ids"`
+}
+
+func (p DBQuestionPage) toQuestionPage() QuestionPage {
+	return QuestionPage{
+		ContentType:    p.ContentType,
+		QuestionType:   p.QuestionType,
+		QuestionPageID: p.QuestionPageID,
+		PageID:         p.PageID,
+		Position:       p.Position,
+		OptionIDs:      p.OptionIDs,
+	}
+}
+
+// QuestionDraw is a rule of the lesson which draws Count random question pages
+// from the bank, optionally only those with the given tag and difficulty.
+type QuestionDraw struct {
+	BankID     int64
+	Count      int64
+	Tag        string
+	Difficulty string
+}
+
+type SubmitQuestionAnswer struct {
+	LessonAttemptID   int64         `json:"lesson_attempt_id" validate:"required"`
+	PageID            int64         `json:"page_id" validate:"required"`
+	OptionID          int64         `json:"option_id" validate:"required_without_all=TextAnswer SelectedOptionIDs BoolAnswer NumericAnswer BlankAnswers OrderedItems MatchedPairs"`
+	TextAnswer        string        `json:"text_answer"`
+	SelectedOptionIDs []int64       `json:"selected_option_ids" validate:"omitempty,unique"`
+	BoolAnswer        *bool         `json:"bool_answer,omitempty"`
+	NumericAnswer     *float64      `json:"numeric_answer,omitempty"`
+	BlankAnswers      []string      `json:"blank_answers,omitempty"`
+	OrderedItems      []string      `json:"ordered_items,omitempty" validate:"omitempty,unique"`
+	MatchedPairs      []MatchedPair `json:"matched_pairs,omitempty" validate:"omitempty,unique=Left"`
+}
+
+// MatchedPair is a pair of items matched by the user in a matching question.
+type MatchedPair struct {
+	Left  string `json:"left"`
+	Right string `json:"right"`
+}
+
+type QuestionPageAttempt struct {
+	ID                int64
+	QuestionAttemptID int64
+	PageAttemptID     int64
+	LessonAttemptID   int64
+	PageID            int64
+	QuestionType      string
+	UserAnswer        string
+	IsSuccessful      bool
+	IsComplete        bool
+}
+
+type UpdateQuestionPageAttempt struct {
+	ID                int64  `json:"id" validate:"required"`
+	QuestionAttemptID int64  `json:"question_attempt_id" validate:"required"`
+	PageAttemptID     int64  `json:"page_attempt_id" validate:"required"`
+	UserAnswer        string `json:"user_answer" validate:"required"`
+	IsSuccessful      bool   `json:"is_successful"`
+	Score             int64  `json:"score" validate:"min=0,max=100"`
+}
+
+type QuestionAnswerResult struct {
+	ID           int64
+	IsSuccessful bool
+	Score        int64
+	Explanation  string
+	Feedback     []OptionFeedback
+}
+
+// OptionFeedback is the feedback of an option chosen by the user.
+type OptionFeedback struct {
+	OptionID int64
+	Feedback string
+}
+
+type DBQuestionPageAttempt struct {
+	ID                int64  `db:"id"`
+	QuestionAttemptID int64  `db:"question_attempt_id"`
+	PageAttemptID     int64  `db:"page_attempt_id"`
+	LessonAttemptID   int64  `db:"lesson_attempt_id"`
+	PageID            int64  `db:"page_id"`
+	QuestionType      string `db:"question_type"`
+	UserAnswer        string `db:"user_answer"`
+	IsSuccessful      bool   `db:"is_successful"`
+	IsComplete        bool   `db:"is_complete"`
+}
+
+type LessonAttempt struct {
+	ID              int64
+	LessonID        int64
+	PlanID          int64
+	ChannelID       int64
+	UserID          int64
+	StartTime       time.Time
+	EndTime         time.Time
+	IsComplete      bool
+	IsSuccessful    bool
+	PercentageScore int64
+}
+
+type QuestionAttemptsStats struct {
+	Total      int64
+	Successful int64
+	ScoreSum   int64
+}
+
+type CompleteLessonAttempt struct {
+	ID              int64 `json:"id" validate:"required"`
+	IsSuccessful    bool  `json:"is_successful"`
+	PercentageScore int64 `json:"percentage_score" validate:"min=0,max=100"`
+}
+
+type LessonAttemptResult struct {
+	ID              int64
+	PercentageScore int64
+	IsSuccessful    bool
+}
+
+type DBLessonAttempt struct {
+	ID              int64        `db:"id"`
+	LessonID        int64        `db:"lesson_id"`
+	PlanID          int64        `db:"plan_id"`
+	ChannelID       int64        `db:"channel_id"`
+	UserID          int64        `db:"user_id"`
+	StartTime       time.Time    `db:"start_time"`
+	EndTime         sql.NullTime `db:"end_time"`
+	IsComplete      bool         `db:"is_complete"`
+	IsSuccessful    bool         `db:"is_successful"`
+	PercentageScore int64        `db:"percentage_score"`
+}
+
+func (a DBLessonAttempt) toLessonAttempt() LessonAttempt {
+	return LessonAttempt{
+		ID:              a.ID,
+		LessonID:        a.LessonID,
+		PlanID:          a.PlanID,
+		ChannelID:       a.ChannelID,
+		UserID:          a.UserID,
+		StartTime:       a.StartTime,
+		EndTime:         a.EndTime.Time,
+		IsComplete:      a.IsComplete,
+		IsSuccessful:    a.IsSuccessful,
+		PercentageScore: a.PercentageScore,
+	}
+}
+
+type PageAttempt struct {
+	ID           int64
+	PageID       int64
+	ContentType  string
+	QuestionType string
+	UserAnswer   string
+	IsSuccessful bool
+	CreatedAt    time.Time
+	Modified     time.Time
+	IsViewed     bool
+	ViewedAt     time.Time
+	Progress     int64
+	Score        int64
+	Position     int64
+	OptionOrder  []int64
+	BankID       int64
+	Draw         QuestionDraw
+}
+
+type LessonAttemptWithPages struct {
+	LessonAttempt
+	PageAttempts []PageAttempt
+}
+
+type ListAttemptsFilter struct {
+	UserID    *int64 `json:"user_id,omitempty"`
+	LessonID  *int64 `json:"lesson_id,omitempty"`
+	PlanID    *int64 `json:"plan_id,omitempty"`
+	ChannelID *int64 `json:"channel_id,omitempty"`
+}
+
+type DBPageAttempt struct {
+	ID             int64          `db:"id"`
+	PageID         sql.NullInt64  `db:"page_id"`
+	ContentType    string         `db:"content_type"`
+	QuestionType   sql.NullString `db:"question_type"`
+	UserAnswer     sql.NullString `db:"user_answer"`
+	IsSuccessful   sql.NullBool   `db:"is_successful"`
+	CreatedAt      time.Time      `db:"created_at"`
+	Modified       sql.NullTime   `db:"modified"`
+	IsViewed       sql.NullBool   `db:"is_viewed"`
+	ViewedAt       sql.NullTime   `db:"viewed_at"`
+	Progress       sql.NullInt64  `db:"progress"`
+	Score          sql.NullInt64  `db:"score"`
+	Position       sql.NullInt64  `db:"position"`
+	OptionOrder    []int64        `db:"option_order"`
+	BankID         sql.NullInt64  `db:"bank_id"`
+	DrawCount      sql.NullInt64  `db:"draw_count"`
+	DrawTag        sql.NullString `db:"draw_tag"`
+	DrawDifficulty sql.NullString `db:"draw_difficulty"`
+}
+
+func (a DBPageAttempt) toPageAttempt() PageAttempt {
+	return PageAttempt{
+		ID:           a.ID,
+		PageID:       a.PageID.Int64,
+		ContentType:  a.ContentType,
+		QuestionType: a.QuestionType.String,
+		UserAnswer:   a.UserAnswer.String,
+		IsSuccessful: a.IsSuccessful.Bool,
+		CreatedAt:    a.CreatedAt,
+		Modified:     a.Modified.Time,
+		IsViewed:     a.IsViewed.Bool,
+		ViewedAt:     a.ViewedAt.Time,
+		Progress:     a.Progress.Int64,
+		Score:        a.Score.Int64,
+		Position:     a.Position.Int64,
+		OptionOrder:  a.OptionOrder,
+		BankID:       a.BankID.Int64,
+		Draw: QuestionDraw{
+			BankID:     a.BankID.Int64,
+			Count:      a.DrawCount.Int64,
+			Tag:        a.DrawTag.String,
+			Difficulty: a.DrawDifficulty.String,
+		},
+	}
+}
+
+type AttemptPolicy struct {
+	MaxAttempts            int64
+	AttemptCooldownSeconds int64
+	TimeLimitSeconds       int64
+	RequireAllPagesViewed  bool
+	ShuffleQuestions       bool
+	ShuffleOptions         bool
+}
+
+type UserLessonAttemptsStats struct {
+	Total       int64
+	Open        int64
+	LastEndTime time.Time
+}
+
+type DBUserLessonAttemptsStats struct {
+	Total       int64        `db:"total"`
+	Open        int64        `db:"open"`
+	LastEndTime sql.NullTime `db:"last_end_time"`
+}
+
+type ContentPage struct {
+	PageID      int64  `json:"page_id" validate:"required"`
+	Position    int64  `json:"position"`
+	ContentType string `json:"content_type" validate:"required"`
+}
+
+type CreateContentPageAttempt struct {
+	CreateAbstractPageAttempt
+	PageID int64 `json:"page_id" validate:"required"`
+}
+
+type MarkPageViewed struct {
+	LessonAttemptID int64  `json:"lesson_attempt_id" validate:"required"`
+	PageID          int64  `json:"page_id" validate:"required"`
+	Progress        *int64 `json:"progress,omitempty" validate:"omitempty,min=0"`
+}
+
+type ContentPageAttempt struct {
+	ID              int64
+	PageAttemptID   int64
+	LessonAttemptID int64
+	PageID          int64
+	IsViewed        bool
+	Progress        int64
+	IsComplete      bool
+}
+
+type DBContentPageAttempt struct {
+	ID              int64 `db:"id"`
+	PageAttemptID   int64 `db:"page_attempt_id"`
+	LessonAttemptID int64 `db:"lesson_attempt_id"`
+	PageID          int64 `db:"page_id"`
+	IsViewed        bool  `db:"is_viewed"`
+	Progress        int64 `db:"progress"`
+	IsComplete      bool  `db:"is_complete"`
+}
+
+type UpdateContentPageAttempt struct {
+	ID            int64  `json:"id" validate:"required"`
+	PageAttemptID int64  `json:"page_attempt_id" validate:"required"`
+	Progress      *int64 `json:"progress,omitempty" validate:"omitempty,min=0"`
+}
+
+type ContentPagesViewStats struct {
+	Total  int64
+	Viewed int64
+}
+
+type PlanInfo struct {
+	ID   int64
+	Name string
+}
+
+type LessonAttemptsSummary struct {
+	LessonID      int64
+	LessonName    string
+	AttemptsCount int64
+	OpenAttempts  int64
+	Passed        bool
+	BestScore     int64
+	LastAttemptAt time.Time
+}
+
+type DBLessonAttemptsSummary struct {
+	LessonID      int64        `db:"lesson_id"`
+	LessonName    string       `db:"lesson_name"`
+	AttemptsCount int64        `db:"attempts_count"`
+	OpenAttempts  int64        `db:"open_attempts"`
+	Passed        bool         `db:"passed"`
+	BestScore     int64        `db:"best_score"`
+	LastAttemptAt sql.NullTime `db:"last_attempt_at"`
+}
+
+func (s DBLessonAttemptsSummary) toLessonAttemptsSummary() LessonAttemptsSummary {
+	return LessonAttemptsSummary{
+		LessonID:      s.LessonID,
+		LessonName:    s.LessonName,
+		AttemptsCount: s.AttemptsCount,
+		OpenAttempts:  s.OpenAttempts,
+		Passed:        s.Passed,
+		BestScore:     s.BestScore,
+		LastAttemptAt: s.LastAttemptAt.Time,
+	}
+}
+
+const (
+	LessonStatusNotStarted = "not_started"
+	LessonStatusInProgress = "in_progress"
+	LessonStatusPassed     = "passed"
+	LessonStatusFailed     = "failed"
+)
+
+type LessonProgress struct {
+	LessonID      int64
+	LessonName    string
+	Status        string
+	BestScore     int64
+	AttemptsCount int64
+	LastAttemptAt time.Time
+}
+
+type PlanProgress struct {
+	PlanID               int64
+	PlanName             string
+	TotalLessons         int64
+	PassedLessons        int64
+	CompletionPercentage int64
+	Lessons              []LessonProgress
+}
+
+type ChannelProgress struct {
+	ChannelID            int64
+	TotalLessons         int64
+	PassedLessons        int64
+	CompletionPercentage int64
+	Plans                []PlanProgress
 }
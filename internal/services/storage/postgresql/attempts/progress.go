@@ -0,0 +1,147 @@
+package attempts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
+)
+
+const getPlanInfoQuery = `
+	SELECT id, name
+	FROM plans
+	WHERE id = $1`
+
+func (a *AttemptsPostgresStorage) GetPlanInfo(ctx context.Context, planID int64) (PlanInfo, error) {
+	const op = "storage.postgresql.attempts.progress.GetPlanInfo"
+
+	var plan PlanInfo
+
+	err := postgresql.Conn(ctx, a.db).QueryRow(ctx, getPlanInfoQuery, planID).Scan(
+		&plan.ID,
+		&plan.Name,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return plan, fmt.Errorf("%s: %w", op, storage.ErrPlanNotFound)
+		}
+		return plan, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return plan, nil
+}
+
+const (
+	checkChannelExistsQuery = `
+	SELECT EXISTS(SELECT 1 FROM channels WHERE id = $1)`
+	getChannelPlansInfoQuery = `
+	SELECT
+		p.id,
+		p.name
+	FROM
+		channels_plans cp
+	INNER JOIN
+		plans p ON cp.plan_id = p.id
+	WHERE cp.channel_id = $1
+	ORDER BY cp.position, p.id`
+)
+
+func (a *AttemptsPostgresStorage) GetChannelPlansInfo(ctx context.Context, channelID int64) ([]PlanInfo, error) {
+	const op = "storage.postgresql.attempts.progress.GetChannelPlansInfo"
+
+	conn := postgresql.Conn(ctx, a.db)
+
+	var exists bool
+	err := conn.QueryRow(ctx, checkChannelExistsQuery, channelID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrChannelNotFound)
+	}
+
+	rows, err := conn.Query(ctx, getChannelPlansInfoQuery, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var plans []PlanInfo
+	for rows.Next() {
+		var plan PlanInfo
+		if err := rows.Scan(
+			&plan.ID,
+			&plan.Name,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return plans, nil
+}
+
+const getLessonAttemptsSummariesQuery = `
+	SELECT
+		l.id AS lesson_id,
+		l.name AS lesson_name,
+		COUNT(la.id) AS attempts_count,
+		COUNT(la.id) FILTER (WHERE la.is_complete = false) AS open_attempts,
+		COALESCE(BOOL_OR(la.is_complete AND la.is_successful), false) AS passed,
+		COALESCE(MAX(la.percentage_score) FILTER (WHERE la.is_complete), 0) AS best_score,
+		MAX(la.start_time) AS last_attempt_at
+	FROM
+		plans_lessons pl
+	INNER JOIN
+		lessons l ON pl.lesson_id = l.id
+	LEFT JOIN
+		attempt_lessonattempt la ON
+			la.lesson_id = l.id AND
+			la.plan_id = pl.plan_id AND
+			la.user_id = $1 AND
+			($3::integer IS NULL OR la.channel_id = $3)
+	WHERE pl.plan_id = $2
+	GROUP BY l.id, l.name, pl.position
+	ORDER BY pl.position, l.id`
+
+// GetLessonAttemptsSummaries rolls attempts of the user up per lesson of the plan.
+// If channelID is not nil only attempts made within the channel are taken into account.
+func (a *AttemptsPostgresStorage) GetLessonAttemptsSummaries(ctx context.Context, userID, planID int64, channelID *int64) ([]LessonAttemptsSummary, error) {
+	const op = "storage.postgresql.attempts.progress.GetLessonAttemptsSummaries"
+
+	rows, err := postgresql.Conn(ctx, a.db).Query(ctx, getLessonAttemptsSummariesQuery, userID, planID, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var summaries []LessonAttemptsSummary
+	for rows.Next() {
+		var summary DBLessonAttemptsSummary
+		if err := rows.Scan(
+			&summary.LessonID,
+			&summary.LessonName,
+			&summary.AttemptsCount,
+			&summary.OpenAttempts,
+			&summary.Passed,
+			&summary.BestScore,
+			&summary.LastAttemptAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		summaries = append(summaries, summary.toLessonAttemptsSummary())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,381 @@
+package banks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BanksPostgresStorage struct {
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
+}
+
+func NewBanksStorage(db *pgxpool.Pool) *BanksPostgresStorage {
+	return &BanksPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
+}
+
+const createBankQuery = `
+	INSERT INTO question_bank(
+		name,
+		description,
+		lesson_id,
+		channel_id,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified
+	)
+	VALUES ($1, NULLIF($2, ''), NULLIF($3, 0), NULLIF($4, 0), $5, $6, $7, $8)
+	RETURNING id`
+
+func (b *BanksPostgresStorage) CreateBank(ctx context.Context, bank CreateBank) (int64, error) {
+	const op = "storage.postgresql.banks.banks.CreateBank"
+
+	var id int64
+
+	err := postgresql.Conn(ctx, b.db).QueryRow(ctx, createBankQuery,
+		bank.Name,
+		bank.Description,
+		bank.LessonID,
+		bank.ChannelID,
+		bank.CreatedBy,
+		bank.LastModifiedBy,
+		bank.CreatedAt,
+		bank.Modified,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23503": // foreign key violation code
+				if pgErr.ConstraintName == "fk_channel" {
+					return 0, fmt.Errorf("%s: %w", op, storage.ErrChannelNotFound)
+				}
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			case "23514": // check violation code
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+			}
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+const getBankByIDQuery = `
+	SELECT
+		qb.id,
+		qb.name,
+		qb.description,
+		qb.lesson_id,
+		qb.channel_id,
+		qb.created_by,
+		qb.last_modified_by,
+		qb.created_at,
+		qb.modified,
+		COALESCE((
+			SELECT json_agg(json_build_object(
+				'id', bi.id,
+				'page_id', bi.page_id,
+				'tag', bi.tag,
+				'difficulty', bi.difficulty
+			) ORDER BY bi.id)
+			FROM question_bankitem bi
+			WHERE bi.bank_id = qb.id
+		), '[]') AS items
+	FROM question_bank qb
+	WHERE qb.id = $1`
+
+func (b *BanksPostgresStorage) GetBankByID(ctx context.Context, bankID int64) (BankWithItems, error) {
+	const op = "storage.postgresql.banks.banks.GetBankByID"
+
+	var bank DBBank
+	var items []BankItem
+
+	err := postgresql.Conn(ctx, b.db).QueryRow(ctx, getBankByIDQuery, bankID).Scan(
+		&bank.ID,
+		&bank.Name,
+		&bank.Description,
+		&bank.LessonID,
+		&bank.ChannelID,
+		&bank.CreatedBy,
+		&bank.LastModifiedBy,
+		&bank.CreatedAt,
+		&bank.Modified,
+		&items,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return BankWithItems{}, fmt.Errorf("%s: %w", op, storage.ErrBankNotFound)
+		}
+		return BankWithItems{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return BankWithItems{
+		Bank:  bank.toBank(),
+		Items: items,
+	}, nil
+}
+
+const getBanksQuery = `
+	SELECT
+		id,
+		name,
+		description,
+		lesson_id,
+		channel_id,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified
+	FROM question_bank
+	WHERE
+		($1::integer IS NULL OR lesson_id = $1) AND
+		($2::integer IS NULL OR channel_id = $2)
+	ORDER BY id
+	LIMIT $3 OFFSET $4`
+
+func (b *BanksPostgresStorage) GetBanks(ctx context.Context, filter BanksFilter, limit, offset int64) ([]Bank, error) {
+	const op = "storage.postgresql.banks.banks.GetBanks"
+
+	rows, err := postgresql.Conn(ctx, b.db).Query(ctx, getBanksQuery,
+		filter.LessonID,
+		filter.ChannelID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var banks []Bank
+	for rows.Next() {
+		var bank DBBank
+		if err := rows.Scan(
+			&bank.ID,
+			&bank.Name,
+			&bank.Description,
+			&bank.LessonID,
+			&bank.ChannelID,
+			&bank.CreatedBy,
+			&bank.LastModifiedBy,
+			&bank.CreatedAt,
+			&bank.Modified,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		banks = append(banks, bank.toBank())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return banks, nil
+}
+
+const deleteBankQuery = `
+	DELETE FROM question_bank
+	WHERE id = $1`
+
+func (b *BanksPostgresStorage) DeleteBank(ctx context.Context, id int64) error {
+	const op = "storage.postgresql.banks.banks.DeleteBank"
+
+	res, err := postgresql.Conn(ctx, b.db).Exec(ctx, deleteBankQuery, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrBankNotFound)
+	}
+
+	return nil
+}
+
+const addBankItemQuery = `
+	INSERT INTO question_bankitem(bank_id, page_id, tag, difficulty)
+	SELECT $1, ap.id, NULLIF($3, ''), NULLIF($4, '')
+	FROM pages_abstractpages ap
+	WHERE
+		ap.id = $2 AND
+		ap.content_type = 'question'
+	RETURNING id`
+
+// AddBankItem adds the question page to the bank and returns ID of the bank item.
+func (b *BanksPostgresStorage) AddBankItem(ctx context.Context, item AddBankItem) (int64, error) {
+	const op = "storage.postgresql.banks.banks.AddBankItem"
+
+	var id int64
+
+	err := postgresql.Conn(ctx, b.db).QueryRow(ctx, addBankItemQuery,
+		item.BankID,
+		item.PageID,
+		item.Tag,
+		item.Difficulty,
+	).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505": // unique violation code
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrQuestionInBank)
+			case "23503": // foreign key violation code
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrBankNotFound)
+			}
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+const removeBankItemQuery = `
+	DELETE FROM question_bankitem
+	WHERE bank_id = $1 AND page_id = $2`
+
+func (b *BanksPostgresStorage) RemoveBankItem(ctx context.Context, bankID, pageID int64) error {
+	const op = "storage.postgresql.banks.banks.RemoveBankItem"
+
+	res, err := postgresql.Conn(ctx, b.db).Exec(ctx, removeBankItemQuery, bankID, pageID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+	}
+
+	return nil
+}
+
+const (
+	countLessonBanksQuery = `
+	SELECT COUNT(*)
+	FROM question_bank qb
+	WHERE
+		qb.id = ANY($2) AND (
+			qb.lesson_id = $1 OR
+			qb.channel_id IN (
+				SELECT cp.channel_id
+				FROM plans_lessons pl
+				INNER JOIN channels_plans cp ON pl.plan_id = cp.plan_id
+				WHERE pl.lesson_id = $1
+			)
+		)`
+	deleteLessonDrawsQuery = `
+	DELETE FROM question_bankdraw
+	WHERE lesson_id = $1`
+	createLessonDrawQuery = `
+	INSERT INTO question_bankdraw(lesson_id, bank_id, position, count, tag, difficulty)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''))`
+)
+
+// SetLessonDraws replaces draw rules of the lesson. Banks must belong to the lesson
+// or to a channel the lesson is part of.
+func (b *BanksPostgresStorage) SetLessonDraws(ctx context.Context, draws SetLessonDraws) error {
+	const op = "storage.postgresql.banks.banks.SetLessonDraws"
+
+	bankIDs := make(map[int64]bool, len(draws.Draws))
+	for _, draw := range draws.Draws {
+		bankIDs[draw.BankID] = true
+	}
+
+	ids := make([]int64, 0, len(bankIDs))
+	for id := range bankIDs {
+		ids = append(ids, id)
+	}
+
+	return b.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, b.db)
+
+		var found int
+		err := conn.QueryRow(ctx, countLessonBanksQuery, draws.LessonID, ids).Scan(&found)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if found != len(ids) {
+			return fmt.Errorf("%s: %w", op, storage.ErrBankNotFound)
+		}
+
+		if _, err := conn.Exec(ctx, deleteLessonDrawsQuery, draws.LessonID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for i, draw := range draws.Draws {
+			_, err := conn.Exec(ctx, createLessonDrawQuery,
+				draws.LessonID,
+				draw.BankID,
+				i,
+				draw.Count,
+				draw.Tag,
+				draw.Difficulty,
+			)
+			if err != nil {
+				var pgErr *pgconn.PgError
+				if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign key violation code
+					return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+				}
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+const getLessonDrawsQuery = `
+	SELECT
+		bank_id,
+		count,
+		COALESCE(tag, ''),
+		COALESCE(difficulty, '')
+	FROM question_bankdraw
+	WHERE lesson_id = $1
+	ORDER BY position`
+
+func (b *BanksPostgresStorage) GetLessonDraws(ctx context.Context, lessonID int64) ([]QuestionDraw, error) {
+	const op = "storage.postgresql.banks.banks.GetLessonDraws"
+
+	rows, err := postgresql.Conn(ctx, b.db).Query(ctx, getLessonDrawsQuery, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var draws []QuestionDraw
+	for rows.Next() {
+		var draw QuestionDraw
+		if err := rows.Scan(
+			&draw.BankID,
+			&draw.Count,
+			&draw.Tag,
+			&draw.Difficulty,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		draws = append(draws, draw)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return draws, nil
+}
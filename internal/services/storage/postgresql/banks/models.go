@@ -0,0 +1,99 @@
+package banks
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+type Bank struct {
+	ID             int64
+	Name           string
+	Description    string
+	LessonID       int64
+	ChannelID      int64
+	CreatedBy      int64
+	LastModifiedBy int64
+	CreatedAt      time.Time
+	Modified       time.Time
+}
+
+type BankWithItems struct {
+	Bank
+	Items []BankItem
+}
+
+type BankItem struct {
+	ID         int64  `json:"id"`
+	PageID     int64  `json:"page_id"`
+	Tag        string `json:"tag"`
+	Difficulty string `json:"difficulty"`
+}
+
+type CreateBank struct {
+	Name           string    `json:"name" validate:"required,max=255"`
+	Description    string    `json:"description"`
+	LessonID       int64     `json:"lesson_id" validate:"required_without=ChannelID,excluded_with=ChannelID"`
+	ChannelID      int64     `json:"channel_id" validate:"required_without=LessonID,excluded_with=LessonID"`
+	CreatedBy      int64     `json:"created_by" validate:"required"`
+	LastModifiedBy int64     `json:"last_modified_by" validate:"required"`
+	CreatedAt      time.Time `json:"created_at"`
+	Modified       time.Time `json:"modified"`
+}
+
+type BanksFilter struct {
+	LessonID  *int64 `json:"lesson_id,omitempty"`
+	ChannelID *int64 `json:"channel_id,omitempty"`
+}
+
+type AddBankItem struct {
+	BankID     int64  `json:"bank_id" validate:"required"`
+	PageID     int64  `json:"page_id" validate:"required"`
+	Tag        string `json:"tag" validate:"max=100"`
+	Difficulty string `json:"difficulty" validate:"omitempty,oneof=easy medium hard"`
+}
+
+// QuestionDraw is a rule of a lesson which draws Count random questions
+// from the bank, optionally only those with the given tag and difficulty.
+type QuestionDraw struct {
+	BankID     int64  `json:"bank_id" validate:"required"`
+	Count      int64  `json:"count" validate:"required,min=1"`
+	Tag        string `json:"tag" validate:"max=100"`
+	Difficulty string `json:"difficulty" validate:"omitempty,oneof=easy medium hard"`
+}
+
+type SetLessonDraws struct {
+	LessonID int64          `json:"lesson_id" validate:"required"`
+	Draws    []QuestionDraw `json:"draws" validate:"dive"`
+}
+
+type DBBank struct {
+	ID             int64          `db:"id"`
+	Name           string         `db:"name"`
+	Description    sql.NullString `db:"description"`
+	LessonID       sql.NullInt64  `db:"lesson_id"`
+	ChannelID      sql.NullInt64  `db:"channel_id"`
+	CreatedBy      int64          `db:"created_by"`
+	LastModifiedBy int64          `db:"last_modified_by"`
+	CreatedAt      time.Time      `db:"created_at"`
+	Modified       sql.NullTime   `db:"modified"`
+}
+
+func (b DBBank) toBank() Bank {
+	return Bank{
+		ID:             b.ID,
+		Name:           b.Name,
+		Description:    b.Description.String,
+		LessonID:       b.LessonID.Int64,
+		ChannelID:      b.ChannelID.Int64,
+		CreatedBy:      b.CreatedBy,
+		LastModifiedBy: b.LastModifiedBy,
+		CreatedAt:      b.CreatedAt,
+		Modified:       b.Modified.Time,
+	}
+}
@@ -6,16 +6,22 @@ import (
 	"fmt"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type ChannelPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewChannelStorage(db *pgxpool.Pool) *ChannelPostgresStorage {
-	return &ChannelPostgresStorage{db: db}
+	return &ChannelPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const createChannelQuery = `
@@ -74,7 +80,8 @@ const getChannelWithPlansQuery = `
 	LEFT JOIN
 		plans p ON cp.plan_id = p.id
 	WHERE
-		c.id = $1`
+		c.id = $1
+	ORDER BY cp.position, p.id`
 
 func (c *ChannelPostgresStorage) GetChannelByID(ctx context.Context, channelID int64) (ChannelWithPlans, error) {
 	const op = "storage.postgresql.channels.channels.GetChannelByID"
@@ -221,21 +228,121 @@ func (c *ChannelPostgresStorage) UpdateChannel(ctx context.Context, updChannel U
 	return id, nil
 }
 
-const deleteChannelQuery = `
+const (
+	lockChannelPlansQuery = `
+	SELECT p.id
+	FROM plans p
+	INNER JOIN channels_plans cp ON p.id = cp.plan_id
+	WHERE cp.channel_id = $1
+	ORDER BY p.id
+	FOR UPDATE OF p`
+	deleteChannelQuery = `
 	DELETE FROM channels
 	WHERE id = $1`
+	deleteOrphanedPlansQuery = `
+	DELETE FROM plans p
+	WHERE
+		p.id = ANY($1) AND
+		NOT EXISTS (
+			SELECT 1
+			FROM channels_plans cp
+			WHERE cp.plan_id = p.id
+		)`
+)
 
+// DeleteChannel deletes the channel together with its plans which are not
+// attached to any other channel.
 func (c *ChannelPostgresStorage) DeleteChannel(ctx context.Context, id int64) error {
 	const op = "storage.postgresql.channels.channels.DeleteChannel"
 
-	res, err := c.db.Exec(ctx, deleteChannelQuery, id)
+	return c.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, c.db)
+
+		if err := postgresql.LockChannel(ctx, conn, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := conn.Query(ctx, lockChannelPlansQuery, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		planIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+
+		if _, err := conn.Exec(ctx, deleteChannelQuery, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, deleteOrphanedPlansQuery, planIDs); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const (
+	checkPlanExistsQuery = `
+	SELECT EXISTS(SELECT 1 FROM plans WHERE id = $1)`
+	getPlanChannelsQuery = `
+	SELECT
+		c.id,
+		c.name,
+		c.description,
+		c.created_by,
+		c.last_modified_by,
+		c.created_at,
+		c.modified
+	FROM
+		channels c
+	INNER JOIN
+		channels_plans cp ON c.id = cp.channel_id
+	WHERE cp.plan_id = $1
+	ORDER BY c.id`
+)
+
+// GetPlanChannels returns channels the plan is attached to.
+func (c *ChannelPostgresStorage) GetPlanChannels(ctx context.Context, planID int64) ([]Channel, error) {
+	const op = "storage.postgresql.channels.channels.GetPlanChannels"
+
+	conn := postgresql.Conn(ctx, c.db)
+
+	var exists bool
+	if err := conn.QueryRow(ctx, checkPlanExistsQuery, planID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrPlanNotFound)
+	}
+
+	rows, err := conn.Query(ctx, getPlanChannelsQuery, planID)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var channels []Channel
+	for rows.Next() {
+		var channel DBChannel
+		if err := rows.Scan(
+			&channel.ID,
+			&channel.Name,
+			&channel.Description,
+			&channel.CreatedBy,
+			&channel.LastModifiedBy,
+			&channel.CreatedAt,
+			&channel.Modified,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		channels = append(channels, Channel(channel))
 	}
 
-	if res.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrChannelNotFound)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return channels, nil
 }
@@ -0,0 +1,340 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	cloneLessonQuery = `
+	INSERT INTO lessons(
+		name,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified,
+		max_attempts,
+		attempt_cooldown_seconds,
+		time_limit_seconds,
+		require_all_pages_viewed,
+		shuffle_questions,
+		shuffle_options,
+		description,
+		estimated_duration_minutes,
+		difficulty,
+		learning_objectives,
+		cover_image_url
+	)
+	SELECT
+		COALESCE(NULLIF($2, ''), name),
+		$3,
+		$3,
+		now(),
+		now(),
+		max_attempts,
+		attempt_cooldown_seconds,
+		time_limit_seconds,
+		require_all_pages_viewed,
+		shuffle_questions,
+		shuffle_options,
+		description,
+		estimated_duration_minutes,
+		difficulty,
+		learning_objectives,
+		cover_image_url
+	FROM lessons
+	WHERE id = $1
+	RETURNING id`
+	clonePlansLessonsQuery = `
+	INSERT INTO plans_lessons(plan_id, lesson_id, position)
+	VALUES ($1, $2, $3)`
+	getLessonPagesQuery = `
+	SELECT id, content_type
+	FROM pages_abstractpages
+	WHERE lesson_id = $1
+	ORDER BY position, id`
+	clonePageQuery = `
+	INSERT INTO pages_abstractpages(
+		lesson_id,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified,
+		content_type,
+		position
+	)
+	SELECT $2, $3, $3, now(), now(), content_type, position
+	FROM pages_abstractpages
+	WHERE id = $1
+	RETURNING id`
+	getPageQuestionQuery = `
+	SELECT question_id
+	FROM question_questionpage
+	WHERE abstractpage_id = $1`
+	cloneQuestionQuery = `
+	INSERT INTO question_abstractquestion(question_type, explanation)
+	SELECT question_type, explanation
+	FROM question_abstractquestion
+	WHERE id = $1
+	RETURNING id`
+	cloneQuestionPageQuery = `
+	INSERT INTO question_questionpage(abstractpage_id, question_id)
+	VALUES ($1, $2)`
+	getLessonBanksQuery = `
+	SELECT id
+	FROM question_bank
+	WHERE lesson_id = $1
+	ORDER BY id`
+	cloneBankQuery = `
+	INSERT INTO question_bank(
+		name,
+		description,
+		lesson_id,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified
+	)
+	SELECT name, description, $2, $3, $3, now(), now()
+	FROM question_bank
+	WHERE id = $1
+	RETURNING id`
+	cloneBankItemsQuery = `
+	INSERT INTO question_bankitem(bank_id, page_id, tag, difficulty)
+	SELECT $2, COALESCE(m.new_id, bi.page_id), bi.tag, bi.difficulty
+	FROM question_bankitem bi
+	LEFT JOIN unnest($3::integer[], $4::integer[]) AS m(old_id, new_id) ON bi.page_id = m.old_id
+	WHERE bi.bank_id = $1`
+	cloneBankDrawsQuery = `
+	INSERT INTO question_bankdraw(lesson_id, bank_id, position, count, tag, difficulty)
+	SELECT $2, COALESCE(m.new_id, d.bank_id), d.position, d.count, d.tag, d.difficulty
+	FROM question_bankdraw d
+	LEFT JOIN unnest($3::integer[], $4::integer[]) AS m(old_id, new_id) ON d.bank_id = m.old_id
+	WHERE d.lesson_id = $1`
+)
+
+// cloneContentQueries copy the typed row of an image, video or pdf page.
+// Each of them takes the source page ID and the copy ID.
+var cloneContentQueries = map[string]string{
+	"image": `
+	INSERT INTO image_imagepage(abstractpage_id, image_file_url, image_name)
+	SELECT $2, image_file_url, image_name
+	FROM image_imagepage
+	WHERE abstractpage_id = $1`,
+	"video": `
+	INSERT INTO video_videopage(abstractpage_id, video_file_url, video_name)
+	SELECT $2, video_file_url, video_name
+	FROM video_videopage
+	WHERE abstractpage_id = $1`,
+	"pdf": `
+	INSERT INTO pdf_pdfpage(abstractpage_id, pdf_file_url, pdf_name)
+	SELECT $2, pdf_file_url, pdf_name
+	FROM pdf_pdfpage
+	WHERE abstractpage_id = $1`,
+}
+
+// cloneQuestionTypeQueries copy typed rows of a question. Each of them takes
+// the source question ID and the copy ID and copies nothing for other types.
+// Parent rows go before their items.
+var cloneQuestionTypeQueries = []string{
+	`
+	INSERT INTO question_multichoicequestion(question_abstractquestion_id, question)
+	SELECT $2, question
+	FROM question_multichoicequestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_multiselectquestion(question_abstractquestion_id, question, grading_mode)
+	SELECT $2, question, grading_mode
+	FROM question_multiselectquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_option(question_abstractquestion_id, position, text, is_correct, feedback)
+	SELECT $2, position, text, is_correct, feedback
+	FROM question_option
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_shortanswerquestion(
+		question_abstractquestion_id,
+		question,
+		accepted_answers,
+		match_mode,
+		trim_whitespace
+	)
+	SELECT $2, question, accepted_answers, match_mode, trim_whitespace
+	FROM question_shortanswerquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_truefalsequestion(question_abstractquestion_id, question, answer)
+	SELECT $2, question, answer
+	FROM question_truefalsequestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_numericquestion(
+		question_abstractquestion_id,
+		question,
+		answer,
+		tolerance,
+		min_value,
+		max_value
+	)
+	SELECT $2, question, answer, tolerance, min_value, max_value
+	FROM question_numericquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_fillblanksquestion(question_abstractquestion_id, question, match_mode, trim_whitespace)
+	SELECT $2, question, match_mode, trim_whitespace
+	FROM question_fillblanksquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_fillblank(fillblanksquestion_id, position, accepted_answers)
+	SELECT nq.id, fb.position, fb.accepted_answers
+	FROM question_fillblank fb
+	INNER JOIN question_fillblanksquestion oq ON fb.fillblanksquestion_id = oq.id
+	INNER JOIN question_fillblanksquestion nq ON nq.question_abstractquestion_id = $2
+	WHERE oq.question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_orderingquestion(question_abstractquestion_id, question)
+	SELECT $2, question
+	FROM question_orderingquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_orderingitem(orderingquestion_id, position, text)
+	SELECT nq.id, oi.position, oi.text
+	FROM question_orderingitem oi
+	INNER JOIN question_orderingquestion oq ON oi.orderingquestion_id = oq.id
+	INNER JOIN question_orderingquestion nq ON nq.question_abstractquestion_id = $2
+	WHERE oq.question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_matchingquestion(question_abstractquestion_id, question)
+	SELECT $2, question
+	FROM question_matchingquestion
+	WHERE question_abstractquestion_id = $1`,
+	`
+	INSERT INTO question_matchingpair(matchingquestion_id, position, left_item, right_item)
+	SELECT nq.id, mp.position, mp.left_item, mp.right_item
+	FROM question_matchingpair mp
+	INNER JOIN question_matchingquestion oq ON mp.matchingquestion_id = oq.id
+	INNER JOIN question_matchingquestion nq ON nq.question_abstractquestion_id = $2
+	WHERE oq.question_abstractquestion_id = $1`,
+}
+
+type pageToClone struct {
+	id          int64
+	contentType string
+}
+
+// CloneLesson copies the lesson with its pages, questions, lesson banks and
+// draw rules into the plan at the given position and returns ID of the copy.
+// The copy is created by createdBy and named name, an empty name keeps the name
+// of the source lesson. Draw rules of channel banks are kept as they are.
+// Conn should be a transaction.
+func CloneLesson(ctx context.Context, conn Querier, lessonID, planID, position int64, name string, createdBy int64) (int64, error) {
+	const op = "storage.postgresql.CloneLesson"
+
+	var cloneID int64
+	err := conn.QueryRow(ctx, cloneLessonQuery, lessonID, name, createdBy).Scan(&cloneID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := conn.Exec(ctx, clonePlansLessonsQuery, planID, cloneID, position); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := conn.Query(ctx, getLessonPagesQuery, lessonID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	pages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (pageToClone, error) {
+		var page pageToClone
+		err := row.Scan(&page.id, &page.contentType)
+		return page, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+	}
+
+	oldPageIDs := make([]int64, 0, len(pages))
+	newPageIDs := make([]int64, 0, len(pages))
+	for _, page := range pages {
+		pageID, err := clonePage(ctx, conn, page, cloneID, createdBy)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		oldPageIDs = append(oldPageIDs, page.id)
+		newPageIDs = append(newPageIDs, pageID)
+	}
+
+	rows, err = conn.Query(ctx, getLessonBanksQuery, lessonID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	bankIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+	}
+
+	newBankIDs := make([]int64, 0, len(bankIDs))
+	for _, bankID := range bankIDs {
+		var newBankID int64
+		err := conn.QueryRow(ctx, cloneBankQuery, bankID, cloneID, createdBy).Scan(&newBankID)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, cloneBankItemsQuery, bankID, newBankID, oldPageIDs, newPageIDs)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		newBankIDs = append(newBankIDs, newBankID)
+	}
+
+	if _, err := conn.Exec(ctx, cloneBankDrawsQuery, lessonID, cloneID, bankIDs, newBankIDs); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cloneID, nil
+}
+
+func clonePage(ctx context.Context, conn Querier, page pageToClone, lessonID, createdBy int64) (int64, error) {
+	var pageID int64
+	if err := conn.QueryRow(ctx, clonePageQuery, page.id, lessonID, createdBy).Scan(&pageID); err != nil {
+		return 0, err
+	}
+
+	if page.contentType != "question" {
+		if _, err := conn.Exec(ctx, cloneContentQueries[page.contentType], page.id, pageID); err != nil {
+			return 0, err
+		}
+		return pageID, nil
+	}
+
+	var sourceQuestionID int64
+	if err := conn.QueryRow(ctx, getPageQuestionQuery, page.id).Scan(&sourceQuestionID); err != nil {
+		return 0, err
+	}
+
+	var questionID int64
+	if err := conn.QueryRow(ctx, cloneQuestionQuery, sourceQuestionID).Scan(&questionID); err != nil {
+		return 0, err
+	}
+
+	if _, err := conn.Exec(ctx, cloneQuestionPageQuery, pageID, questionID); err != nil {
+		return 0, err
+	}
+
+	for _, query := range cloneQuestionTypeQueries {
+		if _, err := conn.Exec(ctx, query, sourceQuestionID, questionID); err != nil {
+			return 0, err
+		}
+	}
+
+	return pageID, nil
+}
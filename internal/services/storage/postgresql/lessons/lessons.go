@@ -4,82 +4,150 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"slices"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type LessonsPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewLessonsStorage(db *pgxpool.Pool) *LessonsPostgresStorage {
-	return &LessonsPostgresStorage{db: db}
+	return &LessonsPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const (
 	createLessonQuery = `
-	INSERT INTO lessons(name, created_by, last_modified_by, created_at, modified)
-	VALUES ($1, $2, $3, $4, $5)
+	INSERT INTO lessons(
+		name,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified,
+		max_attempts,
+		attempt_cooldown_seconds,
+		time_limit_seconds,
+		require_all_pages_viewed,
+		shuffle_questions,
+		shuffle_options,
+		description,
+		estimated_duration_minutes,
+		difficulty,
+		learning_objectives,
+		cover_image_url
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, ''), COALESCE($15, '{}'), $16)
 	RETURNING id`
 	createPlansLessonsQuery = `
-	INSERT INTO plans_lessons(plan_id, lesson_id)
-	VALUES ($1, $2)`
+	INSERT INTO plans_lessons(plan_id, lesson_id, position)
+	VALUES ($1, $2, $3)`
+	lessonNameTakenQuery = `
+	SELECT EXISTS(
+		SELECT 1
+		FROM plans_lessons pl
+		INNER JOIN lessons l ON pl.lesson_id = l.id
+		WHERE pl.plan_id = $1 AND l.name = $2 AND l.id <> $3
+	)`
 )
 
 func (l *LessonsPostgresStorage) CreateLesson(ctx context.Context, lesson CreateLesson) (int64, error) {
 	const op = "storage.postgresql.lessons.lessons.CreateLesson"
 
-	tx, err := l.db.Begin(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
-	defer func() {
+	var lessonID int64
+
+	err := l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		err := conn.QueryRow(ctx, createLessonQuery,
+			lesson.Name,
+			lesson.CreatedBy,
+			lesson.LastModifiedBy,
+			lesson.CreatedAt,
+			lesson.Modified,
+			lesson.MaxAttempts,
+			lesson.AttemptCooldownSeconds,
+			lesson.TimeLimitSeconds,
+			lesson.RequireAllPagesViewed,
+			lesson.ShuffleQuestions,
+			lesson.ShuffleOptions,
+			lesson.Description,
+			lesson.EstimatedDurationMinutes,
+			lesson.Difficulty,
+			lesson.LearningObjectives,
+			lesson.CoverImageURL,
+		).Scan(&lessonID)
 		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == "23505" { // unique violation code
+					return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+				}
 			}
+			return fmt.Errorf("%s: %w", op, err)
 		}
-	}()
 
-	var lessonID int64
-	err = tx.QueryRow(ctx, createLessonQuery,
-		lesson.Name,
-		lesson.CreatedBy,
-		lesson.LastModifiedBy,
-		lesson.CreatedAt,
-		lesson.Modified,
-	).Scan(&lessonID)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			if pgErr.Code == "23505" { // unique violation code
-				return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
-			}
+		position, err := postgresql.NextLessonPosition(ctx, conn, lesson.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		return 0, fmt.Errorf("%s: %w", op, err)
-	}
 
-	_, err = tx.Exec(ctx,
-		createPlansLessonsQuery,
-		lesson.PlanID,
-		lessonID,
-	)
-	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
+		var taken bool
+		err = conn.QueryRow(ctx, lessonNameTakenQuery, lesson.PlanID, lesson.Name, lessonID).Scan(&taken)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, storage.ErrLessonExitsts)
+		}
+
+		_, err = conn.Exec(ctx,
+			createPlansLessonsQuery,
+			lesson.PlanID,
+			lessonID,
+			position,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
+		}
 
-	if err = tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return lessonID, nil
 }
 
 const getLessonByIDQuery = `
-	SELECT id, name, created_by, last_modified_by, created_at, modified 
+	SELECT
+		id,
+		name,
+		created_by,
+		last_modified_by,
+		created_at,
+		modified,
+		max_attempts,
+		attempt_cooldown_seconds,
+		time_limit_seconds,
+		require_all_pages_viewed,
+		shuffle_questions,
+		shuffle_options,
+		pages_version,
+		description,
+		estimated_duration_minutes,
+		COALESCE(difficulty, ''),
+		learning_objectives,
+		cover_image_url
 	FROM lessons 
 	WHERE id = $1`
 
@@ -88,13 +156,25 @@ func (l *LessonsPostgresStorage) GetLessonByID(ctx context.Context, lessonID int
 
 	var lesson DBLesson
 
-	err := l.db.QueryRow(ctx, getLessonByIDQuery, lessonID).Scan(
+	err := postgresql.Conn(ctx, l.db).QueryRow(ctx, getLessonByIDQuery, lessonID).Scan(
 		&lesson.ID,
 		&lesson.Name,
 		&lesson.CreatedBy,
 		&lesson.LastModifiedBy,
 		&lesson.CreatedAt,
 		&lesson.Modified,
+		&lesson.MaxAttempts,
+		&lesson.AttemptCooldownSeconds,
+		&lesson.TimeLimitSeconds,
+		&lesson.RequireAllPagesViewed,
+		&lesson.ShuffleQuestions,
+		&lesson.ShuffleOptions,
+		&lesson.PagesVersion,
+		&lesson.Description,
+		&lesson.EstimatedDurationMinutes,
+		&lesson.Difficulty,
+		&lesson.LearningObjectives,
+		&lesson.CoverImageURL,
 	)
 	if err != nil {
 		return (Lesson)(lesson), fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
@@ -110,7 +190,19 @@ const getLessonsQuery = `
 		l.created_by AS lesson_created_by,
 		l.last_modified_by AS lesson_last_modified_by,
 		l.created_at AS lesson_created_at,
-		l.modified AS lesson_modified
+		l.modified AS lesson_modified,
+		l.max_attempts AS lesson_max_attempts,
+		l.attempt_cooldown_seconds AS lesson_attempt_cooldown_seconds,
+		l.time_limit_seconds AS lesson_time_limit_seconds,
+		l.require_all_pages_viewed AS lesson_require_all_pages_viewed,
+		l.shuffle_questions AS lesson_shuffle_questions,
+		l.shuffle_options AS lesson_shuffle_options,
+		l.pages_version AS lesson_pages_version,
+		l.description AS lesson_description,
+		l.estimated_duration_minutes AS lesson_estimated_duration_minutes,
+		COALESCE(l.difficulty, '') AS lesson_difficulty,
+		l.learning_objectives AS lesson_learning_objectives,
+		l.cover_image_url AS lesson_cover_image_url
 	FROM 
 		lessons l
 	INNER JOIN 
@@ -118,7 +210,7 @@ const getLessonsQuery = `
 	INNER JOIN 
 		plans p ON pl.plan_id = p.id
 	WHERE pl.plan_id = $1
-	ORDER BY l.id
+	ORDER BY pl.position, l.id
 	LIMIT $2 OFFSET $3`
 
 func (l *LessonsPostgresStorage) GetLessons(ctx context.Context, planID int64, limit, offset int64) ([]Lesson, error) {
@@ -126,7 +218,7 @@ func (l *LessonsPostgresStorage) GetLessons(ctx context.Context, planID int64, l
 
 	var lessons []DBLesson
 
-	rows, err := l.db.Query(ctx, getLessonsQuery, planID, limit, offset)
+	rows, err := postgresql.Conn(ctx, l.db).Query(ctx, getLessonsQuery, planID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -141,6 +233,18 @@ func (l *LessonsPostgresStorage) GetLessons(ctx context.Context, planID int64, l
 			&lesson.LastModifiedBy,
 			&lesson.CreatedAt,
 			&lesson.Modified,
+			&lesson.MaxAttempts,
+			&lesson.AttemptCooldownSeconds,
+			&lesson.TimeLimitSeconds,
+			&lesson.RequireAllPagesViewed,
+			&lesson.ShuffleQuestions,
+			&lesson.ShuffleOptions,
+			&lesson.PagesVersion,
+			&lesson.Description,
+			&lesson.EstimatedDurationMinutes,
+			&lesson.Difficulty,
+			&lesson.LearningObjectives,
+			&lesson.CoverImageURL,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
 		}
@@ -159,27 +263,94 @@ func (l *LessonsPostgresStorage) GetLessons(ctx context.Context, planID int64, l
 	return mappedLessons, nil
 }
 
+const (
+	lockLessonPlansQuery = `
+	SELECT p.id
+	FROM plans p
+	INNER JOIN plans_lessons pl ON p.id = pl.plan_id
+	WHERE pl.lesson_id = $1
+	ORDER BY p.id
+	FOR UPDATE OF p`
+	lessonNameTakenInPlansQuery = `
+	SELECT EXISTS(
+		SELECT 1
+		FROM plans_lessons own
+		INNER JOIN plans_lessons pl ON own.plan_id = pl.plan_id
+		INNER JOIN lessons l ON pl.lesson_id = l.id
+		WHERE own.lesson_id = $1 AND l.id <> $1 AND l.name = $2
+	)`
+)
+
 const updateLessonQuery = `
 	UPDATE lessons 
 	SET name = COALESCE($2, name), 
 	    last_modified_by = $3, 
+	    max_attempts = COALESCE($4, max_attempts),
+	    attempt_cooldown_seconds = COALESCE($5, attempt_cooldown_seconds),
+	    time_limit_seconds = COALESCE($6, time_limit_seconds),
+	    require_all_pages_viewed = COALESCE($7, require_all_pages_viewed),
+	    shuffle_questions = COALESCE($8, shuffle_questions),
+	    shuffle_options = COALESCE($9, shuffle_options),
+	    description = COALESCE($10, description),
+	    estimated_duration_minutes = COALESCE($11, estimated_duration_minutes),
+	    difficulty = CASE WHEN $12::varchar IS NULL THEN difficulty ELSE NULLIF($12, '') END,
+	    learning_objectives = COALESCE($13, learning_objectives),
+	    cover_image_url = COALESCE($14, cover_image_url),
 	    modified = now() 
 	WHERE id = $1
 	RETURNING id`
 
+// UpdateLesson performs a partial update of the lesson. Lesson names are unique
+// within a plan, so a new name must not be taken in any plan of the lesson.
 func (l *LessonsPostgresStorage) UpdateLesson(ctx context.Context, updLesson UpdateLessonRequest) (int64, error) {
 	const op = "storage.postgresql.lesson.lesson.UpdateLesson"
 
 	var id int64
 
-	err := l.db.QueryRow(ctx, updateLessonQuery,
-		updLesson.ID,
-		updLesson.Name,
-		updLesson.LastModifiedBy,
-	).Scan(&id)
+	err := l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		if updLesson.Name != nil {
+			if _, err := conn.Exec(ctx, lockLessonPlansQuery, updLesson.ID); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+
+			var taken bool
+			err := conn.QueryRow(ctx, lessonNameTakenInPlansQuery, updLesson.ID, *updLesson.Name).Scan(&taken)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			if taken {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonExitsts)
+			}
+		}
+
+		err := conn.QueryRow(ctx, updateLessonQuery,
+			updLesson.ID,
+			updLesson.Name,
+			updLesson.LastModifiedBy,
+			updLesson.MaxAttempts,
+			updLesson.AttemptCooldownSeconds,
+			updLesson.TimeLimitSeconds,
+			updLesson.RequireAllPagesViewed,
+			updLesson.ShuffleQuestions,
+			updLesson.ShuffleOptions,
+			updLesson.Description,
+			updLesson.EstimatedDurationMinutes,
+			updLesson.Difficulty,
+			updLesson.LearningObjectives,
+			updLesson.CoverImageURL,
+		).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		return 0, err
 	}
+
 	return id, nil
 }
 
@@ -190,7 +361,7 @@ const deleteLessonQuery = `
 func (l *LessonsPostgresStorage) DeleteLesson(ctx context.Context, id int64) error {
 	const op = "storage.postgresql.lessons.lessons.DeleteLesson"
 
-	res, err := l.db.Exec(ctx, deleteLessonQuery, id)
+	res, err := postgresql.Conn(ctx, l.db).Exec(ctx, deleteLessonQuery, id)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
 	}
@@ -201,3 +372,293 @@ func (l *LessonsPostgresStorage) DeleteLesson(ctx context.Context, id int64) err
 
 	return nil
 }
+
+const (
+	getPlanLessonIDsQuery = `
+	SELECT lesson_id
+	FROM plans_lessons
+	WHERE plan_id = $1`
+	reorderLessonsQuery = `
+	UPDATE plans_lessons pl
+	SET position = o.ord - 1
+	FROM unnest($2::integer[]) WITH ORDINALITY AS o(lesson_id, ord)
+	WHERE pl.lesson_id = o.lesson_id AND pl.plan_id = $1`
+	touchPlanQuery = `
+	UPDATE plans
+	SET last_modified_by = $2, modified = now()
+	WHERE id = $1`
+)
+
+// ReorderLessons rewrites positions of the plan lessons in the given order.
+// Ordered IDs must list every lesson of the plan once.
+func (l *LessonsPostgresStorage) ReorderLessons(ctx context.Context, reorder ReorderLessons) error {
+	const op = "storage.postgresql.lessons.lessons.ReorderLessons"
+
+	return l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		if err := postgresql.LockPlan(ctx, conn, reorder.PlanID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := conn.Query(ctx, getPlanLessonIDsQuery, reorder.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		lessonIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+
+		if !postgresql.SameIDs(lessonIDs, reorder.OrderedIDs) {
+			return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		}
+
+		if _, err := conn.Exec(ctx, reorderLessonsQuery, reorder.PlanID, reorder.OrderedIDs); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, touchPlanQuery, reorder.PlanID, reorder.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const (
+	getLessonNameQuery = `
+	SELECT name
+	FROM lessons
+	WHERE id = $1
+	FOR UPDATE`
+	detachLessonQuery = `
+	DELETE FROM plans_lessons
+	WHERE plan_id = $1 AND lesson_id = $2
+	RETURNING position`
+	countLessonPlansQuery = `
+	SELECT COUNT(*)
+	FROM plans_lessons
+	WHERE lesson_id = $1`
+)
+
+// AttachLessonToPlan adds the existing lesson to the end of the plan.
+func (l *LessonsPostgresStorage) AttachLessonToPlan(ctx context.Context, attach LessonInPlan) error {
+	const op = "storage.postgresql.lessons.lessons.AttachLessonToPlan"
+
+	return l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		position, err := postgresql.NextLessonPosition(ctx, conn, attach.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var name string
+		err = conn.QueryRow(ctx, getLessonNameQuery, attach.LessonID).Scan(&name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var taken bool
+		err = conn.QueryRow(ctx, lessonNameTakenQuery, attach.PlanID, name, attach.LessonID).Scan(&taken)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, storage.ErrLessonExitsts)
+		}
+
+		_, err = conn.Exec(ctx, createPlansLessonsQuery, attach.PlanID, attach.LessonID, position)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique violation code
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonInPlan)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, touchPlanQuery, attach.PlanID, attach.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// DetachLessonFromPlan removes the lesson from the plan. A lesson must stay in
+// at least one plan, the last one is left with DeleteLesson.
+// Attempts taken in the plan are kept.
+func (l *LessonsPostgresStorage) DetachLessonFromPlan(ctx context.Context, detach LessonInPlan) error {
+	const op = "storage.postgresql.lessons.lessons.DetachLessonFromPlan"
+
+	return l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		if err := postgresql.LockPlan(ctx, conn, detach.PlanID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var name string
+		err := conn.QueryRow(ctx, getLessonNameQuery, detach.LessonID).Scan(&name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var position int64
+		err = conn.QueryRow(ctx, detachLessonQuery, detach.PlanID, detach.LessonID).Scan(&position)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := postgresql.ReleaseLessonPosition(ctx, conn, detach.PlanID, position); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var left int64
+		if err := conn.QueryRow(ctx, countLessonPlansQuery, detach.LessonID).Scan(&left); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if left == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLastLessonPlan)
+		}
+
+		if _, err := conn.Exec(ctx, touchPlanQuery, detach.PlanID, detach.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// CloneLesson copies the lesson with its pages and questions to the end of the plan
+// and returns ID of the copy. An empty name keeps the name of the source lesson.
+func (l *LessonsPostgresStorage) CloneLesson(ctx context.Context, clone CloneLesson) (int64, error) {
+	const op = "storage.postgresql.lessons.lessons.CloneLesson"
+
+	var lessonID int64
+
+	err := l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		position, err := postgresql.NextLessonPosition(ctx, conn, clone.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		name := clone.Name
+		if name == "" {
+			err := conn.QueryRow(ctx, getLessonNameQuery, clone.LessonID).Scan(&name)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+				}
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		var taken bool
+		err = conn.QueryRow(ctx, lessonNameTakenQuery, clone.PlanID, name, 0).Scan(&taken)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, storage.ErrLessonExitsts)
+		}
+
+		lessonID, err = postgresql.CloneLesson(ctx, conn, clone.LessonID, clone.PlanID, position, name, clone.CreatedBy)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return lessonID, nil
+}
+
+// MoveLesson moves the lesson from one plan to the end of another one.
+// The lesson keeps its ID, so its pages stay as they are. Past attempts keep
+// the plan and channel they were taken in.
+func (l *LessonsPostgresStorage) MoveLesson(ctx context.Context, move MoveLesson) error {
+	const op = "storage.postgresql.lessons.lessons.MoveLesson"
+
+	return l.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, l.db)
+
+		// Both plans are locked in ID order, so moves in opposite directions
+		// don't deadlock.
+		planIDs := []int64{move.FromPlanID, move.ToPlanID}
+		slices.Sort(planIDs)
+		for _, planID := range planIDs {
+			if err := postgresql.LockPlan(ctx, conn, planID); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		var name string
+		err := conn.QueryRow(ctx, getLessonNameQuery, move.LessonID).Scan(&name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var fromPosition int64
+		err = conn.QueryRow(ctx, detachLessonQuery, move.FromPlanID, move.LessonID).Scan(&fromPosition)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := postgresql.ReleaseLessonPosition(ctx, conn, move.FromPlanID, fromPosition); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var taken bool
+		err = conn.QueryRow(ctx, lessonNameTakenQuery, move.ToPlanID, name, move.LessonID).Scan(&taken)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if taken {
+			return fmt.Errorf("%s: %w", op, storage.ErrLessonExitsts)
+		}
+
+		position, err := postgresql.NextLessonPosition(ctx, conn, move.ToPlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, createPlansLessonsQuery, move.ToPlanID, move.LessonID, position)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique violation code
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonInPlan)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, planID := range planIDs {
+			if _, err := conn.Exec(ctx, touchPlanQuery, planID, move.LastModifiedBy); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		return nil
+	})
+}
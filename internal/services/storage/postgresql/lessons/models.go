@@ -3,12 +3,25 @@ package lessons
 import "time"
 
 type Lesson struct {
-	ID             int64
-	Name           string
-	CreatedBy      int64
-	LastModifiedBy int64
-	CreatedAt      time.Time
-	Modified       time.Time
+	ID                     int64
+	Name                   string
+	CreatedBy              int64
+	LastModifiedBy         int64
+	CreatedAt              time.Time
+	Modified               time.Time
+	MaxAttempts            int64
+	AttemptCooldownSeconds int64
+	TimeLimitSeconds       int64
+	RequireAllPagesViewed  bool
+	ShuffleQuestions       bool
+	ShuffleOptions         bool
+	PagesVersion           int64
+
+	Description              string
+	EstimatedDurationMinutes int64
+	Difficulty               string
+	LearningObjectives       []string
+	CoverImageURL            string
 }
 
 type CreateLesson struct {
@@ -19,19 +32,84 @@ type CreateLesson struct {
 	CreatedAt      time.Time `json:"created_at"`
 	Modified       time.Time `json:"modified"`
 	PlanID         int64     `json:"plan_id" validate:"required"`
+
+	MaxAttempts            int64 `json:"max_attempts" validate:"min=0"`
+	AttemptCooldownSeconds int64 `json:"attempt_cooldown_seconds" validate:"min=0"`
+	TimeLimitSeconds       int64 `json:"time_limit_seconds" validate:"min=0"`
+	RequireAllPagesViewed  bool  `json:"require_all_pages_viewed"`
+	ShuffleQuestions       bool  `json:"shuffle_questions"`
+	ShuffleOptions         bool  `json:"shuffle_options"`
+
+	Description              string   `json:"description"`
+	EstimatedDurationMinutes int64    `json:"estimated_duration_minutes" validate:"min=0"`
+	Difficulty               string   `json:"difficulty" validate:"omitempty,oneof=easy medium hard"`
+	LearningObjectives       []string `json:"learning_objectives" validate:"dive,required,max=500"`
+	CoverImageURL            string   `json:"cover_image_url" validate:"omitempty,url,max=2048"`
 }
 
 type UpdateLessonRequest struct {
 	ID             int64   `json:"id" validate:"required"`
 	Name           *string `json:"name,omitempty"`
 	LastModifiedBy int64   `json:"last_modified_by" validate:"required"`
+
+	MaxAttempts            *int64 `json:"max_attempts,omitempty" validate:"omitempty,min=0"`
+	AttemptCooldownSeconds *int64 `json:"attempt_cooldown_seconds,omitempty" validate:"omitempty,min=0"`
+	TimeLimitSeconds       *int64 `json:"time_limit_seconds,omitempty" validate:"omitempty,min=0"`
+	RequireAllPagesViewed  *bool  `json:"require_all_pages_viewed,omitempty"`
+	ShuffleQuestions       *bool  `json:"shuffle_questions,omitempty"`
+	ShuffleOptions         *bool  `json:"shuffle_options,omitempty"`
+
+	Description              *string   `json:"description,omitempty"`
+	EstimatedDurationMinutes *int64    `json:"estimated_duration_minutes,omitempty" validate:"omitempty,min=0"`
+	Difficulty               *string   `json:"difficulty,omitempty" validate:"omitempty,oneof='' easy medium hard"`
+	LearningObjectives       *[]string `json:"learning_objectives,omitempty" validate:"omitempty,dive,required,max=500"`
+	CoverImageURL            *string   `json:"cover_image_url,omitempty" validate:"omitempty,max=2048,eq=|url"`
+}
+
+type ReorderLessons struct {
+	PlanID         int64   `json:"plan_id" validate:"required"`
+	OrderedIDs     []int64 `json:"ordered_ids" validate:"required,min=1,unique"`
+	LastModifiedBy int64   `json:"last_modified_by" validate:"required"`
+}
+
+type LessonInPlan struct {
+	LessonID       int64 `json:"lesson_id" validate:"required"`
+	PlanID         int64 `json:"plan_id" validate:"required"`
+	LastModifiedBy int64 `json:"last_modified_by" validate:"required"`
+}
+
+type MoveLesson struct {
+	LessonID       int64 `json:"lesson_id" validate:"required"`
+	FromPlanID     int64 `json:"from_plan_id" validate:"required"`
+	ToPlanID       int64 `json:"to_plan_id" validate:"required,nefield=FromPlanID"`
+	LastModifiedBy int64 `json:"last_modified_by" validate:"required"`
+}
+
+type CloneLesson struct {
+	LessonID  int64  `json:"lesson_id" validate:"required"`
+	PlanID    int64  `json:"plan_id" validate:"required"`
+	Name      string `json:"name" validate:"max=255"`
+	CreatedBy int64  `json:"created_by" validate:"required"`
 }
 
 type DBLesson struct {
-	ID             int64     `db:"id"`
-	Name           string    `db:"name"`
-	CreatedBy      int64     `db:"created_by"`
-	LastModifiedBy int64     `db:"last_modified_by"`
-	CreatedAt      time.Time `db:"created_at"`
-	Modified       time.Time `db:"modified"`
+	ID                     int64     `db:"id"`
+	Name                   string    `db:"name"`
+	CreatedBy              int64     `db:"created_by"`
+	LastModifiedBy         int64     `db:"last_modified_by"`
+	CreatedAt              time.Time `db:"created_at"`
+	Modified               time.Time `db:"modified"`
+	MaxAttempts            int64     `db:"max_attempts"`
+	AttemptCooldownSeconds int64     `db:"attempt_cooldown_seconds"`
+	TimeLimitSeconds       int64     `db:"time_limit_seconds"`
+	RequireAllPagesViewed  bool      `db:"require_all_pages_viewed"`
+	ShuffleQuestions       bool      `db:"shuffle_questions"`
+	ShuffleOptions         bool      `db:"shuffle_options"`
+	PagesVersion           int64     `db:"pages_version"`
+
+	Description              string   `db:"description"`
+	EstimatedDurationMinutes int64    `db:"estimated_duration_minutes"`
+	Difficulty               string   `db:"difficulty"`
+	LearningObjectives       []string `db:"learning_objectives"`
+	CoverImageURL            string   `db:"cover_image_url"`
 }
@@ -27,6 +27,7 @@ type BasePage struct {
 	CreatedAt      time.Time `json:"created_at"`
 	Modified       time.Time `json:"modified"`
 	ContentType    string    `json:"content_type"`
+	Position       int64     `json:"position"`
 }
 
 type ImagePage struct {
@@ -52,6 +53,7 @@ type CreateBasePage struct {
 	CreatedBy      int64  `json:"created_by"`
 	LastModifiedBy int64  `json:"last_modified_by"`
 	ContentType    string `json:"content_type"`
+	Position       *int64 `json:"position,omitempty" validate:"omitempty,min=0"`
 }
 
 type CreateImagePage struct {
@@ -95,6 +97,24 @@ type UpdatePDFPage struct {
 	PdfName    string `json:"pdf_name,omitempty"`
 }
 
+// ReorderPages sets the order of all pages of the lesson. Version is the version
+// of page order the caller saw, it must still be current.
+type ReorderPages struct {
+	LessonID       int64   `json:"lesson_id" validate:"required"`
+	OrderedIDs     []int64 `json:"ordered_ids" validate:"required,min=1,unique"`
+	Version        int64   `json:"version" validate:"min=0"`
+	LastModifiedBy int64   `json:"last_modified_by" validate:"required"`
+}
+
+// MovePage moves the page to the target lesson. A nil or out of range position
+// puts the page after the last one.
+type MovePage struct {
+	PageID         int64  `json:"page_id" validate:"required"`
+	TargetLessonID int64  `json:"target_lesson_id" validate:"required"`
+	Position       *int64 `json:"position,omitempty" validate:"omitempty,min=0"`
+	LastModifiedBy int64  `json:"last_modified_by" validate:"required"`
+}
+
 type DBBasePage struct {
 	ID             int64     `db:"id"`
 	LessonID       int64     `db:"lesson_id"`
@@ -103,6 +123,7 @@ type DBBasePage struct {
 	CreatedAt      time.Time `db:"created_at"`
 	Modified       time.Time `db:"modified"`
 	ContentType    string    `db:"content_type"`
+	Position       int64     `db:"position"`
 }
 
 type DBImagePage struct {
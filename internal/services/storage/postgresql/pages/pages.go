@@ -2,26 +2,34 @@ package pages
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PagesPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewPagesStorage(db *pgxpool.Pool) *PagesPostgresStorage {
-	return &PagesPostgresStorage{db: db}
+	return &PagesPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const (
 	createAbstractPageQuery = `
-	INSERT INTO pages_abstractpages(lesson_id, created_by, last_modified_by, created_at, modified, content_type)
-	VALUES ($1, $2, $3, now(), now(), $4)
+	INSERT INTO pages_abstractpages(lesson_id, created_by, last_modified_by, created_at, modified, content_type, position)
+	VALUES ($1, $2, $3, now(), now(), $4, $5)
 	RETURNING id`
 	updateAbstractPageQuery = `
 	UPDATE pages_abstractpages
@@ -38,29 +46,6 @@ func (p *PagesPostgresStorage) insertPageSpecific(ctx context.Context, tx pgx.Tx
 	return nil
 }
 
-func (p *PagesPostgresStorage) insertAbstractPage(ctx context.Context, page CreatePage) (int64, pgx.Tx, error) {
-	tx, err := p.db.Begin(ctx)
-	if err != nil {
-		return 0, nil, fmt.Errorf("create: %w", storage.ErrFailedTransaction)
-	}
-
-	commonFields := page.GetCommonFields()
-	var pageID int64
-	err = tx.QueryRow(
-		ctx,
-		createAbstractPageQuery,
-		commonFields.LessonID,
-		commonFields.CreatedBy,
-		commonFields.LastModifiedBy,
-		commonFields.ContentType,
-	).Scan(&pageID)
-	if err != nil {
-		return 0, nil, fmt.Errorf("create abstract: %w", err)
-	}
-
-	return pageID, tx, err
-}
-
 func (p *PagesPostgresStorage) updateAbstractPage(ctx context.Context, page UpdatePage) (int64, pgx.Tx, error) {
 	tx, err := p.db.Begin(ctx)
 	if err != nil {
@@ -81,35 +66,51 @@ func (p *PagesPostgresStorage) updateAbstractPage(ctx context.Context, page Upda
 	return commonFields.ID, tx, err
 }
 
+// CreatePage creates the page at the requested position of the lesson,
+// shifting the following pages down.
 func (p *PagesPostgresStorage) CreatePage(ctx context.Context, page CreatePage) (int64, error) {
 	const op = "storage.postgresql.pages.pages.CreatePage"
 
-	pageID, tx, err := p.insertAbstractPage(ctx, page)
-	if err != nil {
-		return 0, fmt.Errorf("CreatePage abstract: %w", err)
-	}
-	defer func(err error) {
+	var pageID int64
+
+	err := p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		commonFields := page.GetCommonFields()
+		position, err := postgresql.ReservePagePosition(ctx, conn, commonFields.LessonID, commonFields.Position)
 		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
-			}
+			return fmt.Errorf("%s: %w", op, err)
 		}
-	}(err)
 
-	err = p.insertPageSpecific(
-		ctx,
-		tx,
-		page.GetInsertQuery(),
-		append([]interface{}{pageID},
-			page.GetContentTypeSpecificFields()...)...,
-	)
+		err = conn.QueryRow(
+			ctx,
+			createAbstractPageQuery,
+			commonFields.LessonID,
+			commonFields.CreatedBy,
+			commonFields.LastModifiedBy,
+			commonFields.ContentType,
+			position,
+		).Scan(&pageID)
+		if err != nil {
+			return fmt.Errorf("%s: create abstract: %w", op, err)
+		}
+
+		_, err = conn.Exec(
+			ctx,
+			page.GetInsertQuery(),
+			append([]interface{}{pageID},
+				page.GetContentTypeSpecificFields()...)...,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: create page specific: %w", op, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("create page specific: %w", err)
+		return 0, err
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("create page commit: %w", storage.ErrCommitTransaction)
-	}
 	return pageID, nil
 }
 
@@ -123,6 +124,7 @@ const (
 		ab.created_at AS created_at, 
 		ab.modified AS modified, 
 		ab.content_type AS content_type,
+		ab.position AS position,
 		ip.image_file_url AS image_file_url,
 		ip.image_name AS image_name
 	FROM
@@ -139,6 +141,7 @@ const (
 		ab.created_at AS created_at, 
 		ab.modified AS modified, 
 		ab.content_type AS content_type,
+		ab.position AS position,
 		vp.video_file_url AS video_file_url,
 		vp.video_name AS video_name
 	FROM
@@ -155,6 +158,7 @@ const (
 		ab.created_at AS created_at, 
 		ab.modified AS modified, 
 		ab.content_type AS content_type,
+		ab.position AS position,
 		pdf.pdf_file_url AS pdf_file_url,
 		pdf.pdf_name AS pdf_name
 	FROM
@@ -180,6 +184,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 			&dbImagePage.CreatedAt,
 			&dbImagePage.Modified,
 			&dbImagePage.ContentType,
+			&dbImagePage.Position,
 			&dbImagePage.ImageFileUrl,
 			&dbImagePage.ImageName,
 		)
@@ -196,6 +201,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 				CreatedAt:      dbImagePage.CreatedAt,
 				Modified:       dbImagePage.Modified,
 				ContentType:    dbImagePage.ContentType,
+				Position:       dbImagePage.Position,
 			},
 			ImageFileUrl: dbImagePage.ImageFileUrl,
 			ImageName:    dbImagePage.ImageName,
@@ -212,6 +218,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 			&dbVideoPage.CreatedAt,
 			&dbVideoPage.Modified,
 			&dbVideoPage.ContentType,
+			&dbVideoPage.Position,
 			&dbVideoPage.VideoFileUrl,
 			&dbVideoPage.VideoName,
 		)
@@ -228,6 +235,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 				CreatedAt:      dbVideoPage.CreatedAt,
 				Modified:       dbVideoPage.Modified,
 				ContentType:    dbVideoPage.ContentType,
+				Position:       dbVideoPage.Position,
 			},
 			VideoFileUrl: dbVideoPage.VideoFileUrl,
 			VideoName:    dbVideoPage.VideoName,
@@ -243,6 +251,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 			&dbPDFPage.CreatedAt,
 			&dbPDFPage.Modified,
 			&dbPDFPage.ContentType,
+			&dbPDFPage.Position,
 			&dbPDFPage.PdfFileUrl,
 			&dbPDFPage.PdfName,
 		)
@@ -259,6 +268,7 @@ func (p *PagesPostgresStorage) GetPageByID(ctx context.Context, pageID int64, co
 				CreatedAt:      dbPDFPage.CreatedAt,
 				Modified:       dbPDFPage.Modified,
 				ContentType:    dbPDFPage.ContentType,
+				Position:       dbPDFPage.Position,
 			},
 			PdfFileUrl: dbPDFPage.PdfFileUrl,
 			PdfName:    dbPDFPage.PdfName,
@@ -279,13 +289,14 @@ const getPagesQuery = `
 		ab.last_modified_by AS last_modified_by,
 		ab.created_at AS created_at,
 		ab.modified AS modified,
-		ab.content_type AS content_type
+		ab.content_type AS content_type,
+		ab.position AS position
 	FROM
 		pages_abstractpages ab
 	INNER JOIN
 		lessons l ON ab.lesson_id = l.id
 	WHERE l.id = $1
-	ORDER BY abstractpage_id
+	ORDER BY ab.position, abstractpage_id
 	LIMIT $2 OFFSET $3`
 
 func (p *PagesPostgresStorage) GetPages(ctx context.Context, lessonID int64, limit, offset int64) ([]BasePage, error) {
@@ -309,6 +320,7 @@ func (p *PagesPostgresStorage) GetPages(ctx context.Context, lessonID int64, lim
 			&page.CreatedAt,
 			&page.Modified,
 			&page.ContentType,
+			&page.Position,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
 		}
@@ -362,19 +374,219 @@ func (p *PagesPostgresStorage) UpdatePage(ctx context.Context, updPage UpdatePag
 
 const deletePageQuery = `
 	DELETE FROM pages_abstractpages
-	WHERE id = $1`
+	WHERE id = $1
+	RETURNING lesson_id, position`
 
+// DeletePage deletes the page and closes the gap in page order of its lesson.
 func (p *PagesPostgresStorage) DeletePage(ctx context.Context, id int64) error {
 	const op = "storage.postgresql.pages.pages.DeletePage"
 
-	res, err := p.db.Exec(ctx, deletePageQuery, id)
+	return p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		var lessonID sql.NullInt64
+		var position int64
+		err := conn.QueryRow(ctx, deletePageQuery, id).Scan(&lessonID, &position)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !lessonID.Valid {
+			return nil
+		}
+
+		if err := postgresql.ReleasePagePosition(ctx, conn, lessonID.Int64, position); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const (
+	getPagesVersionQuery = `
+	SELECT pages_version
+	FROM lessons
+	WHERE id = $1
+	FOR UPDATE`
+	getLessonPageIDsQuery = `
+	SELECT id
+	FROM pages_abstractpages
+	WHERE lesson_id = $1`
+	reorderPagesQuery = `
+	UPDATE pages_abstractpages ap
+	SET position = o.ord - 1
+	FROM unnest($2::integer[]) WITH ORDINALITY AS o(id, ord)
+	WHERE ap.id = o.id AND ap.lesson_id = $1`
+	updatePagesVersionQuery = `
+	UPDATE lessons
+	SET
+		pages_version = pages_version + 1,
+		last_modified_by = $2,
+		modified = now()
+	WHERE id = $1
+	RETURNING pages_version`
+)
+
+// ReorderPages rewrites positions of the lesson pages in the given order and returns
+// the new version of page order. It fails with storage.ErrPagesOrderChanged when
+// the page order was changed since the version the caller saw.
+func (p *PagesPostgresStorage) ReorderPages(ctx context.Context, reorder ReorderPages) (int64, error) {
+	const op = "storage.postgresql.pages.pages.ReorderPages"
+
+	var version int64
+
+	err := p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		var current int64
+		err := conn.QueryRow(ctx, getPagesVersionQuery, reorder.LessonID).Scan(&current)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if current != reorder.Version {
+			return fmt.Errorf("%s: %w", op, storage.ErrPagesOrderChanged)
+		}
+
+		rows, err := conn.Query(ctx, getLessonPageIDsQuery, reorder.LessonID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		pageIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+
+		if !postgresql.SameIDs(pageIDs, reorder.OrderedIDs) {
+			return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		}
+
+		if _, err := conn.Exec(ctx, reorderPagesQuery, reorder.LessonID, reorder.OrderedIDs); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = conn.QueryRow(ctx, updatePagesVersionQuery, reorder.LessonID, reorder.LastModifiedBy).Scan(&version)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+		return 0, err
 	}
 
-	if res.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
-	}
+	return version, nil
+}
 
-	return nil
+const (
+	getPageLessonQuery = `
+	SELECT lesson_id, position
+	FROM pages_abstractpages
+	WHERE id = $1`
+	lockLessonsQuery = `
+	SELECT id
+	FROM lessons
+	WHERE id = ANY($1)
+	ORDER BY id
+	FOR UPDATE`
+	lockPageQuery = `
+	SELECT lesson_id, position
+	FROM pages_abstractpages
+	WHERE id = $1
+	FOR UPDATE`
+	unlinkPageQuery = `
+	UPDATE pages_abstractpages
+	SET lesson_id = NULL
+	WHERE id = $1`
+	movePageQuery = `
+	UPDATE pages_abstractpages
+	SET
+		lesson_id = $2,
+		position = $3,
+		last_modified_by = $4,
+		modified = now()
+	WHERE id = $1`
+)
+
+// MovePage moves the page to the target lesson or to another position of its lesson.
+// The page keeps its ID, so attempts which reference it stay valid.
+func (p *PagesPostgresStorage) MovePage(ctx context.Context, move MovePage) error {
+	const op = "storage.postgresql.pages.pages.MovePage"
+
+	return p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		var sourceID sql.NullInt64
+		var position int64
+		err := conn.QueryRow(ctx, getPageLessonQuery, move.PageID).Scan(&sourceID, &position)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Both lessons are locked in ID order, so moves in opposite directions
+		// don't deadlock.
+		lessonIDs := []int64{move.TargetLessonID}
+		if sourceID.Valid && sourceID.Int64 != move.TargetLessonID {
+			lessonIDs = append(lessonIDs, sourceID.Int64)
+		}
+
+		rows, err := conn.Query(ctx, lockLessonsQuery, lessonIDs)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		locked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		if !slices.Contains(locked, move.TargetLessonID) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLessonNotFound)
+		}
+
+		var lockedSourceID sql.NullInt64
+		err = conn.QueryRow(ctx, lockPageQuery, move.PageID).Scan(&lockedSourceID, &position)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if lockedSourceID != sourceID {
+			return fmt.Errorf("%s: %w", op, storage.ErrPagesOrderChanged)
+		}
+
+		// The page is taken out of any lesson first, so it isn't counted
+		// when its new position is reserved.
+		if _, err := conn.Exec(ctx, unlinkPageQuery, move.PageID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if sourceID.Valid {
+			if err := postgresql.ReleasePagePosition(ctx, conn, sourceID.Int64, position); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		position, err = postgresql.ReservePagePosition(ctx, conn, move.TargetLessonID, move.Position)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, movePageQuery, move.PageID, move.TargetLessonID, position, move.LastModifiedBy)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
 }
@@ -36,6 +36,25 @@ type UpdatePlanRequest struct {
 	Public         *bool   `json:"public,omitempty"`
 }
 
+type ReorderPlans struct {
+	ChannelID      int64   `json:"channel_id" validate:"required"`
+	OrderedIDs     []int64 `json:"ordered_ids" validate:"required,min=1,unique"`
+	LastModifiedBy int64   `json:"last_modified_by" validate:"required"`
+}
+
+type PlanInChannel struct {
+	PlanID         int64 `json:"plan_id" validate:"required"`
+	ChannelID      int64 `json:"channel_id" validate:"required"`
+	LastModifiedBy int64 `json:"last_modified_by" validate:"required"`
+}
+
+type ClonePlan struct {
+	PlanID    int64  `json:"plan_id" validate:"required"`
+	Name      string `json:"name" validate:"required,max=255"`
+	ChannelID int64  `json:"channel_id" validate:"required"`
+	CreatedBy int64  `json:"created_by" validate:"required"`
+}
+
 type DBPlan struct {
 	ID             int64     `db:"id"`
 	Name           string    `db:"name"`
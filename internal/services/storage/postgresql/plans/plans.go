@@ -4,19 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PlansPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewPlansStorage(db *pgxpool.Pool) *PlansPostgresStorage {
-	return &PlansPostgresStorage{db: db}
+	return &PlansPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const (
@@ -25,55 +30,55 @@ const (
 	VALUES ($1, $2, $3, $4, $5, $6)
 	RETURNING id`
 	createChannelsPlansQuery = `
-	INSERT INTO channels_plans(channel_id, plan_id)
-	VALUES ($1, $2)`
+	INSERT INTO channels_plans(channel_id, plan_id, position)
+	VALUES ($1, $2, $3)`
 )
 
 func (p *PlansPostgresStorage) CreatePlan(ctx context.Context, plan CreatePlan) (int64, error) {
 	const op = "storage.postgresql.plans.plans.CreatePlan"
 
-	tx, err := p.db.Begin(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
-	defer func() {
+	var planID int64
+
+	err := p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		err := conn.QueryRow(ctx, createPlanQuery,
+			plan.Name,
+			plan.Description,
+			plan.CreatedBy,
+			plan.LastModifiedBy,
+			plan.CreatedAt,
+			plan.Modified,
+		).Scan(&planID)
 		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == "23505" { // unique violation code
+					return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+				}
 			}
+			return fmt.Errorf("%s: %w", op, err)
 		}
-	}()
 
-	var planID int64
-	err = tx.QueryRow(ctx, createPlanQuery,
-		plan.Name,
-		plan.Description,
-		plan.CreatedBy,
-		plan.LastModifiedBy,
-		plan.CreatedAt,
-		plan.Modified,
-	).Scan(&planID)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			if pgErr.Code == "23505" { // unique violation code
-				return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
-			}
+		position, err := postgresql.NextPlanPosition(ctx, conn, plan.ChannelID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		return 0, fmt.Errorf("%s: %w", op, err)
-	}
 
-	_, err = tx.Exec(ctx,
-		createChannelsPlansQuery,
-		plan.ChannelID,
-		planID,
-	)
-	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
+		_, err = conn.Exec(ctx,
+			createChannelsPlansQuery,
+			plan.ChannelID,
+			planID,
+			position,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
+		}
 
-	if err = tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return planID, nil
@@ -89,7 +94,7 @@ func (p *PlansPostgresStorage) GetPlanByID(ctx context.Context, planID int64) (P
 
 	var plan DBPlan
 
-	err := p.db.QueryRow(ctx, getPlanByIDQuery, planID).Scan(
+	err := postgresql.Conn(ctx, p.db).QueryRow(ctx, getPlanByIDQuery, planID).Scan(
 		&plan.ID,
 		&plan.Name,
 		&plan.Description,
@@ -125,7 +130,7 @@ const getPlansQuery = `
 	INNER JOIN 
 		channels c ON cp.channel_id = c.id
 	WHERE cp.channel_id = $1
-	ORDER BY p.id
+	ORDER BY cp.position, p.id
 	LIMIT $2 OFFSET $3;`
 
 func (p *PlansPostgresStorage) GetPlans(ctx context.Context, channel_id int64, limit, offset int64) ([]Plan, error) {
@@ -133,7 +138,7 @@ func (p *PlansPostgresStorage) GetPlans(ctx context.Context, channel_id int64, l
 
 	var plans []DBPlan
 
-	rows, err := p.db.Query(ctx, getPlansQuery, channel_id, limit, offset)
+	rows, err := postgresql.Conn(ctx, p.db).Query(ctx, getPlansQuery, channel_id, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -185,7 +190,7 @@ func (p *PlansPostgresStorage) UpdatePlan(ctx context.Context, updPlan UpdatePla
 
 	var id int64
 
-	err := p.db.QueryRow(ctx, updatePlanQuery,
+	err := postgresql.Conn(ctx, p.db).QueryRow(ctx, updatePlanQuery,
 		updPlan.ID,
 		updPlan.Name,
 		updPlan.Description,
@@ -206,7 +211,7 @@ const deletePlanQuery = `
 func (p *PlansPostgresStorage) DeletePlan(ctx context.Context, id int64) error {
 	const op = "storage.postgresql.plans.plans.DeletePlan"
 
-	res, err := p.db.Exec(ctx, deletePlanQuery, id)
+	res, err := postgresql.Conn(ctx, p.db).Exec(ctx, deletePlanQuery, id)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, storage.ErrPlanNotFound)
 	}
@@ -217,3 +222,212 @@ func (p *PlansPostgresStorage) DeletePlan(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+const (
+	getChannelPlanIDsQuery = `
+	SELECT plan_id
+	FROM channels_plans
+	WHERE channel_id = $1`
+	reorderPlansQuery = `
+	UPDATE channels_plans cp
+	SET position = o.ord - 1
+	FROM unnest($2::integer[]) WITH ORDINALITY AS o(plan_id, ord)
+	WHERE cp.plan_id = o.plan_id AND cp.channel_id = $1`
+	touchChannelQuery = `
+	UPDATE channels
+	SET last_modified_by = $2, modified = now()
+	WHERE id = $1`
+)
+
+// ReorderPlans rewrites positions of the channel plans in the given order.
+// Ordered IDs must list every plan of the channel once.
+func (p *PlansPostgresStorage) ReorderPlans(ctx context.Context, reorder ReorderPlans) error {
+	const op = "storage.postgresql.plans.plans.ReorderPlans"
+
+	return p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		if err := postgresql.LockChannel(ctx, conn, reorder.ChannelID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := conn.Query(ctx, getChannelPlanIDsQuery, reorder.ChannelID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		planIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+
+		if !postgresql.SameIDs(planIDs, reorder.OrderedIDs) {
+			return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		}
+
+		if _, err := conn.Exec(ctx, reorderPlansQuery, reorder.ChannelID, reorder.OrderedIDs); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, touchChannelQuery, reorder.ChannelID, reorder.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const (
+	detachPlanQuery = `
+	DELETE FROM channels_plans
+	WHERE channel_id = $1 AND plan_id = $2`
+	countPlanChannelsQuery = `
+	SELECT COUNT(*)
+	FROM channels_plans
+	WHERE plan_id = $1`
+)
+
+// AttachPlanToChannel adds the existing plan to the end of the channel.
+func (p *PlansPostgresStorage) AttachPlanToChannel(ctx context.Context, attach PlanInChannel) error {
+	const op = "storage.postgresql.plans.plans.AttachPlanToChannel"
+
+	return p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		position, err := postgresql.NextPlanPosition(ctx, conn, attach.ChannelID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := postgresql.LockPlan(ctx, conn, attach.PlanID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = conn.Exec(ctx, createChannelsPlansQuery, attach.ChannelID, attach.PlanID, position)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique violation code
+				return fmt.Errorf("%s: %w", op, storage.ErrPlanInChannel)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, touchChannelQuery, attach.ChannelID, attach.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// DetachPlanFromChannel removes the plan from the channel. A plan must stay in
+// at least one channel, the last one is left with DeletePlan.
+func (p *PlansPostgresStorage) DetachPlanFromChannel(ctx context.Context, detach PlanInChannel) error {
+	const op = "storage.postgresql.plans.plans.DetachPlanFromChannel"
+
+	return p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		if err := postgresql.LockChannel(ctx, conn, detach.ChannelID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := postgresql.LockPlan(ctx, conn, detach.PlanID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		res, err := conn.Exec(ctx, detachPlanQuery, detach.ChannelID, detach.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrPlanNotFound)
+		}
+
+		var left int64
+		if err := conn.QueryRow(ctx, countPlanChannelsQuery, detach.PlanID).Scan(&left); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if left == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLastPlanChannel)
+		}
+
+		if _, err := conn.Exec(ctx, touchChannelQuery, detach.ChannelID, detach.LastModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+const (
+	clonePlanQuery = `
+	INSERT INTO plans(name, description, created_by, last_modified_by, created_at, modified)
+	SELECT $2, description, $3, $3, now(), now()
+	FROM plans
+	WHERE id = $1
+	RETURNING id`
+	getPlanLessonIDsQuery = `
+	SELECT lesson_id
+	FROM plans_lessons
+	WHERE plan_id = $1
+	ORDER BY position`
+)
+
+// ClonePlan copies the plan with all its lessons to the end of the channel and
+// returns ID of the copy. Lessons shared with other plans are copied as well,
+// so the copy can be changed without touching the source plan.
+// The copy is unpublished and private.
+func (p *PlansPostgresStorage) ClonePlan(ctx context.Context, clone ClonePlan) (int64, error) {
+	const op = "storage.postgresql.plans.plans.ClonePlan"
+
+	var planID int64
+
+	err := p.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, p.db)
+
+		position, err := postgresql.NextPlanPosition(ctx, conn, clone.ChannelID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := postgresql.LockPlan(ctx, conn, clone.PlanID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = conn.QueryRow(ctx, clonePlanQuery, clone.PlanID, clone.Name, clone.CreatedBy).Scan(&planID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique violation code
+				return fmt.Errorf("%s: %w", op, storage.ErrPlanExitsts)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, createChannelsPlansQuery, clone.ChannelID, planID, position); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := conn.Query(ctx, getPlanLessonIDsQuery, clone.PlanID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		lessonIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+
+		for i, lessonID := range lessonIDs {
+			_, err := postgresql.CloneLesson(ctx, conn, lessonID, planID, int64(i), "", clone.CreatedBy)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return planID, nil
+}
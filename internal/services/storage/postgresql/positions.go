@@ -0,0 +1,203 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	bumpPagesVersionQuery = `
+	UPDATE lessons
+	SET pages_version = pages_version + 1
+	WHERE id = $1`
+	countLessonPagesQuery = `
+	SELECT COUNT(*)
+	FROM pages_abstractpages
+	WHERE lesson_id = $1`
+	shiftPagesDownQuery = `
+	UPDATE pages_abstractpages
+	SET position = position + 1
+	WHERE lesson_id = $1 AND position >= $2`
+	shiftPagesUpQuery = `
+	UPDATE pages_abstractpages
+	SET position = position - 1
+	WHERE lesson_id = $1 AND position > $2`
+	lockPlanQuery = `
+	SELECT id
+	FROM plans
+	WHERE id = $1
+	FOR UPDATE`
+	shiftLessonsUpQuery = `
+	UPDATE plans_lessons
+	SET position = position - 1
+	WHERE plan_id = $1 AND position > $2`
+	nextLessonPositionQuery = `
+	SELECT COALESCE(MAX(position) + 1, 0)
+	FROM plans_lessons
+	WHERE plan_id = $1`
+	lockChannelQuery = `
+	SELECT id
+	FROM channels
+	WHERE id = $1
+	FOR UPDATE`
+	nextPlanPositionQuery = `
+	SELECT COALESCE(MAX(position) + 1, 0)
+	FROM channels_plans
+	WHERE channel_id = $1`
+)
+
+// ReservePagePosition frees a position for a new page of the lesson and returns it.
+// A nil or out of range position appends the page after the last one.
+// Page order of the lesson gets a new version, which also locks the lesson
+// until the end of the transaction, so conn should be a transaction.
+func ReservePagePosition(ctx context.Context, conn Querier, lessonID int64, position *int64) (int64, error) {
+	const op = "storage.postgresql.ReservePagePosition"
+
+	if err := bumpPagesVersion(ctx, conn, lessonID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var count int64
+	if err := conn.QueryRow(ctx, countLessonPagesQuery, lessonID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if position == nil || *position >= count {
+		return count, nil
+	}
+
+	if _, err := conn.Exec(ctx, shiftPagesDownQuery, lessonID, *position); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return *position, nil
+}
+
+// ReleasePagePosition closes the gap left by a page removed from the lesson.
+func ReleasePagePosition(ctx context.Context, conn Querier, lessonID, position int64) error {
+	const op = "storage.postgresql.ReleasePagePosition"
+
+	if err := bumpPagesVersion(ctx, conn, lessonID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := conn.Exec(ctx, shiftPagesUpQuery, lessonID, position); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func bumpPagesVersion(ctx context.Context, conn Querier, lessonID int64) error {
+	res, err := conn.Exec(ctx, bumpPagesVersionQuery, lessonID)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return storage.ErrLessonNotFound
+	}
+
+	return nil
+}
+
+// LockPlan locks the plan until the end of the transaction, which serializes
+// changes of its lesson order.
+func LockPlan(ctx context.Context, conn Querier, planID int64) error {
+	var id int64
+	err := conn.QueryRow(ctx, lockPlanQuery, planID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrPlanNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// LockChannel locks the channel until the end of the transaction, which serializes
+// changes of its plan order.
+func LockChannel(ctx context.Context, conn Querier, channelID int64) error {
+	var id int64
+	err := conn.QueryRow(ctx, lockChannelQuery, channelID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrChannelNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// NextLessonPosition locks the plan and returns the position after its last lesson,
+// so conn should be a transaction.
+func NextLessonPosition(ctx context.Context, conn Querier, planID int64) (int64, error) {
+	const op = "storage.postgresql.NextLessonPosition"
+
+	if err := LockPlan(ctx, conn, planID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var position int64
+	if err := conn.QueryRow(ctx, nextLessonPositionQuery, planID).Scan(&position); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return position, nil
+}
+
+// ReleaseLessonPosition closes the gap left by a lesson removed from the plan.
+// The plan should be locked with LockPlan in the same transaction.
+func ReleaseLessonPosition(ctx context.Context, conn Querier, planID, position int64) error {
+	const op = "storage.postgresql.ReleaseLessonPosition"
+
+	if _, err := conn.Exec(ctx, shiftLessonsUpQuery, planID, position); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// NextPlanPosition locks the channel and returns the position after its last plan,
+// so conn should be a transaction.
+func NextPlanPosition(ctx context.Context, conn Querier, channelID int64) (int64, error) {
+	const op = "storage.postgresql.NextPlanPosition"
+
+	if err := LockChannel(ctx, conn, channelID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var position int64
+	if err := conn.QueryRow(ctx, nextPlanPositionQuery, channelID).Scan(&position); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return position, nil
+}
+
+// SameIDs reports whether ordered lists exactly the given IDs, each of them once.
+func SameIDs(ids, ordered []int64) bool {
+	if len(ids) != len(ordered) {
+		return false
+	}
+
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+
+	for _, id := range ordered {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id)
+	}
+
+	return true
+}
@@ -1,9 +1,33 @@
 package questions
 
 import (
+	"database/sql"
 	"time"
 )
 
+const (
+	QuestionTypeMultichoice = "multichoice"
+	QuestionTypeShortAnswer = "short_answer"
+	QuestionTypeMultiSelect = "multiselect"
+	QuestionTypeTrueFalse   = "true_false"
+	QuestionTypeNumeric     = "numeric"
+	QuestionTypeFillBlanks  = "fill_blanks"
+	QuestionTypeOrdering    = "ordering"
+	QuestionTypeMatching    = "matching"
+)
+
+const (
+	MatchModeExact           = "exact"
+	MatchModeCaseInsensitive = "case_insensitive"
+	MatchModeRegex           = "regex"
+)
+
+const (
+	GradingModeAllOrNothing  = "all_or_nothing"
+	GradingModePartialCredit = "partial_credit"
+	GradingModePenalty       = "penalty"
+)
+
 type QuestionPage struct {
 	ID             int64
 	LessonID       int64
@@ -12,16 +36,81 @@ type QuestionPage struct {
 	CreatedAt      time.Time
 	Modified       time.Time
 	ContentType    string
+	Position       int64
 
 	QuestionType string
 
-	Question string
-	OptionA  string
-	OptionB  string
-	OptionC  string
-	OptionD  string
-	OptionE  string
-	Answer   string
+	Question    string
+	Explanation string
+	Options     []Option
+
+	ShortAnswer *ShortAnswerQuestion
+	MultiSelect *MultiSelectQuestion
+	TrueFalse   *TrueFalseQuestion
+	Numeric     *NumericQuestion
+	FillBlanks  *FillBlanksQuestion
+	Ordering    *OrderingQuestion
+	Matching    *MatchingQuestion
+}
+
+type ShortAnswerQuestion struct {
+	AcceptedAnswers []string `json:"accepted_answers" validate:"required,min=1,dive,required"`
+	MatchMode       string   `json:"match_mode" validate:"required,oneof=exact case_insensitive regex"`
+	TrimWhitespace  bool     `json:"trim_whitespace"`
+}
+
+// Option is an answer option of a multichoice or multi-select question.
+// Options are kept in the order they are shown to the user.
+type Option struct {
+	ID        int64  `json:"id"`
+	Text      string `json:"text" validate:"required,max=512"`
+	IsCorrect bool   `json:"is_correct"`
+	Feedback  string `json:"feedback"`
+}
+
+// MultiSelectQuestion uses options of the question page, any number of which may be correct.
+type MultiSelectQuestion struct {
+	GradingMode string `json:"grading_mode" validate:"required,oneof=all_or_nothing partial_credit penalty"`
+}
+
+type TrueFalseQuestion struct {
+	Answer bool `json:"answer"`
+}
+
+// NumericQuestion is answered either with an exact value within the tolerance
+// or with any value of the inclusive [MinValue, MaxValue] range.
+type NumericQuestion struct {
+	Answer    *float64 `json:"answer,omitempty" validate:"required_without_all=MinValue MaxValue"`
+	Tolerance float64  `json:"tolerance" validate:"gte=0"`
+	MinValue  *float64 `json:"min_value,omitempty" validate:"required_with=MaxValue"`
+	MaxValue  *float64 `json:"max_value,omitempty" validate:"required_with=MinValue"`
+}
+
+// FillBlanksQuestion is a passage with blanks. Blanks are listed
+// in the order they appear in the passage.
+type FillBlanksQuestion struct {
+	Blanks         []FillBlank `json:"blanks" validate:"required,min=1,dive"`
+	MatchMode      string      `json:"match_mode" validate:"required,oneof=exact case_insensitive regex"`
+	TrimWhitespace bool        `json:"trim_whitespace"`
+}
+
+type FillBlank struct {
+	AcceptedAnswers []string `json:"accepted_answers" validate:"required,min=1,dive,required"`
+}
+
+// OrderingQuestion holds items in the correct order.
+type OrderingQuestion struct {
+	Items []string `json:"items" validate:"required,min=2,unique,dive,required"`
+}
+
+// MatchingQuestion holds correct pairs of left and right items.
+type MatchingQuestion struct {
+	Pairs []MatchingPair `json:"pairs" validate:"required,min=2,unique=Left,unique=Right,dive"`
+}
+
+type MatchingPair struct {
+	Left  string `json:"left" validate:"required"`
+	Right string `json:"right" validate:"required"`
 }
 
 type CreateQuestionPage struct {
@@ -29,29 +118,78 @@ type CreateQuestionPage struct {
 	CreatedBy      int64  `json:"created_by" validate:"required"`
 	LastModifiedBy int64  `json:"last_modified_by" validate:"required"`
 	ContentType    string `json:"content_type" validate:"required"`
+	Position       *int64 `json:"position,omitempty" validate:"omitempty,min=0"`
+
+	QuestionType string `json:"question_type" validate:"required,oneof=multichoice short_answer multiselect true_false numeric fill_blanks ordering matching"`
 
-	QuestionType string `json:"question_type" validate:"required"`
+	Question    string   `json:"question" validate:"required"`
+	Explanation string   `json:"explanation,omitempty"`
+	Options     []Option `json:"options,omitempty" validate:"required_if=QuestionType multichoice,required_if=QuestionType multiselect,omitempty,min=2,dive"`
 
-	Question string `json:"question" validate:"required"`
-	OptionA  string `json:"option_a" validate:"required"`
-	OptionB  string `json:"option_b" validate:"required"`
-	OptionC  string `json:"option_c,omitempty"`
-	OptionD  string `json:"option_d,omitempty"`
-	OptionE  string `json:"option_e,omitempty"`
-	Answer   string `json:"answer" validate:"required"`
+	ShortAnswer *ShortAnswerQuestion `json:"short_answer,omitempty" validate:"required_if=QuestionType short_answer"`
+	MultiSelect *MultiSelectQuestion `json:"multi_select,omitempty" validate:"required_if=QuestionType multiselect"`
+	TrueFalse   *TrueFalseQuestion   `json:"true_false,omitempty" validate:"required_if=QuestionType true_false"`
+	Numeric     *NumericQuestion     `json:"numeric,omitempty" validate:"required_if=QuestionType numeric"`
+	FillBlanks  *FillBlanksQuestion  `json:"fill_blanks,omitempty" validate:"required_if=QuestionType fill_blanks"`
+	Ordering    *OrderingQuestion    `json:"ordering,omitempty" validate:"required_if=QuestionType ordering"`
+	Matching    *MatchingQuestion    `json:"matching,omitempty" validate:"required_if=QuestionType matching"`
 }
 
 type UpdateQuestionPage struct {
 	ID             int64 `json:"id" validate:"required"`
 	LastModifiedBy int64 `json:"last_modified_by" validate:"required"`
 
-	Question *string `json:"question,omitempty"`
-	OptionA  *string `json:"option_a,omitempty"`
-	OptionB  *string `json:"option_b,omitempty"`
-	OptionC  *string `json:"option_c,omitempty"`
-	OptionD  *string `json:"option_d,omitempty"`
-	OptionE  *string `json:"option_e,omitempty"`
-	Answer   *string `json:"answer,omitempty"`
+	Question    *string `json:"question,omitempty"`
+	Explanation *string `json:"explanation,omitempty"`
+	// Options replace all options of the question when not empty. Options with
+	// an ID keep it, options without one are added, the rest are removed.
+	Options []Option `json:"options,omitempty" validate:"omitempty,min=2,dive"`
+
+	ShortAnswer *UpdateShortAnswerQuestion `json:"short_answer,omitempty"`
+	MultiSelect *UpdateMultiSelectQuestion `json:"multi_select,omitempty"`
+	TrueFalse   *UpdateTrueFalseQuestion   `json:"true_false,omitempty"`
+	Numeric     *UpdateNumericQuestion     `json:"numeric,omitempty"`
+	FillBlanks  *UpdateFillBlanksQuestion  `json:"fill_blanks,omitempty"`
+	Ordering    *UpdateOrderingQuestion    `json:"ordering,omitempty"`
+	Matching    *UpdateMatchingQuestion    `json:"matching,omitempty"`
+}
+
+type UpdateShortAnswerQuestion struct {
+	AcceptedAnswers []string `json:"accepted_answers,omitempty" validate:"omitempty,dive,required"`
+	MatchMode       *string  `json:"match_mode,omitempty" validate:"omitempty,oneof=exact case_insensitive regex"`
+	TrimWhitespace  *bool    `json:"trim_whitespace,omitempty"`
+}
+
+type UpdateMultiSelectQuestion struct {
+	GradingMode *string `json:"grading_mode,omitempty" validate:"omitempty,oneof=all_or_nothing partial_credit penalty"`
+}
+
+type UpdateTrueFalseQuestion struct {
+	Answer *bool `json:"answer,omitempty"`
+}
+
+type UpdateNumericQuestion struct {
+	Answer    *float64 `json:"answer,omitempty"`
+	Tolerance *float64 `json:"tolerance,omitempty" validate:"omitempty,gte=0"`
+	MinValue  *float64 `json:"min_value,omitempty"`
+	MaxValue  *float64 `json:"max_value,omitempty"`
+}
+
+// UpdateFillBlanksQuestion replaces all blanks of the question when Blanks is not empty.
+type UpdateFillBlanksQuestion struct {
+	Blanks         []FillBlank `json:"blanks,omitempty" validate:"omitempty,dive"`
+	MatchMode      *string     `json:"match_mode,omitempty" validate:"omitempty,oneof=exact case_insensitive regex"`
+	TrimWhitespace *bool       `json:"trim_whitespace,omitempty"`
+}
+
+// UpdateOrderingQuestion replaces all items of the question when Items is not empty.
+type UpdateOrderingQuestion struct {
+	Items []string `json:"items,omitempty" validate:"omitempty,min=2,unique,dive,required"`
+}
+
+// UpdateMatchingQuestion replaces all pairs of the question when Pairs is not empty.
+type UpdateMatchingQuestion struct {
+	Pairs []MatchingPair `json:"pairs,omitempty" validate:"omitempty,min=2,unique=Left,unique=Right,dive"`
 }
 
 type DBQuestionPage struct {
@@ -62,14 +200,108 @@ type DBQuestionPage struct {
 	CreatedAt      time.Time `db:"created_at"`
 	Modified       time.Time `db:"modified"`
 	ContentType    string    `db:"content_type"`
+	Position       int64     `db:"position"`
 
 	QuestionType string `db:"question_type"`
 
-	Question string `db:"question"`
-	OptionA  string `db:"option_a"`
-	OptionB  string `db:"option_b"`
-	OptionC  string `db:"option_c"`
-	OptionD  string `db:"option_d"`
-	OptionE  string `db:"option_e"`
-	Answer   string `db:"answer"`
+	Question    sql.NullString `db:"question"`
+	Explanation sql.NullString `db:"explanation"`
+	Options     []Option       `db:"options"`
+
+	AcceptedAnswers []string       `db:"accepted_answers"`
+	MatchMode       sql.NullString `db:"match_mode"`
+	TrimWhitespace  sql.NullBool   `db:"trim_whitespace"`
+
+	GradingMode sql.NullString `db:"grading_mode"`
+
+	TrueFalseAnswer sql.NullBool `db:"true_false_answer"`
+
+	NumericAnswer sql.NullFloat64 `db:"numeric_answer"`
+	Tolerance     sql.NullFloat64 `db:"tolerance"`
+	MinValue      sql.NullFloat64 `db:"min_value"`
+	MaxValue      sql.NullFloat64 `db:"max_value"`
+
+	Blanks [][]string `db:"blanks"`
+
+	OrderingItems []string       `db:"ordering_items"`
+	MatchingPairs []MatchingPair `db:"matching_pairs"`
+}
+
+func (q DBQuestionPage) toQuestionPage() QuestionPage {
+	page := QuestionPage{
+		ID:             q.ID,
+		LessonID:       q.LessonID,
+		CreatedBy:      q.CreatedBy,
+		LastModifiedBy: q.LastModifiedBy,
+		CreatedAt:      q.CreatedAt,
+		Modified:       q.Modified,
+		ContentType:    q.ContentType,
+		Position:       q.Position,
+		QuestionType:   q.QuestionType,
+		Question:       q.Question.String,
+		Explanation:    q.Explanation.String,
+		Options:        q.Options,
+	}
+
+	if q.QuestionType == QuestionTypeShortAnswer {
+		page.ShortAnswer = &ShortAnswerQuestion{
+			AcceptedAnswers: q.AcceptedAnswers,
+			MatchMode:       q.MatchMode.String,
+			TrimWhitespace:  q.TrimWhitespace.Bool,
+		}
+	}
+
+	if q.QuestionType == QuestionTypeMultiSelect {
+		page.MultiSelect = &MultiSelectQuestion{
+			GradingMode: q.GradingMode.String,
+		}
+	}
+
+	if q.QuestionType == QuestionTypeTrueFalse {
+		page.TrueFalse = &TrueFalseQuestion{
+			Answer: q.TrueFalseAnswer.Bool,
+		}
+	}
+
+	if q.QuestionType == QuestionTypeNumeric {
+		page.Numeric = &NumericQuestion{
+			Answer:    nullFloat64Ptr(q.NumericAnswer),
+			Tolerance: q.Tolerance.Float64,
+			MinValue:  nullFloat64Ptr(q.MinValue),
+			MaxValue:  nullFloat64Ptr(q.MaxValue),
+		}
+	}
+
+	if q.QuestionType == QuestionTypeFillBlanks {
+		blanks := make([]FillBlank, 0, len(q.Blanks))
+		for _, accepted := range q.Blanks {
+			blanks = append(blanks, FillBlank{AcceptedAnswers: accepted})
+		}
+		page.FillBlanks = &FillBlanksQuestion{
+			Blanks:         blanks,
+			MatchMode:      q.MatchMode.String,
+			TrimWhitespace: q.TrimWhitespace.Bool,
+		}
+	}
+
+	if q.QuestionType == QuestionTypeOrdering {
+		page.Ordering = &OrderingQuestion{
+			Items: q.OrderingItems,
+		}
+	}
+
+	if q.QuestionType == QuestionTypeMatching {
+		page.Matching = &MatchingQuestion{
+			Pairs: q.MatchingPairs,
+		}
+	}
+
+	return page
+}
+
+func nullFloat64Ptr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
 }
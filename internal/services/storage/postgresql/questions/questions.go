@@ -2,121 +2,338 @@ package questions
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type QuestionsPostgresStorage struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	txManager *postgresql.TxManager
 }
 
 func NewQuestionsStorage(db *pgxpool.Pool) *QuestionsPostgresStorage {
-	return &QuestionsPostgresStorage{db: db}
+	return &QuestionsPostgresStorage{
+		db:        db,
+		txManager: postgresql.NewTxManager(db),
+	}
 }
 
 const (
 	createAbstractPageQuery = `
-	INSERT INTO pages_abstractpages(lesson_id, created_by, last_modified_by, created_at, modified, content_type)
-	VALUES ($1, $2, $3, now(), now(), $4)
+	INSERT INTO pages_abstractpages(lesson_id, created_by, last_modified_by, created_at, modified, content_type, position)
+	VALUES ($1, $2, $3, now(), now(), $4, $5)
 	RETURNING id`
 	createAbstractQuestion = `
-	INSERT INTO question_abstractquestion(question_type)
-	VALUES	($1)
+	INSERT INTO question_abstractquestion(question_type, explanation)
+	VALUES	($1, NULLIF($2, ''))
 	RETURNING id`
 	createQuestionPage = `
 	INSERT INTO question_questionpage(abstractpage_id, question_id)
 	VALUES ($1, $2)`
 	createMultichoiceQuestion = `
-	INSERT INTO question_multichoicequestion(
+	INSERT INTO question_multichoicequestion(question_abstractquestion_id, question)
+	VALUES ($1, $2)`
+	createShortAnswerQuestion = `
+	INSERT INTO question_shortanswerquestion(
+		question_abstractquestion_id,
+		question,
+		accepted_answers,
+		match_mode,
+		trim_whitespace
+	)
+	VALUES ($1, $2, $3, $4, $5)`
+	createMultiSelectQuestion = `
+	INSERT INTO question_multiselectquestion(question_abstractquestion_id, question, grading_mode)
+	VALUES ($1, $2, $3)`
+	createOption = `
+	INSERT INTO question_option(question_abstractquestion_id, position, text, is_correct, feedback)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''))`
+	createTrueFalseQuestion = `
+	INSERT INTO question_truefalsequestion(question_abstractquestion_id, question, answer)
+	VALUES ($1, $2, $3)`
+	createNumericQuestion = `
+	INSERT INTO question_numericquestion(
 		question_abstractquestion_id,
 		question,
-		option_a,
-		option_b,
-		option_c,
-		option_d,
-		option_e,
-		answer
+		answer,
+		tolerance,
+		min_value,
+		max_value
 	)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	VALUES ($1, $2, $3, $4, $5, $6)`
+	createFillBlanksQuestion = `
+	INSERT INTO question_fillblanksquestion(
+		question_abstractquestion_id,
+		question,
+		match_mode,
+		trim_whitespace
+	)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id`
+	createFillBlank = `
+	INSERT INTO question_fillblank(fillblanksquestion_id, position, accepted_answers)
+	VALUES ($1, $2, $3)`
+	createOrderingQuestion = `
+	INSERT INTO question_orderingquestion(question_abstractquestion_id, question)
+	VALUES ($1, $2)
+	RETURNING id`
+	createOrderingItem = `
+	INSERT INTO question_orderingitem(orderingquestion_id, position, text)
+	VALUES ($1, $2, $3)`
+	createMatchingQuestion = `
+	INSERT INTO question_matchingquestion(question_abstractquestion_id, question)
+	VALUES ($1, $2)
+	RETURNING id`
+	createMatchingPair = `
+	INSERT INTO question_matchingpair(matchingquestion_id, position, left_item, right_item)
+	VALUES ($1, $2, $3, $4)`
 )
 
 func (q *QuestionsPostgresStorage) CreateQuestionPage(ctx context.Context, questionPage CreateQuestionPage) (int64, error) {
 	const op = "storage.postgresql.pages.pages.CreateQuestionPage"
 
-	tx, err := q.db.Begin(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
-	}
-	defer func() {
+	var abstrPageID int64
+
+	err := q.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, q.db)
+
+		position, err := postgresql.ReservePagePosition(ctx, conn, questionPage.LessonID, questionPage.Position)
 		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = conn.QueryRow(
+			ctx,
+			createAbstractPageQuery,
+			questionPage.LessonID,
+			questionPage.CreatedBy,
+			questionPage.LastModifiedBy,
+			questionPage.ContentType,
+			position,
+		).Scan(&abstrPageID)
+		if err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		var quePageID int64
+		err = conn.QueryRow(
+			ctx,
+			createAbstractQuestion,
+			questionPage.QuestionType,
+			questionPage.Explanation,
+		).Scan(&quePageID)
+		if err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		_, err = conn.Exec(
+			ctx,
+			createQuestionPage,
+			abstrPageID,
+			quePageID,
+		)
+		if err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		switch questionPage.QuestionType {
+		case QuestionTypeMultichoice:
+			_, err = conn.Exec(
+				ctx,
+				createMultichoiceQuestion,
+				quePageID,
+				questionPage.Question,
+			)
+			if err != nil {
+				return q.checkPgError(err, op)
 			}
+			err = q.createOptions(ctx, quePageID, questionPage.Options)
+		case QuestionTypeShortAnswer:
+			_, err = conn.Exec(
+				ctx,
+				createShortAnswerQuestion,
+				quePageID,
+				questionPage.Question,
+				questionPage.ShortAnswer.AcceptedAnswers,
+				questionPage.ShortAnswer.MatchMode,
+				questionPage.ShortAnswer.TrimWhitespace,
+			)
+		case QuestionTypeMultiSelect:
+			_, err = conn.Exec(
+				ctx,
+				createMultiSelectQuestion,
+				quePageID,
+				questionPage.Question,
+				questionPage.MultiSelect.GradingMode,
+			)
+			if err != nil {
+				return q.checkPgError(err, op)
+			}
+			err = q.createOptions(ctx, quePageID, questionPage.Options)
+		case QuestionTypeTrueFalse:
+			_, err = conn.Exec(
+				ctx,
+				createTrueFalseQuestion,
+				quePageID,
+				questionPage.Question,
+				questionPage.TrueFalse.Answer,
+			)
+		case QuestionTypeNumeric:
+			_, err = conn.Exec(
+				ctx,
+				createNumericQuestion,
+				quePageID,
+				questionPage.Question,
+				questionPage.Numeric.Answer,
+				questionPage.Numeric.Tolerance,
+				questionPage.Numeric.MinValue,
+				questionPage.Numeric.MaxValue,
+			)
+		case QuestionTypeFillBlanks:
+			var fillBlanksID int64
+			err = conn.QueryRow(
+				ctx,
+				createFillBlanksQuestion,
+				quePageID,
+				questionPage.Question,
+				questionPage.FillBlanks.MatchMode,
+				questionPage.FillBlanks.TrimWhitespace,
+			).Scan(&fillBlanksID)
+			if err != nil {
+				return q.checkPgError(err, op)
+			}
+			err = q.createFillBlanks(ctx, fillBlanksID, questionPage.FillBlanks.Blanks)
+		case QuestionTypeOrdering:
+			var orderingID int64
+			err = conn.QueryRow(
+				ctx,
+				createOrderingQuestion,
+				quePageID,
+				questionPage.Question,
+			).Scan(&orderingID)
+			if err != nil {
+				return q.checkPgError(err, op)
+			}
+			err = q.createOrderingItems(ctx, orderingID, questionPage.Ordering.Items)
+		case QuestionTypeMatching:
+			var matchingID int64
+			err = conn.QueryRow(
+				ctx,
+				createMatchingQuestion,
+				quePageID,
+				questionPage.Question,
+			).Scan(&matchingID)
+			if err != nil {
+				return q.checkPgError(err, op)
+			}
+			err = q.createMatchingPairs(ctx, matchingID, questionPage.Matching.Pairs)
+		default:
+			return fmt.Errorf("%s: %w", op, storage.ErrUnContType)
+		}
+		if err != nil {
+			return q.checkPgError(err, op)
 		}
-	}()
 
-	var abstrPageID int64
-	err = tx.QueryRow(
-		ctx,
-		createAbstractPageQuery,
-		questionPage.LessonID,
-		questionPage.CreatedBy,
-		questionPage.LastModifiedBy,
-		questionPage.ContentType,
-	).Scan(&abstrPageID)
+		return nil
+	})
 	if err != nil {
-		return q.checkPgError(err, op)
+		return 0, err
 	}
 
-	var quePageID int64
-	err = tx.QueryRow(
-		ctx,
-		createAbstractQuestion,
-		questionPage.QuestionType,
-	).Scan(&quePageID)
-	if err != nil {
-		return q.checkPgError(err, op)
+	return abstrPageID, nil
+}
+
+// createOptions saves options of the question in the given order.
+func (q *QuestionsPostgresStorage) createOptions(ctx context.Context, questionID int64, options []Option) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	for position, option := range options {
+		_, err := conn.Exec(
+			ctx,
+			createOption,
+			questionID,
+			position,
+			option.Text,
+			option.IsCorrect,
+			option.Feedback,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err = tx.Exec(
-		ctx,
-		createQuestionPage,
-		abstrPageID,
-		quePageID,
-	)
-	if err != nil {
-		return q.checkPgError(err, op)
+	return nil
+}
+
+// createFillBlanks saves blanks of the fill-in-the-blank question keeping their order.
+func (q *QuestionsPostgresStorage) createFillBlanks(ctx context.Context, fillBlanksID int64, blanks []FillBlank) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	for position, blank := range blanks {
+		_, err := conn.Exec(
+			ctx,
+			createFillBlank,
+			fillBlanksID,
+			position,
+			blank.AcceptedAnswers,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err = tx.Exec(
-		ctx,
-		createMultichoiceQuestion,
-		quePageID,
-		questionPage.Question,
-		questionPage.OptionA,
-		questionPage.OptionB,
-		questionPage.OptionC,
-		questionPage.OptionD,
-		questionPage.OptionE,
-		questionPage.Answer,
-	)
-	if err != nil {
-		return q.checkPgError(err, op)
+	return nil
+}
+
+// createOrderingItems saves items of the ordering question in the correct order.
+func (q *QuestionsPostgresStorage) createOrderingItems(ctx context.Context, orderingID int64, items []string) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	for position, item := range items {
+		_, err := conn.Exec(
+			ctx,
+			createOrderingItem,
+			orderingID,
+			position,
+			item,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
+	return nil
+}
+
+// createMatchingPairs saves pairs of the matching question keeping their order.
+func (q *QuestionsPostgresStorage) createMatchingPairs(ctx context.Context, matchingID int64, pairs []MatchingPair) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	for position, pair := range pairs {
+		_, err := conn.Exec(
+			ctx,
+			createMatchingPair,
+			matchingID,
+			position,
+			pair.Left,
+			pair.Right,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	return abstrPageID, nil
+	return nil
 }
 
-const getQuestionPageByIDQuery = `
+const selectQuestionPageQuery = `
 	SELECT 
 		ab.id AS abstractpage_id, 
 		ab.lesson_id lesson_id, 
@@ -125,22 +342,63 @@ const getQuestionPageByIDQuery = `
 		ab.created_at AS created_at, 
 		ab.modified AS modified, 
 		ab.content_type AS content_type,
+		ab.position AS position,
 		aq.question_type AS question_type,
-		mq.question AS question,
-		mq.option_a AS option_a,
-		mq.option_b AS option_b,
-		mq.option_c AS option_c,
-		mq.option_d AS option_d,
-		mq.option_e AS option_e,
-		mq.answer AS answer
+		aq.explanation AS explanation,
+		COALESCE(mq.question, sq.question, msq.question, tfq.question, nq.question, fbq.question, oq.question, mtq.question) AS question,
+		(
+			SELECT json_agg(json_build_object('id', o.id, 'text', o.text, 'is_correct', o.is_correct, 'feedback', COALESCE(o.feedback, '')) ORDER BY o.position)
+			FROM question_option o
+			WHERE o.question_abstractquestion_id = aq.id
+		) AS options,
+		sq.accepted_answers AS accepted_answers,
+		COALESCE(sq.match_mode, fbq.match_mode) AS match_mode,
+		COALESCE(sq.trim_whitespace, fbq.trim_whitespace) AS trim_whitespace,
+		msq.grading_mode AS grading_mode,
+		tfq.answer AS true_false_answer,
+		nq.answer AS numeric_answer,
+		nq.tolerance AS tolerance,
+		nq.min_value AS min_value,
+		nq.max_value AS max_value,
+		(
+			SELECT json_agg(fb.accepted_answers ORDER BY fb.position)
+			FROM question_fillblank fb
+			WHERE fb.fillblanksquestion_id = fbq.id
+		) AS blanks,
+		(
+			SELECT json_agg(oi.text ORDER BY oi.position)
+			FROM question_orderingitem oi
+			WHERE oi.orderingquestion_id = oq.id
+		) AS ordering_items,
+		(
+			SELECT json_agg(json_build_object('left', mp.left_item, 'right', mp.right_item) ORDER BY mp.position)
+			FROM question_matchingpair mp
+			WHERE mp.matchingquestion_id = mtq.id
+		) AS matching_pairs
 	FROM
 		pages_abstractpages ab
 	INNER JOIN
 		question_questionpage qp ON ab.id = qp.abstractpage_id
 	INNER JOIN
 		question_abstractquestion aq ON qp.question_id = aq.id
-	INNER JOIN
+	LEFT JOIN
 		question_multichoicequestion mq ON aq.id = mq.question_abstractquestion_id
+	LEFT JOIN
+		question_shortanswerquestion sq ON aq.id = sq.question_abstractquestion_id
+	LEFT JOIN
+		question_multiselectquestion msq ON aq.id = msq.question_abstractquestion_id
+	LEFT JOIN
+		question_truefalsequestion tfq ON aq.id = tfq.question_abstractquestion_id
+	LEFT JOIN
+		question_numericquestion nq ON aq.id = nq.question_abstractquestion_id
+	LEFT JOIN
+		question_fillblanksquestion fbq ON aq.id = fbq.question_abstractquestion_id
+	LEFT JOIN
+		question_orderingquestion oq ON aq.id = oq.question_abstractquestion_id
+	LEFT JOIN
+		question_matchingquestion mtq ON aq.id = mtq.question_abstractquestion_id`
+
+const getQuestionPageByIDQuery = selectQuestionPageQuery + `
 	WHERE abstractpage_id = $1`
 
 func (q *QuestionsPostgresStorage) GetQuestionPageByID(ctx context.Context, pageID int64) (QuestionPage, error) {
@@ -148,7 +406,47 @@ func (q *QuestionsPostgresStorage) GetQuestionPageByID(ctx context.Context, page
 
 	var questionPage DBQuestionPage
 
-	err := q.db.QueryRow(ctx, getQuestionPageByIDQuery, pageID).Scan(
+	err := scanQuestionPage(postgresql.Conn(ctx, q.db).QueryRow(ctx, getQuestionPageByIDQuery, pageID), &questionPage)
+	if err != nil {
+		return questionPage.toQuestionPage(), fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+	}
+
+	return questionPage.toQuestionPage(), nil
+}
+
+const getQuestionPagesQuery = selectQuestionPageQuery + `
+	WHERE ab.lesson_id = $1
+	ORDER BY ab.position, ab.id
+	LIMIT $2 OFFSET $3`
+
+// GetQuestionPages gets question pages of the lesson with their full content.
+func (q *QuestionsPostgresStorage) GetQuestionPages(ctx context.Context, lessonID int64, limit, offset int64) ([]QuestionPage, error) {
+	const op = "storage.postgresql.questions.questions.GetQuestionPages"
+
+	rows, err := postgresql.Conn(ctx, q.db).Query(ctx, getQuestionPagesQuery, lessonID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var questionPages []QuestionPage
+	for rows.Next() {
+		var questionPage DBQuestionPage
+		if err := scanQuestionPage(rows, &questionPage); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		questionPages = append(questionPages, questionPage.toQuestionPage())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return questionPages, nil
+}
+
+func scanQuestionPage(row pgx.Row, questionPage *DBQuestionPage) error {
+	return row.Scan(
 		&questionPage.ID,
 		&questionPage.LessonID,
 		&questionPage.CreatedBy,
@@ -156,20 +454,154 @@ func (q *QuestionsPostgresStorage) GetQuestionPageByID(ctx context.Context, page
 		&questionPage.CreatedAt,
 		&questionPage.Modified,
 		&questionPage.ContentType,
+		&questionPage.Position,
 		&questionPage.QuestionType,
+		&questionPage.Explanation,
 		&questionPage.Question,
-		&questionPage.OptionA,
-		&questionPage.OptionB,
-		&questionPage.OptionC,
-		&questionPage.OptionD,
-		&questionPage.OptionE,
-		&questionPage.Answer,
+		&questionPage.Options,
+		&questionPage.AcceptedAnswers,
+		&questionPage.MatchMode,
+		&questionPage.TrimWhitespace,
+		&questionPage.GradingMode,
+		&questionPage.TrueFalseAnswer,
+		&questionPage.NumericAnswer,
+		&questionPage.Tolerance,
+		&questionPage.MinValue,
+		&questionPage.MaxValue,
+		&questionPage.Blanks,
+		&questionPage.OrderingItems,
+		&questionPage.MatchingPairs,
 	)
+}
+
+const hasUserAnsweredQuery = `
+	SELECT COALESCE((
+		SELECT qpa.user_answer IS NOT NULL
+		FROM
+			attempt_lessonattempt la
+		INNER JOIN
+			pages_abstractpageattempt apa ON la.id = apa.lesson_attempt_id
+		INNER JOIN
+			question_abstractquestionattempt aqa ON apa.id = aqa.page_attempt_id
+		INNER JOIN
+			question_questionpageattempt qpa ON aqa.id = qpa.question_attempt_id
+		INNER JOIN
+			question_questionpage qp ON qpa.page_id = qp.id
+		WHERE
+			qp.abstractpage_id = $1 AND
+			la.user_id = $2
+		ORDER BY la.id DESC
+		LIMIT 1
+	), false)`
+
+// HasUserAnswered reports whether the user has answered the question page in the latest
+// of their attempts which contains it. A new attempt hides the answers again until
+// the page is answered in it.
+func (q *QuestionsPostgresStorage) HasUserAnswered(ctx context.Context, pageID, userID int64) (bool, error) {
+	const op = "storage.postgresql.pages.pages.HasUserAnswered"
+
+	var answered bool
+	err := postgresql.Conn(ctx, q.db).QueryRow(ctx, hasUserAnsweredQuery, pageID, userID).Scan(&answered)
 	if err != nil {
-		return (QuestionPage)(questionPage), fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return answered, nil
+}
+
+const getAnsweredPageIDsQuery = `
+	SELECT DISTINCT ON (qp.abstractpage_id)
+		qp.abstractpage_id,
+		qpa.user_answer IS NOT NULL
+	FROM
+		attempt_lessonattempt la
+	INNER JOIN
+		pages_abstractpageattempt apa ON la.id = apa.lesson_attempt_id
+	INNER JOIN
+		question_abstractquestionattempt aqa ON apa.id = aqa.page_attempt_id
+	INNER JOIN
+		question_questionpageattempt qpa ON aqa.id = qpa.question_attempt_id
+	INNER JOIN
+		question_questionpage qp ON qpa.page_id = qp.id
+	WHERE
+		qp.abstractpage_id = ANY($1) AND
+		la.user_id = $2
+	ORDER BY qp.abstractpage_id, la.id DESC`
+
+// GetAnsweredPageIDs returns IDs of the given question pages the user has answered
+// in the latest of their attempts which contains the page.
+func (q *QuestionsPostgresStorage) GetAnsweredPageIDs(ctx context.Context, pageIDs []int64, userID int64) (map[int64]bool, error) {
+	const op = "storage.postgresql.questions.questions.GetAnsweredPageIDs"
+
+	rows, err := postgresql.Conn(ctx, q.db).Query(ctx, getAnsweredPageIDsQuery, pageIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	answered := make(map[int64]bool)
+	for rows.Next() {
+		var pageID int64
+		var isAnswered bool
+		if err := rows.Scan(&pageID, &isAnswered); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		if isAnswered {
+			answered[pageID] = true
+		}
 	}
 
-	return (QuestionPage)(questionPage), nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return answered, nil
+}
+
+const (
+	deleteAbstractQuestionQuery = `
+	DELETE FROM question_abstractquestion
+	WHERE id = $1`
+	deleteQuestionAbstractPageQuery = `
+	DELETE FROM pages_abstractpages
+	WHERE id = $1
+	RETURNING lesson_id, position`
+)
+
+// DeleteQuestionPage deletes the question with its details, options and question page,
+// and then the abstract page itself, closing the gap in page order of the lesson.
+func (q *QuestionsPostgresStorage) DeleteQuestionPage(ctx context.Context, pageID int64) error {
+	const op = "storage.postgresql.questions.questions.DeleteQuestionPage"
+
+	return q.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, q.db)
+
+		var questionID int64
+		err := conn.QueryRow(ctx, getQuestionIDQuery, pageID).Scan(&questionID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, storage.ErrPageNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err := conn.Exec(ctx, deleteAbstractQuestionQuery, questionID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var lessonID sql.NullInt64
+		var position int64
+		err = conn.QueryRow(ctx, deleteQuestionAbstractPageQuery, pageID).Scan(&lessonID, &position)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !lessonID.Valid {
+			return nil
+		}
+
+		return postgresql.ReleasePagePosition(ctx, conn, lessonID.Int64, position)
+	})
 }
 
 const (
@@ -179,17 +611,21 @@ const (
 		last_modified_by = $2,
 		modified = now()
 	WHERE id = $1`
+	updateExplanationQuery = `
+	UPDATE
+		question_abstractquestion aq
+	SET
+		explanation = NULLIF($2, '')
+	FROM
+		question_questionpage qp
+	WHERE
+		qp.question_id = aq.id
+	AND qp.abstractpage_id = $1`
 	updateQuestionPageQuery = `
 	UPDATE
 		question_multichoicequestion mq
 	SET
-		question = COALESCE($2, question),
-		option_a = COALESCE($3, option_a),
-		option_b = COALESCE($4, option_b),
-		option_c = COALESCE($5, option_c),
-		option_d = COALESCE($6, option_d),
-		option_e = COALESCE($7, option_e),
-		answer = COALESCE($8, answer)
+		question = COALESCE($2, question)
 	FROM
     	question_abstractquestion aq
 	INNER JOIN
@@ -197,60 +633,410 @@ const (
 	WHERE
 		mq.question_abstractquestion_id = aq.id
 	AND qp.abstractpage_id = $1`
+	updateShortAnswerQuestionQuery = `
+	UPDATE
+		question_shortanswerquestion sq
+	SET
+		question = COALESCE($2, question),
+		accepted_answers = COALESCE($3, accepted_answers),
+		match_mode = COALESCE($4, match_mode),
+		trim_whitespace = COALESCE($5, trim_whitespace)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		sq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1`
+	updateMultiSelectQuestionQuery = `
+	UPDATE
+		question_multiselectquestion msq
+	SET
+		question = COALESCE($2, question),
+		grading_mode = COALESCE($3, grading_mode)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		msq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1`
+	updateTrueFalseQuestionQuery = `
+	UPDATE
+		question_truefalsequestion tfq
+	SET
+		question = COALESCE($2, question),
+		answer = COALESCE($3, answer)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		tfq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1`
+	updateNumericQuestionQuery = `
+	UPDATE
+		question_numericquestion nq
+	SET
+		question = COALESCE($2, question),
+		answer = COALESCE($3, answer),
+		tolerance = COALESCE($4, tolerance),
+		min_value = COALESCE($5, min_value),
+		max_value = COALESCE($6, max_value)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		nq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1`
+	updateFillBlanksQuestionQuery = `
+	UPDATE
+		question_fillblanksquestion fbq
+	SET
+		question = COALESCE($2, question),
+		match_mode = COALESCE($3, match_mode),
+		trim_whitespace = COALESCE($4, trim_whitespace)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		fbq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1
+	RETURNING fbq.id`
+	deleteFillBlanksQuery = `
+	DELETE FROM question_fillblank
+	WHERE fillblanksquestion_id = $1`
+	updateOrderingQuestionQuery = `
+	UPDATE
+		question_orderingquestion oq
+	SET
+		question = COALESCE($2, question)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		oq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1
+	RETURNING oq.id`
+	deleteOrderingItemsQuery = `
+	DELETE FROM question_orderingitem
+	WHERE orderingquestion_id = $1`
+	updateMatchingQuestionQuery = `
+	UPDATE
+		question_matchingquestion mtq
+	SET
+		question = COALESCE($2, question)
+	FROM
+		question_abstractquestion aq
+	INNER JOIN
+		question_questionpage qp ON aq.id = qp.question_id
+	WHERE
+		mtq.question_abstractquestion_id = aq.id
+	AND qp.abstractpage_id = $1
+	RETURNING mtq.id`
+	deleteMatchingPairsQuery = `
+	DELETE FROM question_matchingpair
+	WHERE matchingquestion_id = $1`
+	getQuestionIDQuery = `
+	SELECT question_id
+	FROM question_questionpage
+	WHERE abstractpage_id = $1`
+	deleteOptionsQuery = `
+	DELETE FROM question_option
+	WHERE question_abstractquestion_id = $1
+	AND NOT (id = ANY($2))`
+	updateOptionQuery = `
+	UPDATE question_option
+	SET
+		position = $3,
+		text = $4,
+		is_correct = $5,
+		feedback = NULLIF($6, '')
+	WHERE id = $1
+	AND question_abstractquestion_id = $2`
 )
 
 func (q *QuestionsPostgresStorage) UpdateQuestionPage(ctx context.Context, updPage UpdateQuestionPage) (int64, error) {
 	const op = "storage.postgresql.pages.pages.UpdateQuestionPageByID"
 
-	tx, err := q.db.Begin(ctx)
+	err := q.txManager.WithTx(ctx, func(ctx context.Context) error {
+		conn := postgresql.Conn(ctx, q.db)
+
+		_, err := conn.Exec(
+			ctx,
+			updateAbstractPageQuery,
+			updPage.ID,
+			updPage.LastModifiedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// An empty explanation removes it.
+		if updPage.Explanation != nil {
+			_, err = conn.Exec(ctx, updateExplanationQuery, updPage.ID, *updPage.Explanation)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		// Only the table of the actual question type has a row for the page,
+		// so the update of the other types is a no-op.
+		_, err = conn.Exec(
+			ctx,
+			updateQuestionPageQuery,
+			updPage.ID,
+			updPage.Question,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var shortAnswer UpdateShortAnswerQuestion
+		if updPage.ShortAnswer != nil {
+			shortAnswer = *updPage.ShortAnswer
+		}
+		_, err = conn.Exec(
+			ctx,
+			updateShortAnswerQuestionQuery,
+			updPage.ID,
+			updPage.Question,
+			shortAnswer.AcceptedAnswers,
+			shortAnswer.MatchMode,
+			shortAnswer.TrimWhitespace,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var multiSelect UpdateMultiSelectQuestion
+		if updPage.MultiSelect != nil {
+			multiSelect = *updPage.MultiSelect
+		}
+		_, err = conn.Exec(
+			ctx,
+			updateMultiSelectQuestionQuery,
+			updPage.ID,
+			updPage.Question,
+			multiSelect.GradingMode,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var trueFalse UpdateTrueFalseQuestion
+		if updPage.TrueFalse != nil {
+			trueFalse = *updPage.TrueFalse
+		}
+		_, err = conn.Exec(
+			ctx,
+			updateTrueFalseQuestionQuery,
+			updPage.ID,
+			updPage.Question,
+			trueFalse.Answer,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var numeric UpdateNumericQuestion
+		if updPage.Numeric != nil {
+			numeric = *updPage.Numeric
+		}
+		_, err = conn.Exec(
+			ctx,
+			updateNumericQuestionQuery,
+			updPage.ID,
+			updPage.Question,
+			numeric.Answer,
+			numeric.Tolerance,
+			numeric.MinValue,
+			numeric.MaxValue,
+		)
+		if err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		if len(updPage.Options) > 0 {
+			if err = q.replaceOptions(ctx, updPage.ID, updPage.Options); err != nil {
+				if errors.Is(err, storage.ErrOptionNotFound) {
+					return fmt.Errorf("%s: %w", op, err)
+				}
+				return q.checkPgError(err, op)
+			}
+		}
+
+		if err = q.updateFillBlanks(ctx, updPage); err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		if err = q.updateOrdering(ctx, updPage); err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		if err = q.updateMatching(ctx, updPage); err != nil {
+			return q.checkPgError(err, op)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
+		return 0, err
 	}
-	defer func() {
-		if err != nil {
-			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
-				log.Printf("%s: %v", op, storage.ErrRollBack)
+
+	return updPage.ID, nil
+}
+
+// replaceOptions replaces options of the page question keeping IDs of the given
+// existing options. Positions follow the order of the options.
+func (q *QuestionsPostgresStorage) replaceOptions(ctx context.Context, pageID int64, options []Option) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	var questionID int64
+	err := conn.QueryRow(ctx, getQuestionIDQuery, pageID).Scan(&questionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrPageNotFound
+		}
+		return err
+	}
+
+	keepIDs := make([]int64, 0, len(options))
+	for _, option := range options {
+		if option.ID != 0 {
+			keepIDs = append(keepIDs, option.ID)
+		}
+	}
+
+	if _, err = conn.Exec(ctx, deleteOptionsQuery, questionID, keepIDs); err != nil {
+		return err
+	}
+
+	for position, option := range options {
+		if option.ID == 0 {
+			_, err = conn.Exec(ctx, createOption, questionID, position, option.Text, option.IsCorrect, option.Feedback)
+			if err != nil {
+				return err
 			}
+			continue
+		}
+
+		tag, err := conn.Exec(ctx, updateOptionQuery, option.ID, questionID, position, option.Text, option.IsCorrect, option.Feedback)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return storage.ErrOptionNotFound
+		}
+	}
+
+	return nil
+}
+
+// updateFillBlanks updates the fill-in-the-blank question of the page, if any,
+// and replaces its blanks when new ones are given.
+func (q *QuestionsPostgresStorage) updateFillBlanks(ctx context.Context, updPage UpdateQuestionPage) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	var fillBlanks UpdateFillBlanksQuestion
+	if updPage.FillBlanks != nil {
+		fillBlanks = *updPage.FillBlanks
+	}
+
+	var fillBlanksID int64
+	err := conn.QueryRow(
+		ctx,
+		updateFillBlanksQuestionQuery,
+		updPage.ID,
+		updPage.Question,
+		fillBlanks.MatchMode,
+		fillBlanks.TrimWhitespace,
+	).Scan(&fillBlanksID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
 		}
-	}()
+		return err
+	}
+
+	if len(fillBlanks.Blanks) == 0 {
+		return nil
+	}
+
+	if _, err = conn.Exec(ctx, deleteFillBlanksQuery, fillBlanksID); err != nil {
+		return err
+	}
+
+	return q.createFillBlanks(ctx, fillBlanksID, fillBlanks.Blanks)
+}
+
+// updateOrdering updates the ordering question of the page, if any,
+// and replaces its items when new ones are given.
+func (q *QuestionsPostgresStorage) updateOrdering(ctx context.Context, updPage UpdateQuestionPage) error {
+	conn := postgresql.Conn(ctx, q.db)
 
-	_, err = tx.Exec(
+	var orderingID int64
+	err := conn.QueryRow(
 		ctx,
-		updateAbstractPageQuery,
+		updateOrderingQuestionQuery,
 		updPage.ID,
-		updPage.LastModifiedBy,
-	)
+		updPage.Question,
+	).Scan(&orderingID)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if updPage.Ordering == nil || len(updPage.Ordering.Items) == 0 {
+		return nil
+	}
+
+	if _, err = conn.Exec(ctx, deleteOrderingItemsQuery, orderingID); err != nil {
+		return err
 	}
 
-	_, err = tx.Exec(
+	return q.createOrderingItems(ctx, orderingID, updPage.Ordering.Items)
+}
+
+// updateMatching updates the matching question of the page, if any,
+// and replaces its pairs when new ones are given.
+func (q *QuestionsPostgresStorage) updateMatching(ctx context.Context, updPage UpdateQuestionPage) error {
+	conn := postgresql.Conn(ctx, q.db)
+
+	var matchingID int64
+	err := conn.QueryRow(
 		ctx,
-		updateQuestionPageQuery,
+		updateMatchingQuestionQuery,
 		updPage.ID,
 		updPage.Question,
-		updPage.OptionA,
-		updPage.OptionB,
-		updPage.OptionC,
-		updPage.OptionD,
-		updPage.OptionE,
-		updPage.Answer,
-	)
+	).Scan(&matchingID)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
+	if updPage.Matching == nil || len(updPage.Matching.Pairs) == 0 {
+		return nil
 	}
 
-	return updPage.ID, nil
+	if _, err = conn.Exec(ctx, deleteMatchingPairsQuery, matchingID); err != nil {
+		return err
+	}
+
+	return q.createMatchingPairs(ctx, matchingID, updPage.Matching.Pairs)
 }
 
-func (q *QuestionsPostgresStorage) checkPgError(err error, op string) (int64, error) {
+func (q *QuestionsPostgresStorage) checkPgError(err error, op string) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-		return 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
 	}
-	return 0, fmt.Errorf("%s: %w", op, err)
+	return fmt.Errorf("%s: %w", op, err)
 }
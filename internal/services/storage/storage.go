@@ -6,16 +6,31 @@ var (
 	ErrChannelExitsts  = errors.New("channel already exists")
 	ErrChannelNotFound = errors.New("channel not found")
 
-	ErrPlanExitsts  = errors.New("plan already exists")
-	ErrPlanNotFound = errors.New("plan not found")
+	ErrPlanExitsts     = errors.New("plan already exists")
+	ErrPlanNotFound    = errors.New("plan not found")
+	ErrPlanInChannel   = errors.New("plan already in channel")
+	ErrLastPlanChannel = errors.New("plan is not in any other channel")
 
 	ErrLessonExitsts  = errors.New("lesson already exists")
 	ErrLessonNotFound = errors.New("lesson not found")
+	ErrLessonInPlan   = errors.New("lesson already in plan")
+	ErrLastLessonPlan = errors.New("lesson is not in any other plan")
 
 	ErrPageExitsts  = errors.New("page already exists")
 	ErrPageNotFound = errors.New("page not found")
 	ErrUnContType   = errors.New("unsupported content type")
 
+	ErrPagesOrderChanged = errors.New("page order changed")
+
+	ErrOptionNotFound = errors.New("option not found")
+
+	ErrBankNotFound   = errors.New("question bank not found")
+	ErrQuestionInBank = errors.New("question already in bank")
+
+	ErrAttemptExitsts   = errors.New("attempt already exists")
+	ErrAttemptNotFound  = errors.New("attempt not found")
+	ErrAttemptCompleted = errors.New("attempt already completed")
+
 	ErrInvalidCredentials    = errors.New("invalid credentials")
 	ErrRowsIteration         = errors.New("rows iteration failed")
 	ErrScanFailed            = errors.New("scan failed")
@@ -0,0 +1,9 @@
+package storage
+
+import "context"
+
+// TxManager runs fn as a single unit of work. Storage calls made with the
+// context passed to fn take part in the same transaction.
+type TxManager interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
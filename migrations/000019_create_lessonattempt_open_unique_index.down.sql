@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "uq_lessonattempt_open";
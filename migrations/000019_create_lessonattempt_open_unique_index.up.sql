@@ -0,0 +1,21 @@
+UPDATE "attempt_lessonattempt" la
+SET
+  "is_complete" = true,
+  "end_time" = now()
+WHERE
+  la."is_complete" = false
+  AND EXISTS (
+    SELECT 1
+    FROM "attempt_lessonattempt" newer
+    WHERE
+      newer."is_complete" = false
+      AND newer."user_id" = la."user_id"
+      AND newer."lesson_id" = la."lesson_id"
+      AND newer."plan_id" = la."plan_id"
+      AND newer."channel_id" = la."channel_id"
+      AND newer."id" > la."id"
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_lessonattempt_open"
+ON "attempt_lessonattempt" ("user_id", "lesson_id", "plan_id", "channel_id")
+WHERE "is_complete" = false;
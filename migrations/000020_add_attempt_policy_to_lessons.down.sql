@@ -0,0 +1,4 @@
+ALTER TABLE "lessons"
+DROP COLUMN IF EXISTS "max_attempts",
+DROP COLUMN IF EXISTS "attempt_cooldown_seconds",
+DROP COLUMN IF EXISTS "time_limit_seconds";
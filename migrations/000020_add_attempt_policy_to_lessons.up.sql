@@ -0,0 +1,4 @@
+ALTER TABLE "lessons"
+ADD COLUMN IF NOT EXISTS "max_attempts" integer NOT NULL DEFAULT 0 CHECK ("max_attempts" >= 0),
+ADD COLUMN IF NOT EXISTS "attempt_cooldown_seconds" integer NOT NULL DEFAULT 0 CHECK ("attempt_cooldown_seconds" >= 0),
+ADD COLUMN IF NOT EXISTS "time_limit_seconds" integer NOT NULL DEFAULT 0 CHECK ("time_limit_seconds" >= 0);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS "pages_contentpageattempt" (
+  "id" SERIAL PRIMARY KEY,
+  "page_id" integer,
+  "page_attempt_id" integer UNIQUE,
+  "is_viewed" boolean NOT NULL DEFAULT false,
+  "viewed_at" timestamptz,
+  "progress" integer NOT NULL DEFAULT 0 CHECK ("progress" >= 0),
+  CONSTRAINT fk_page FOREIGN KEY ("page_id") REFERENCES "pages_abstractpages" ("id") ON DELETE CASCADE,
+  CONSTRAINT fk_page_attempt FOREIGN KEY ("page_attempt_id") REFERENCES "pages_abstractpageattempt" ("id") ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE "lessons"
+DROP COLUMN IF EXISTS "require_all_pages_viewed";
@@ -0,0 +1,2 @@
+ALTER TABLE "lessons"
+ADD COLUMN IF NOT EXISTS "require_all_pages_viewed" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "question_shortanswerquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  "accepted_answers" text[] NOT NULL CHECK (cardinality("accepted_answers") > 0),
+  "match_mode" text NOT NULL DEFAULT 'exact' CHECK (match_mode IN ('exact', 'case_insensitive', 'regex')),
+  "trim_whitespace" boolean NOT NULL DEFAULT true,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE "question_questionpageattempt"
+ALTER COLUMN "user_answer" TYPE varchar(8) USING left("user_answer", 8);
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS "question_multiselectquestion";
+
+DELETE FROM "question_abstractquestion" WHERE question_type = 'multiselect';
+DELETE FROM "question_abstractquestionattempt" WHERE question_type = 'multiselect';
+
+ALTER TABLE "question_abstractquestion"
+DROP CONSTRAINT IF EXISTS "question_abstractquestion_question_type_check",
+ADD CONSTRAINT "question_abstractquestion_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer'));
+
+ALTER TABLE "question_abstractquestionattempt"
+DROP CONSTRAINT IF EXISTS "question_abstractquestionattempt_question_type_check",
+ADD CONSTRAINT "question_abstractquestionattempt_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer'));
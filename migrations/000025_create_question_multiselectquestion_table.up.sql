@@ -0,0 +1,23 @@
+ALTER TABLE "question_abstractquestion"
+DROP CONSTRAINT IF EXISTS "question_abstractquestion_question_type_check",
+ADD CONSTRAINT "question_abstractquestion_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer', 'multiselect'));
+
+ALTER TABLE "question_abstractquestionattempt"
+DROP CONSTRAINT IF EXISTS "question_abstractquestionattempt_question_type_check",
+ADD CONSTRAINT "question_abstractquestionattempt_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer', 'multiselect'));
+
+CREATE TABLE IF NOT EXISTS "question_multiselectquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  "option_a" varchar(512) NOT NULL,
+  "option_b" varchar(512) NOT NULL,
+  "option_c" varchar(512) NOT NULL,
+  "option_d" varchar(512) NOT NULL,
+  "option_e" varchar(512) NOT NULL,
+  "answers" text[] NOT NULL CHECK (cardinality("answers") > 0),
+  "grading_mode" text NOT NULL DEFAULT 'all_or_nothing' CHECK (grading_mode IN ('all_or_nothing', 'partial_credit', 'penalty')),
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
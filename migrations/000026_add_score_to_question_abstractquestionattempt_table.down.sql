@@ -0,0 +1,2 @@
+ALTER TABLE "question_abstractquestionattempt"
+DROP COLUMN IF EXISTS "score";
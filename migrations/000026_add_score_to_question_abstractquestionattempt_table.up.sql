@@ -0,0 +1,2 @@
+ALTER TABLE "question_abstractquestionattempt"
+ADD COLUMN IF NOT EXISTS "score" integer CHECK ("score" BETWEEN 0 AND 100);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "question_truefalsequestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  "answer" boolean NOT NULL,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "question_numericquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  "answer" double precision,
+  "tolerance" double precision NOT NULL DEFAULT 0 CHECK ("tolerance" >= 0),
+  "min_value" double precision,
+  "max_value" double precision,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE,
+  CONSTRAINT chk_answer_or_range CHECK (
+    "answer" IS NOT NULL
+    OR ("min_value" IS NOT NULL AND "max_value" IS NOT NULL AND "min_value" <= "max_value")
+  )
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "question_fillblanksquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  "match_mode" text NOT NULL DEFAULT 'exact' CHECK (match_mode IN ('exact', 'case_insensitive', 'regex')),
+  "trim_whitespace" boolean NOT NULL DEFAULT true,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "question_fillblank" (
+  "id" SERIAL PRIMARY KEY,
+  "fillblanksquestion_id" integer NOT NULL,
+  "position" integer NOT NULL CHECK ("position" >= 0),
+  "accepted_answers" text[] NOT NULL CHECK (cardinality("accepted_answers") > 0),
+  CONSTRAINT fk_fillblanksquestion FOREIGN KEY ("fillblanksquestion_id") REFERENCES "question_fillblanksquestion" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_fillblank_position UNIQUE ("fillblanksquestion_id", "position")
+);
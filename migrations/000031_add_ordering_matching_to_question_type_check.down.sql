@@ -0,0 +1,12 @@
+DELETE FROM "question_abstractquestion" WHERE question_type IN ('ordering', 'matching');
+DELETE FROM "question_abstractquestionattempt" WHERE question_type IN ('ordering', 'matching');
+
+ALTER TABLE "question_abstractquestion"
+DROP CONSTRAINT IF EXISTS "question_abstractquestion_question_type_check",
+ADD CONSTRAINT "question_abstractquestion_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer', 'multiselect', 'true_false', 'numeric', 'fill_blanks'));
+
+ALTER TABLE "question_abstractquestionattempt"
+DROP CONSTRAINT IF EXISTS "question_abstractquestionattempt_question_type_check",
+ADD CONSTRAINT "question_abstractquestionattempt_question_type_check"
+CHECK (question_type IN ('multichoice', 'short_answer', 'multiselect', 'true_false', 'numeric', 'fill_blanks'));
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "question_orderingquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "question_orderingitem" (
+  "id" SERIAL PRIMARY KEY,
+  "orderingquestion_id" integer NOT NULL,
+  "position" integer NOT NULL CHECK ("position" >= 0),
+  "text" text NOT NULL,
+  CONSTRAINT fk_orderingquestion FOREIGN KEY ("orderingquestion_id") REFERENCES "question_orderingquestion" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_orderingitem_position UNIQUE ("orderingquestion_id", "position"),
+  CONSTRAINT uq_orderingitem_text UNIQUE ("orderingquestion_id", "text")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "question_matchingpair",
+                     "question_matchingquestion";
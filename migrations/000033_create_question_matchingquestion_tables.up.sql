@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "question_matchingquestion" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer UNIQUE,
+  "question" text,
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "question_matchingpair" (
+  "id" SERIAL PRIMARY KEY,
+  "matchingquestion_id" integer NOT NULL,
+  "position" integer NOT NULL CHECK ("position" >= 0),
+  "left_item" text NOT NULL,
+  "right_item" text NOT NULL,
+  CONSTRAINT fk_matchingquestion FOREIGN KEY ("matchingquestion_id") REFERENCES "question_matchingquestion" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_matchingpair_position UNIQUE ("matchingquestion_id", "position"),
+  CONSTRAINT uq_matchingpair_left_item UNIQUE ("matchingquestion_id", "left_item"),
+  CONSTRAINT uq_matchingpair_right_item UNIQUE ("matchingquestion_id", "right_item")
+);
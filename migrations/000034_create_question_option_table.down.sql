@@ -0,0 +1,75 @@
+-- Only the first five options of a question fit into the fixed columns.
+ALTER TABLE "question_multichoicequestion"
+ADD COLUMN "option_a" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_b" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_c" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_d" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_e" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "answer" varchar(8) NOT NULL DEFAULT '';
+
+ALTER TABLE "question_multiselectquestion"
+ADD COLUMN "option_a" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_b" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_c" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_d" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "option_e" varchar(512) NOT NULL DEFAULT '',
+ADD COLUMN "answers" text[] NOT NULL DEFAULT '{}';
+
+UPDATE "question_multichoicequestion" mq
+SET
+  option_a = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.position = 0), ''),
+  option_b = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.position = 1), ''),
+  option_c = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.position = 2), ''),
+  option_d = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.position = 3), ''),
+  option_e = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.position = 4), ''),
+  answer = COALESCE((
+    SELECT 'OPTION_' || chr(65 + o.position)
+    FROM question_option o
+    WHERE o.question_abstractquestion_id = mq.question_abstractquestion_id AND o.is_correct AND o.position < 5
+    ORDER BY o.position
+    LIMIT 1
+  ), '');
+
+UPDATE "question_multiselectquestion" msq
+SET
+  option_a = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.position = 0), ''),
+  option_b = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.position = 1), ''),
+  option_c = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.position = 2), ''),
+  option_d = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.position = 3), ''),
+  option_e = COALESCE((SELECT o.text FROM question_option o WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.position = 4), ''),
+  answers = ARRAY(
+    SELECT 'OPTION_' || chr(65 + o.position)
+    FROM question_option o
+    WHERE o.question_abstractquestion_id = msq.question_abstractquestion_id AND o.is_correct AND o.position < 5
+    ORDER BY o.position
+  );
+
+UPDATE "question_questionpageattempt" qpa
+SET user_answer = (
+  SELECT string_agg('OPTION_' || chr(65 + o.position), ',' ORDER BY o.position)
+  FROM question_option o
+  WHERE o.id::text = ANY(string_to_array(qpa.user_answer, ','))
+    AND o.position < 5
+)
+FROM question_questionpage qp
+INNER JOIN question_abstractquestion aq ON aq.id = qp.question_id
+WHERE qpa.page_id = qp.id
+  AND aq.question_type IN ('multichoice', 'multiselect');
+
+ALTER TABLE "question_multichoicequestion"
+ALTER COLUMN "option_a" DROP DEFAULT,
+ALTER COLUMN "option_b" DROP DEFAULT,
+ALTER COLUMN "option_c" DROP DEFAULT,
+ALTER COLUMN "option_d" DROP DEFAULT,
+ALTER COLUMN "option_e" DROP DEFAULT,
+ALTER COLUMN "answer" DROP DEFAULT;
+
+ALTER TABLE "question_multiselectquestion"
+ALTER COLUMN "option_a" DROP DEFAULT,
+ALTER COLUMN "option_b" DROP DEFAULT,
+ALTER COLUMN "option_c" DROP DEFAULT,
+ALTER COLUMN "option_d" DROP DEFAULT,
+ALTER COLUMN "option_e" DROP DEFAULT,
+ALTER COLUMN "answers" DROP DEFAULT;
+
+DROP TABLE IF EXISTS "question_option";
@@ -0,0 +1,82 @@
+CREATE TABLE IF NOT EXISTS "question_option" (
+  "id" SERIAL PRIMARY KEY,
+  "question_abstractquestion_id" integer NOT NULL,
+  "position" integer NOT NULL CHECK ("position" >= 0),
+  "text" varchar(512) NOT NULL,
+  "is_correct" boolean NOT NULL DEFAULT false,
+  "legacy_answer" varchar(8),
+  CONSTRAINT fk_question_abstractquestion FOREIGN KEY ("question_abstractquestion_id") REFERENCES "question_abstractquestion" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_question_option_position UNIQUE ("question_abstractquestion_id", "position") DEFERRABLE INITIALLY DEFERRED
+);
+
+-- Move fixed option columns to rows, skipping options which were left empty.
+INSERT INTO "question_option" ("question_abstractquestion_id", "position", "text", "is_correct", "legacy_answer")
+SELECT
+  src.question_abstractquestion_id,
+  ROW_NUMBER() OVER (PARTITION BY src.question_abstractquestion_id ORDER BY src.legacy_answer) - 1,
+  src.text,
+  src.is_correct,
+  src.legacy_answer
+FROM (
+  SELECT mq.question_abstractquestion_id, o.legacy_answer, o.text, o.legacy_answer = mq.answer AS is_correct
+  FROM question_multichoicequestion mq
+  CROSS JOIN LATERAL (VALUES
+    ('OPTION_A', mq.option_a),
+    ('OPTION_B', mq.option_b),
+    ('OPTION_C', mq.option_c),
+    ('OPTION_D', mq.option_d),
+    ('OPTION_E', mq.option_e)
+  ) AS o(legacy_answer, text)
+  UNION ALL
+  SELECT msq.question_abstractquestion_id, o.legacy_answer, o.text, o.legacy_answer = ANY(msq.answers) AS is_correct
+  FROM question_multiselectquestion msq
+  CROSS JOIN LATERAL (VALUES
+    ('OPTION_A', msq.option_a),
+    ('OPTION_B', msq.option_b),
+    ('OPTION_C', msq.option_c),
+    ('OPTION_D', msq.option_d),
+    ('OPTION_E', msq.option_e)
+  ) AS o(legacy_answer, text)
+) src
+WHERE src.question_abstractquestion_id IS NOT NULL
+  AND (src.text <> '' OR src.is_correct);
+
+-- Answers of attempts refer to option IDs instead of option letters.
+UPDATE "question_questionpageattempt" qpa
+SET user_answer = o.id::text
+FROM question_questionpage qp
+INNER JOIN question_abstractquestion aq ON aq.id = qp.question_id AND aq.question_type = 'multichoice'
+INNER JOIN question_option o ON o.question_abstractquestion_id = aq.id
+WHERE qpa.page_id = qp.id
+  AND o.legacy_answer = qpa.user_answer;
+
+UPDATE "question_questionpageattempt" qpa
+SET user_answer = selected.option_ids
+FROM (
+  SELECT a.id, string_agg(o.id::text, ',' ORDER BY o.id) AS option_ids
+  FROM question_questionpageattempt a
+  INNER JOIN question_questionpage qp ON qp.id = a.page_id
+  INNER JOIN question_abstractquestion aq ON aq.id = qp.question_id AND aq.question_type = 'multiselect'
+  INNER JOIN question_option o ON o.question_abstractquestion_id = aq.id
+  WHERE o.legacy_answer = ANY(string_to_array(a.user_answer, ','))
+  GROUP BY a.id
+) selected
+WHERE qpa.id = selected.id;
+
+ALTER TABLE "question_option" DROP COLUMN "legacy_answer";
+
+ALTER TABLE "question_multichoicequestion"
+DROP COLUMN "option_a",
+DROP COLUMN "option_b",
+DROP COLUMN "option_c",
+DROP COLUMN "option_d",
+DROP COLUMN "option_e",
+DROP COLUMN "answer";
+
+ALTER TABLE "question_multiselectquestion"
+DROP COLUMN "option_a",
+DROP COLUMN "option_b",
+DROP COLUMN "option_c",
+DROP COLUMN "option_d",
+DROP COLUMN "option_e",
+DROP COLUMN "answers";
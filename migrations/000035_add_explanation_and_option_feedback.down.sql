@@ -0,0 +1,5 @@
+ALTER TABLE "question_option"
+DROP COLUMN IF EXISTS "feedback";
+
+ALTER TABLE "question_abstractquestion"
+DROP COLUMN IF EXISTS "explanation";
@@ -0,0 +1,5 @@
+ALTER TABLE "question_abstractquestion"
+ADD COLUMN "explanation" text;
+
+ALTER TABLE "question_option"
+ADD COLUMN "feedback" text;
@@ -0,0 +1,9 @@
+ALTER TABLE "question_questionpageattempt"
+DROP COLUMN IF EXISTS "option_order";
+
+ALTER TABLE "pages_abstractpageattempt"
+DROP COLUMN IF EXISTS "position";
+
+ALTER TABLE "lessons"
+DROP COLUMN IF EXISTS "shuffle_questions",
+DROP COLUMN IF EXISTS "shuffle_options";
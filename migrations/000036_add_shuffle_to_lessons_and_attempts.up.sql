@@ -0,0 +1,9 @@
+ALTER TABLE "lessons"
+ADD COLUMN "shuffle_questions" boolean NOT NULL DEFAULT false,
+ADD COLUMN "shuffle_options" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "pages_abstractpageattempt"
+ADD COLUMN "position" integer;
+
+ALTER TABLE "question_questionpageattempt"
+ADD COLUMN "option_order" integer[];
@@ -0,0 +1,7 @@
+ALTER TABLE "question_questionpageattempt"
+DROP CONSTRAINT IF EXISTS fk_bank,
+DROP COLUMN IF EXISTS "bank_id";
+
+DROP TABLE IF EXISTS "question_bankdraw",
+                     "question_bankitem",
+                     "question_bank";
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "question_bank" (
+  "id" SERIAL PRIMARY KEY,
+  "name" varchar(255) NOT NULL,
+  "description" text,
+  "lesson_id" integer,
+  "channel_id" integer,
+  "created_by" integer NOT NULL,
+  "last_modified_by" integer NOT NULL,
+  "created_at" timestamptz DEFAULT (now()),
+  "modified" timestamptz,
+  CONSTRAINT fk_lesson FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE,
+  CONSTRAINT fk_channel FOREIGN KEY ("channel_id") REFERENCES "channels" ("id") ON DELETE CASCADE,
+  CONSTRAINT chk_question_bank_owner CHECK (("lesson_id" IS NULL) <> ("channel_id" IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS "question_bankitem" (
+  "id" SERIAL PRIMARY KEY,
+  "bank_id" integer NOT NULL,
+  "page_id" integer NOT NULL,
+  "tag" varchar(100),
+  "difficulty" text CHECK (difficulty IN ('easy', 'medium', 'hard')),
+  CONSTRAINT fk_bank FOREIGN KEY ("bank_id") REFERENCES "question_bank" ("id") ON DELETE CASCADE,
+  CONSTRAINT fk_page FOREIGN KEY ("page_id") REFERENCES "pages_abstractpages" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_bankitem_page UNIQUE ("bank_id", "page_id")
+);
+
+CREATE TABLE IF NOT EXISTS "question_bankdraw" (
+  "id" SERIAL PRIMARY KEY,
+  "lesson_id" integer NOT NULL,
+  "bank_id" integer NOT NULL,
+  "position" integer NOT NULL CHECK ("position" >= 0),
+  "count" integer NOT NULL CHECK ("count" > 0),
+  "tag" varchar(100),
+  "difficulty" text CHECK (difficulty IN ('easy', 'medium', 'hard')),
+  CONSTRAINT fk_lesson FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE,
+  CONSTRAINT fk_bank FOREIGN KEY ("bank_id") REFERENCES "question_bank" ("id") ON DELETE CASCADE,
+  CONSTRAINT uq_bankdraw_position UNIQUE ("lesson_id", "position")
+);
+
+ALTER TABLE "question_questionpageattempt"
+ADD COLUMN "bank_id" integer,
+ADD CONSTRAINT fk_bank FOREIGN KEY ("bank_id") REFERENCES "question_bank" ("id") ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+ALTER TABLE "lessons"
+DROP COLUMN IF EXISTS "pages_version";
+
+ALTER TABLE "pages_abstractpages"
+DROP CONSTRAINT IF EXISTS uq_abstractpages_lesson_position,
+DROP COLUMN IF EXISTS "position";
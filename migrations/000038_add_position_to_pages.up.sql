@@ -0,0 +1,16 @@
+ALTER TABLE "pages_abstractpages"
+ADD COLUMN "position" integer NOT NULL DEFAULT 0 CHECK ("position" >= 0);
+
+UPDATE "pages_abstractpages" ap
+SET "position" = ordered.rn - 1
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY lesson_id ORDER BY id) AS rn
+  FROM "pages_abstractpages"
+) ordered
+WHERE ap.id = ordered.id;
+
+ALTER TABLE "pages_abstractpages"
+ADD CONSTRAINT uq_abstractpages_lesson_position UNIQUE ("lesson_id", "position") DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE "lessons"
+ADD COLUMN "pages_version" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+ALTER TABLE "channels_plans"
+DROP CONSTRAINT IF EXISTS uq_channels_plans_channel_position,
+DROP COLUMN IF EXISTS "position";
+
+ALTER TABLE "plans_lessons"
+DROP CONSTRAINT IF EXISTS uq_plans_lessons_plan_position,
+DROP COLUMN IF EXISTS "position";
@@ -0,0 +1,27 @@
+ALTER TABLE "plans_lessons"
+ADD COLUMN "position" integer NOT NULL DEFAULT 0 CHECK ("position" >= 0);
+
+UPDATE "plans_lessons" pl
+SET "position" = ordered.rn - 1
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY plan_id ORDER BY lesson_id) AS rn
+  FROM "plans_lessons"
+) ordered
+WHERE pl.id = ordered.id;
+
+ALTER TABLE "plans_lessons"
+ADD CONSTRAINT uq_plans_lessons_plan_position UNIQUE ("plan_id", "position") DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE "channels_plans"
+ADD COLUMN "position" integer NOT NULL DEFAULT 0 CHECK ("position" >= 0);
+
+UPDATE "channels_plans" cp
+SET "position" = ordered.rn - 1
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY channel_id ORDER BY plan_id) AS rn
+  FROM "channels_plans"
+) ordered
+WHERE cp.id = ordered.id;
+
+ALTER TABLE "channels_plans"
+ADD CONSTRAINT uq_channels_plans_channel_position UNIQUE ("channel_id", "position") DEFERRABLE INITIALLY DEFERRED;
@@ -0,0 +1,6 @@
+ALTER TABLE "lessons"
+DROP COLUMN IF EXISTS "description",
+DROP COLUMN IF EXISTS "estimated_duration_minutes",
+DROP COLUMN IF EXISTS "difficulty",
+DROP COLUMN IF EXISTS "learning_objectives",
+DROP COLUMN IF EXISTS "cover_image_url";
@@ -0,0 +1,6 @@
+ALTER TABLE "lessons"
+ADD COLUMN "description" text NOT NULL DEFAULT '',
+ADD COLUMN "estimated_duration_minutes" integer NOT NULL DEFAULT 0 CHECK ("estimated_duration_minutes" >= 0),
+ADD COLUMN "difficulty" varchar(10) CHECK ("difficulty" IN ('easy', 'medium', 'hard')),
+ADD COLUMN "learning_objectives" text[] NOT NULL DEFAULT '{}',
+ADD COLUMN "cover_image_url" varchar(2048) NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+ALTER TABLE "plans_lessons"
+DROP CONSTRAINT IF EXISTS uq_plans_lessons_plan_lesson;
+
+ALTER TABLE "lessons"
+ADD CONSTRAINT "lessons_name_key" UNIQUE ("name");
@@ -0,0 +1,13 @@
+ALTER TABLE "lessons"
+DROP CONSTRAINT IF EXISTS "lessons_name_key";
+
+-- A lesson could be added to the same plan more than once, keep the first row.
+DELETE FROM "plans_lessons" pl
+USING "plans_lessons" dup
+WHERE
+  dup."plan_id" = pl."plan_id"
+  AND dup."lesson_id" = pl."lesson_id"
+  AND dup."id" < pl."id";
+
+ALTER TABLE "plans_lessons"
+ADD CONSTRAINT uq_plans_lessons_plan_lesson UNIQUE ("plan_id", "lesson_id");
@@ -0,0 +1,2 @@
+ALTER TABLE "channels_plans"
+DROP CONSTRAINT IF EXISTS uq_channels_plans_channel_plan;
@@ -0,0 +1,39 @@
+-- A plan could be added to the same channel more than once, keep the first row.
+DELETE FROM "channels_plans" cp
+USING "channels_plans" dup
+WHERE
+  dup."channel_id" = cp."channel_id"
+  AND dup."plan_id" = cp."plan_id"
+  AND dup."id" < cp."id";
+
+ALTER TABLE "channels_plans"
+ADD CONSTRAINT uq_channels_plans_channel_plan UNIQUE ("channel_id", "plan_id");
+
+-- Plans left without a channel by deleted channels are removed only when
+-- nothing is lost with them: they have no attempts and each of their lessons
+-- is still in another plan. Other orphaned plans are kept for manual review.
+DELETE FROM "plans" p
+WHERE
+  NOT EXISTS (
+    SELECT 1
+    FROM "channels_plans" cp
+    WHERE cp."plan_id" = p."id"
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM "attempt_lessonattempt" la
+    WHERE la."plan_id" = p."id"
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM "plans_lessons" pl
+    WHERE
+      pl."plan_id" = p."id"
+      AND NOT EXISTS (
+        SELECT 1
+        FROM "plans_lessons" other
+        WHERE
+          other."lesson_id" = pl."lesson_id"
+          AND other."plan_id" <> p."id"
+      )
+  );
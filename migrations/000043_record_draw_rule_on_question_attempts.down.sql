@@ -0,0 +1,4 @@
+ALTER TABLE "question_questionpageattempt"
+DROP COLUMN IF EXISTS "draw_count",
+DROP COLUMN IF EXISTS "draw_tag",
+DROP COLUMN IF EXISTS "draw_difficulty";
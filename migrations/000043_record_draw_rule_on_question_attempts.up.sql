@@ -0,0 +1,4 @@
+ALTER TABLE "question_questionpageattempt"
+ADD COLUMN "draw_count" integer CHECK ("draw_count" > 0),
+ADD COLUMN "draw_tag" varchar(100),
+ADD COLUMN "draw_difficulty" text CHECK (draw_difficulty IN ('easy', 'medium', 'hard'));
@@ -81,6 +81,13 @@ type QuestionType int32
 const (
 	QuestionType_QUESTION_TYPE_UNSPECIFIED QuestionType = 0
 	QuestionType_MULTICHOICE               QuestionType = 1
+	QuestionType_SHORT_ANSWER              QuestionType = 2
+	QuestionType_MULTISELECT               QuestionType = 3
+	QuestionType_TRUE_FALSE                QuestionType = 4
+	QuestionType_NUMERIC                   QuestionType = 5
+	QuestionType_FILL_BLANKS               QuestionType = 6
+	QuestionType_ORDERING                  QuestionType = 7
+	QuestionType_MATCHING                  QuestionType = 8
 )
 
 // Enum value maps for QuestionType.
@@ -88,10 +95,24 @@ var (
 	QuestionType_name = map[int32]string{
 		0: "QUESTION_TYPE_UNSPECIFIED",
 		1: "MULTICHOICE",
+		2: "SHORT_ANSWER",
+		3: "MULTISELECT",
+		4: "TRUE_FALSE",
+		5: "NUMERIC",
+		6: "FILL_BLANKS",
+		7: "ORDERING",
+		8: "MATCHING",
 	}
 	QuestionType_value = map[string]int32{
 		"QUESTION_TYPE_UNSPECIFIED": 0,
 		"MULTICHOICE":               1,
+		"SHORT_ANSWER":              2,
+		"MULTISELECT":               3,
+		"TRUE_FALSE":                4,
+		"NUMERIC":                   5,
+		"FILL_BLANKS":               6,
+		"ORDERING":                  7,
+		"MATCHING":                  8,
 	}
 )
 
@@ -122,64 +143,217 @@ func (QuestionType) EnumDescriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{1}
 }
 
-type Answer int32
+type MatchMode int32
 
 const (
-	Answer_ANSWER_UNSPECIFIED Answer = 0
-	Answer_OPTION_A           Answer = 1
-	Answer_OPTION_B           Answer = 2
-	Answer_OPTION_C           Answer = 3
-	Answer_OPTION_D           Answer = 4
-	Answer_OPTION_E           Answer = 5
+	MatchMode_MATCH_MODE_UNSPECIFIED MatchMode = 0
+	MatchMode_MATCH_EXACT            MatchMode = 1
+	MatchMode_MATCH_CASE_INSENSITIVE MatchMode = 2
+	MatchMode_MATCH_REGEX            MatchMode = 3
 )
 
-// Enum value maps for Answer.
+// Enum value maps for MatchMode.
 var (
-	Answer_name = map[int32]string{
-		0: "ANSWER_UNSPECIFIED",
-		1: "OPTION_A",
-		2: "OPTION_B",
-		3: "OPTION_C",
-		4: "OPTION_D",
-		5: "OPTION_E",
-	}
-	Answer_value = map[string]int32{
-		"ANSWER_UNSPECIFIED": 0,
-		"OPTION_A":           1,
-		"OPTION_B":           2,
-		"OPTION_C":           3,
-		"OPTION_D":           4,
-		"OPTION_E":           5,
+	MatchMode_name = map[int32]string{
+		0: "MATCH_MODE_UNSPECIFIED",
+		1: "MATCH_EXACT",
+		2: "MATCH_CASE_INSENSITIVE",
+		3: "MATCH_REGEX",
+	}
+	MatchMode_value = map[string]int32{
+		"MATCH_MODE_UNSPECIFIED": 0,
+		"MATCH_EXACT":            1,
+		"MATCH_CASE_INSENSITIVE": 2,
+		"MATCH_REGEX":            3,
 	}
 )
 
-func (x Answer) Enum() *Answer {
-	p := new(Answer)
+func (x MatchMode) Enum() *MatchMode {
+	p := new(MatchMode)
 	*p = x
 	return p
 }
 
-func (x Answer) String() string {
+func (x MatchMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Answer) Descriptor() protoreflect.EnumDescriptor {
+func (MatchMode) Descriptor() protoreflect.EnumDescriptor {
 	return file_lp_proto_enumTypes[2].Descriptor()
 }
 
-func (Answer) Type() protoreflect.EnumType {
+func (MatchMode) Type() protoreflect.EnumType {
 	return &file_lp_proto_enumTypes[2]
 }
 
-func (x Answer) Number() protoreflect.EnumNumber {
+func (x MatchMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Answer.Descriptor instead.
-func (Answer) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use MatchMode.Descriptor instead.
+func (MatchMode) EnumDescriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{2}
 }
 
+type GradingMode int32
+
+const (
+	GradingMode_GRADING_MODE_UNSPECIFIED GradingMode = 0
+	GradingMode_ALL_OR_NOTHING           GradingMode = 1
+	GradingMode_PARTIAL_CREDIT           GradingMode = 2
+	GradingMode_PENALTY                  GradingMode = 3
+)
+
+// Enum value maps for GradingMode.
+var (
+	GradingMode_name = map[int32]string{
+		0: "GRADING_MODE_UNSPECIFIED",
+		1: "ALL_OR_NOTHING",
+		2: "PARTIAL_CREDIT",
+		3: "PENALTY",
+	}
+	GradingMode_value = map[string]int32{
+		"GRADING_MODE_UNSPECIFIED": 0,
+		"ALL_OR_NOTHING":           1,
+		"PARTIAL_CREDIT":           2,
+		"PENALTY":                  3,
+	}
+)
+
+func (x GradingMode) Enum() *GradingMode {
+	p := new(GradingMode)
+	*p = x
+	return p
+}
+
+func (x GradingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_lp_proto_enumTypes[3].Descriptor()
+}
+
+func (GradingMode) Type() protoreflect.EnumType {
+	return &file_lp_proto_enumTypes[3]
+}
+
+func (x GradingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradingMode.Descriptor instead.
+func (GradingMode) EnumDescriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{3}
+}
+
+type Difficulty int32
+
+const (
+	Difficulty_DIFFICULTY_UNSPECIFIED Difficulty = 0
+	Difficulty_EASY                   Difficulty = 1
+	Difficulty_MEDIUM                 Difficulty = 2
+	Difficulty_HARD                   Difficulty = 3
+)
+
+// Enum value maps for Difficulty.
+var (
+	Difficulty_name = map[int32]string{
+		0: "DIFFICULTY_UNSPECIFIED",
+		1: "EASY",
+		2: "MEDIUM",
+		3: "HARD",
+	}
+	Difficulty_value = map[string]int32{
+		"DIFFICULTY_UNSPECIFIED": 0,
+		"EASY":                   1,
+		"MEDIUM":                 2,
+		"HARD":                   3,
+	}
+)
+
+func (x Difficulty) Enum() *Difficulty {
+	p := new(Difficulty)
+	*p = x
+	return p
+}
+
+func (x Difficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Difficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_lp_proto_enumTypes[4].Descriptor()
+}
+
+func (Difficulty) Type() protoreflect.EnumType {
+	return &file_lp_proto_enumTypes[4]
+}
+
+func (x Difficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Difficulty.Descriptor instead.
+func (Difficulty) EnumDescriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{4}
+}
+
+type LessonStatus int32
+
+const (
+	LessonStatus_LESSON_STATUS_UNSPECIFIED LessonStatus = 0
+	LessonStatus_NOT_STARTED               LessonStatus = 1
+	LessonStatus_IN_PROGRESS               LessonStatus = 2
+	LessonStatus_PASSED                    LessonStatus = 3
+	LessonStatus_FAILED                    LessonStatus = 4
+)
+
+// Enum value maps for LessonStatus.
+var (
+	LessonStatus_name = map[int32]string{
+		0: "LESSON_STATUS_UNSPECIFIED",
+		1: "NOT_STARTED",
+		2: "IN_PROGRESS",
+		3: "PASSED",
+		4: "FAILED",
+	}
+	LessonStatus_value = map[string]int32{
+		"LESSON_STATUS_UNSPECIFIED": 0,
+		"NOT_STARTED":               1,
+		"IN_PROGRESS":               2,
+		"PASSED":                    3,
+		"FAILED":                    4,
+	}
+)
+
+func (x LessonStatus) Enum() *LessonStatus {
+	p := new(LessonStatus)
+	*p = x
+	return p
+}
+
+func (x LessonStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LessonStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lp_proto_enumTypes[5].Descriptor()
+}
+
+func (LessonStatus) Type() protoreflect.EnumType {
+	return &file_lp_proto_enumTypes[5]
+}
+
+func (x LessonStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LessonStatus.Descriptor instead.
+func (LessonStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{5}
+}
+
 type BasePage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -192,6 +366,7 @@ type BasePage struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Modified       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`
 	ContentType    ContentType            `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=lp.v1.ContentType" json:"content_type,omitempty"`
+	Position       int64                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"` // Zero-based position of the page within the lesson.
 }
 
 func (x *BasePage) Reset() {
@@ -275,14 +450,22 @@ func (x *BasePage) GetContentType() ContentType {
 	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
+func (x *BasePage) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type CreateBasePage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LessonId       int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
-	CreatedBy      int64 `protobuf:"varint,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	LastModifiedBy int64 `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`
+	LessonId       int64  `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	CreatedBy      int64  `protobuf:"varint,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	LastModifiedBy int64  `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`
+	Position       *int64 `protobuf:"varint,4,opt,name=position,proto3,oneof" json:"position,omitempty"` // Position to insert the page at, appends the page if unset.
 }
 
 func (x *CreateBasePage) Reset() {
@@ -338,6 +521,13 @@ func (x *CreateBasePage) GetLastModifiedBy() int64 {
 	return 0
 }
 
+func (x *CreateBasePage) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
 type UpdateBasePage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1606,22 +1796,19 @@ func (x *DeletePageResponse) GetSuccess() bool {
 	return false
 }
 
-type Channel struct {
+type ReorderPagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
-	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
-	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the channel was created.
-	Modified       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the channel was last modified.
+	LessonId       int64   `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // ID of the lesson whose pages are reordered.
+	OrderedIds     []int64 `protobuf:"varint,2,rep,packed,name=ordered_ids,json=orderedIds,proto3" json:"ordered_ids,omitempty"`        // IDs of all lesson pages in the new order.
+	Version        int64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                                       // Version of page order the order is based on, see Lesson.pages_version.
+	LastModifiedBy int64   `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who reorders the pages.
 }
 
-func (x *Channel) Reset() {
-	*x = Channel{}
+func (x *ReorderPagesRequest) Reset() {
+	*x = ReorderPagesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,13 +1816,13 @@ func (x *Channel) Reset() {
 	}
 }
 
-func (x *Channel) String() string {
+func (x *ReorderPagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Channel) ProtoMessage() {}
+func (*ReorderPagesRequest) ProtoMessage() {}
 
-func (x *Channel) ProtoReflect() protoreflect.Message {
+func (x *ReorderPagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1647,77 +1834,49 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
-func (*Channel) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderPagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPagesRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Channel) GetId() int64 {
+func (x *ReorderPagesRequest) GetLessonId() int64 {
 	if x != nil {
-		return x.Id
+		return x.LessonId
 	}
 	return 0
 }
 
-func (x *Channel) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Channel) GetDescription() string {
+func (x *ReorderPagesRequest) GetOrderedIds() []int64 {
 	if x != nil {
-		return x.Description
+		return x.OrderedIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Channel) GetCreatedBy() int64 {
+func (x *ReorderPagesRequest) GetVersion() int64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.Version
 	}
 	return 0
 }
 
-func (x *Channel) GetLastModifiedBy() int64 {
+func (x *ReorderPagesRequest) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-func (x *Channel) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Channel) GetModified() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Modified
-	}
-	return nil
-}
-
-type ChannelWithPlans struct {
+type ReorderPagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
-	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
-	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the channel was created.
-	Modified       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the channel was last modified.
-	Plans          []*Plan                `protobuf:"bytes,8,rep,name=plans,proto3" json:"plans,omitempty"`
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // New version of page order.
 }
 
-func (x *ChannelWithPlans) Reset() {
-	*x = ChannelWithPlans{}
+func (x *ReorderPagesResponse) Reset() {
+	*x = ReorderPagesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1725,13 +1884,13 @@ func (x *ChannelWithPlans) Reset() {
 	}
 }
 
-func (x *ChannelWithPlans) String() string {
+func (x *ReorderPagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelWithPlans) ProtoMessage() {}
+func (*ReorderPagesResponse) ProtoMessage() {}
 
-func (x *ChannelWithPlans) ProtoReflect() protoreflect.Message {
+func (x *ReorderPagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1743,80 +1902,31 @@ func (x *ChannelWithPlans) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelWithPlans.ProtoReflect.Descriptor instead.
-func (*ChannelWithPlans) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderPagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPagesResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ChannelWithPlans) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ChannelWithPlans) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ChannelWithPlans) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *ChannelWithPlans) GetCreatedBy() int64 {
+func (x *ReorderPagesResponse) GetVersion() int64 {
 	if x != nil {
-		return x.CreatedBy
-	}
-	return 0
-}
-
-func (x *ChannelWithPlans) GetLastModifiedBy() int64 {
-	if x != nil {
-		return x.LastModifiedBy
+		return x.Version
 	}
 	return 0
 }
 
-func (x *ChannelWithPlans) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *ChannelWithPlans) GetModified() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Modified
-	}
-	return nil
-}
-
-func (x *ChannelWithPlans) GetPlans() []*Plan {
-	if x != nil {
-		return x.Plans
-	}
-	return nil
-}
-
-type CreateChannelRequest struct {
+type MovePageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
-	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
-	CreatedBy      int64  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
-	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
+	PageId         int64  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                           // ID of the page to move.
+	TargetLessonId int64  `protobuf:"varint,2,opt,name=target_lesson_id,json=targetLessonId,proto3" json:"target_lesson_id,omitempty"` // ID of the lesson the page is moved to, may be its current lesson.
+	Position       *int64 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`                               // Zero-based position in the target lesson, the page goes last if not set.
+	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who moves the page.
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
+func (x *MovePageRequest) Reset() {
+	*x = MovePageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,13 +1934,13 @@ func (x *CreateChannelRequest) Reset() {
 	}
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *MovePageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*MovePageRequest) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *MovePageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,49 +1952,49 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MovePageRequest.ProtoReflect.Descriptor instead.
+func (*MovePageRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateChannelRequest) GetName() string {
+func (x *MovePageRequest) GetPageId() int64 {
 	if x != nil {
-		return x.Name
+		return x.PageId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateChannelRequest) GetDescription() string {
+func (x *MovePageRequest) GetTargetLessonId() int64 {
 	if x != nil {
-		return x.Description
+		return x.TargetLessonId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateChannelRequest) GetCreatedBy() int64 {
-	if x != nil {
-		return x.CreatedBy
+func (x *MovePageRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
 	}
 	return 0
 }
 
-func (x *CreateChannelRequest) GetLastModifiedBy() int64 {
+func (x *MovePageRequest) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-type CreateChannelResponse struct {
+type MovePageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new channel.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the page was successfully moved.
 }
 
-func (x *CreateChannelResponse) Reset() {
-	*x = CreateChannelResponse{}
+func (x *MovePageResponse) Reset() {
+	*x = MovePageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,13 +2002,13 @@ func (x *CreateChannelResponse) Reset() {
 	}
 }
 
-func (x *CreateChannelResponse) String() string {
+func (x *MovePageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelResponse) ProtoMessage() {}
+func (*MovePageResponse) ProtoMessage() {}
 
-func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
+func (x *MovePageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,28 +2020,34 @@ func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
-func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MovePageResponse.ProtoReflect.Descriptor instead.
+func (*MovePageResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateChannelResponse) GetId() int64 {
+func (x *MovePageResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GetChannelRequest struct {
+type Channel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the channel to retrieve.
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
+	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
+	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the channel was created.
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the channel was last modified.
 }
 
-func (x *GetChannelRequest) Reset() {
-	*x = GetChannelRequest{}
+func (x *Channel) Reset() {
+	*x = Channel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1939,13 +2055,13 @@ func (x *GetChannelRequest) Reset() {
 	}
 }
 
-func (x *GetChannelRequest) String() string {
+func (x *Channel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelRequest) ProtoMessage() {}
+func (*Channel) ProtoMessage() {}
 
-func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *Channel) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,28 +2073,77 @@ func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelRequest.ProtoReflect.Descriptor instead.
-func (*GetChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetChannelRequest) GetId() int64 {
+func (x *Channel) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetChannelResponse struct {
+func (x *Channel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Channel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Channel) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Channel) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *Channel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Channel) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+type ChannelWithPlans struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel *ChannelWithPlans `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // The retrieved channel.
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
+	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
+	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the channel was created.
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the channel was last modified.
+	Plans          []*Plan                `protobuf:"bytes,8,rep,name=plans,proto3" json:"plans,omitempty"`
 }
 
-func (x *GetChannelResponse) Reset() {
-	*x = GetChannelResponse{}
+func (x *ChannelWithPlans) Reset() {
+	*x = ChannelWithPlans{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1986,13 +2151,13 @@ func (x *GetChannelResponse) Reset() {
 	}
 }
 
-func (x *GetChannelResponse) String() string {
+func (x *ChannelWithPlans) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelResponse) ProtoMessage() {}
+func (*ChannelWithPlans) ProtoMessage() {}
 
-func (x *GetChannelResponse) ProtoReflect() protoreflect.Message {
+func (x *ChannelWithPlans) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2004,29 +2169,80 @@ func (x *GetChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelResponse.ProtoReflect.Descriptor instead.
-func (*GetChannelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelWithPlans.ProtoReflect.Descriptor instead.
+func (*ChannelWithPlans) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetChannelResponse) GetChannel() *ChannelWithPlans {
+func (x *ChannelWithPlans) GetId() int64 {
 	if x != nil {
-		return x.Channel
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChannelWithPlans) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelWithPlans) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChannelWithPlans) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ChannelWithPlans) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *ChannelWithPlans) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetChannelsRequest struct {
+func (x *ChannelWithPlans) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *ChannelWithPlans) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type CreateChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`   // Limit for pagination.
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Offset for pagination.
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the channel.
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the channel.
+	CreatedBy      int64  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the channel.
+	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
 }
 
-func (x *GetChannelsRequest) Reset() {
-	*x = GetChannelsRequest{}
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,13 +2250,13 @@ func (x *GetChannelsRequest) Reset() {
 	}
 }
 
-func (x *GetChannelsRequest) String() string {
+func (x *CreateChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelsRequest) ProtoMessage() {}
+func (*CreateChannelRequest) ProtoMessage() {}
 
-func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2052,35 +2268,49 @@ func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
-func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetChannelsRequest) GetLimit() int64 {
+func (x *CreateChannelRequest) GetName() string {
 	if x != nil {
-		return x.Limit
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *GetChannelsRequest) GetOffset() int64 {
+func (x *CreateChannelRequest) GetLastModifiedBy() int64 {
 	if x != nil {
-		return x.Offset
+		return x.LastModifiedBy
 	}
 	return 0
 }
 
-type GetChannelsResponse struct {
+type CreateChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"` // The retrieved list of channels.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new channel.
 }
 
-func (x *GetChannelsResponse) Reset() {
-	*x = GetChannelsResponse{}
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2088,13 +2318,13 @@ func (x *GetChannelsResponse) Reset() {
 	}
 }
 
-func (x *GetChannelsResponse) String() string {
+func (x *CreateChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelsResponse) ProtoMessage() {}
+func (*CreateChannelResponse) ProtoMessage() {}
 
-func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2106,31 +2336,28 @@ func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
-func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetChannelsResponse) GetChannels() []*Channel {
+func (x *CreateChannelResponse) GetId() int64 {
 	if x != nil {
-		return x.Channels
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UpdateChannelRequest struct {
+type GetChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                        // Name of the channel.
-	Description    *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`                          // Description of the channel.
-	LastModifiedBy int64   `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the channel to retrieve.
 }
 
-func (x *UpdateChannelRequest) Reset() {
-	*x = UpdateChannelRequest{}
+func (x *GetChannelRequest) Reset() {
+	*x = GetChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2138,13 +2365,13 @@ func (x *UpdateChannelRequest) Reset() {
 	}
 }
 
-func (x *UpdateChannelRequest) String() string {
+func (x *GetChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChannelRequest) ProtoMessage() {}
+func (*GetChannelRequest) ProtoMessage() {}
 
-func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2156,49 +2383,28 @@ func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChannelRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateChannelRequest) GetId() int64 {
+func (x *GetChannelRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateChannelRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateChannelRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateChannelRequest) GetLastModifiedBy() int64 {
-	if x != nil {
-		return x.LastModifiedBy
-	}
-	return 0
-}
-
-type UpdateChannelResponse struct {
+type GetChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated channel .
+	Channel *ChannelWithPlans `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // The retrieved channel.
 }
 
-func (x *UpdateChannelResponse) Reset() {
-	*x = UpdateChannelResponse{}
+func (x *GetChannelResponse) Reset() {
+	*x = GetChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2206,13 +2412,13 @@ func (x *UpdateChannelResponse) Reset() {
 	}
 }
 
-func (x *UpdateChannelResponse) String() string {
+func (x *GetChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChannelResponse) ProtoMessage() {}
+func (*GetChannelResponse) ProtoMessage() {}
 
-func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
+func (x *GetChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,28 +2430,29 @@ func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChannelResponse.ProtoReflect.Descriptor instead.
-func (*UpdateChannelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChannelResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateChannelResponse) GetId() int64 {
+func (x *GetChannelResponse) GetChannel() *ChannelWithPlans {
 	if x != nil {
-		return x.Id
+		return x.Channel
 	}
-	return 0
+	return nil
 }
 
-type DeleteChannelRequest struct {
+type GetChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the channel to delete.
+	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`   // Limit for pagination.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Offset for pagination.
 }
 
-func (x *DeleteChannelRequest) Reset() {
-	*x = DeleteChannelRequest{}
+func (x *GetChannelsRequest) Reset() {
+	*x = GetChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2253,13 +2460,13 @@ func (x *DeleteChannelRequest) Reset() {
 	}
 }
 
-func (x *DeleteChannelRequest) String() string {
+func (x *GetChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChannelRequest) ProtoMessage() {}
+func (*GetChannelsRequest) ProtoMessage() {}
 
-func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2271,28 +2478,35 @@ func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteChannelRequest) GetId() int64 {
+func (x *GetChannelsRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
 	return 0
 }
 
-type DeleteChannelResponse struct {
+func (x *GetChannelsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the channel was successfully deleted.
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"` // The retrieved list of channels.
 }
 
-func (x *DeleteChannelResponse) Reset() {
-	*x = DeleteChannelResponse{}
+func (x *GetChannelsResponse) Reset() {
+	*x = GetChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,13 +2514,13 @@ func (x *DeleteChannelResponse) Reset() {
 	}
 }
 
-func (x *DeleteChannelResponse) String() string {
+func (x *GetChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChannelResponse) ProtoMessage() {}
+func (*GetChannelsResponse) ProtoMessage() {}
 
-func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
+func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2318,36 +2532,31 @@ func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
-func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteChannelResponse) GetSuccess() bool {
+func (x *GetChannelsResponse) GetChannels() []*Channel {
 	if x != nil {
-		return x.Success
+		return x.Channels
 	}
-	return false
+	return nil
 }
 
-type Plan struct {
+type UpdateChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the plan.
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the plan.
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the plan.
-	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the plan.
-	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
-	IsPublished    bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`            //
-	Public         bool                   `protobuf:"varint,7,opt,name=public,proto3" json:"public,omitempty"`                                         //
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the plan was created.
-	Modified       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the plan was last modified.
+	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the channel.
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                        // Name of the channel.
+	Description    *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`                          // Description of the channel.
+	LastModifiedBy int64   `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the channel.
 }
 
-func (x *Plan) Reset() {
-	*x = Plan{}
+func (x *UpdateChannelRequest) Reset() {
+	*x = UpdateChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2355,13 +2564,13 @@ func (x *Plan) Reset() {
 	}
 }
 
-func (x *Plan) String() string {
+func (x *UpdateChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan) ProtoMessage() {}
+func (*UpdateChannelRequest) ProtoMessage() {}
 
-func (x *Plan) ProtoReflect() protoreflect.Message {
+func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2373,88 +2582,49 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
-func (*Plan) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Plan) GetId() int64 {
+func (x *UpdateChannelRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Plan) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *UpdateChannelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *Plan) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *UpdateChannelRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *Plan) GetCreatedBy() int64 {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return 0
-}
-
-func (x *Plan) GetLastModifiedBy() int64 {
+func (x *UpdateChannelRequest) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-func (x *Plan) GetIsPublished() bool {
-	if x != nil {
-		return x.IsPublished
-	}
-	return false
-}
-
-func (x *Plan) GetPublic() bool {
-	if x != nil {
-		return x.Public
-	}
-	return false
-}
-
-func (x *Plan) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Plan) GetModified() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Modified
-	}
-	return nil
-}
-
-type CreatePlanRequest struct {
+type UpdateChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the plan.
-	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the plan.
-	CreatedBy      int64  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the plan.
-	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
-	ChannelId      int64  `protobuf:"varint,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // Сhannel ID within which the plan is created.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated channel .
 }
 
-func (x *CreatePlanRequest) Reset() {
-	*x = CreatePlanRequest{}
+func (x *UpdateChannelResponse) Reset() {
+	*x = UpdateChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2462,13 +2632,13 @@ func (x *CreatePlanRequest) Reset() {
 	}
 }
 
-func (x *CreatePlanRequest) String() string {
+func (x *UpdateChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanRequest) ProtoMessage() {}
+func (*UpdateChannelResponse) ProtoMessage() {}
 
-func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2480,56 +2650,28 @@ func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
-func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChannelResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreatePlanRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreatePlanRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreatePlanRequest) GetCreatedBy() int64 {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return 0
-}
-
-func (x *CreatePlanRequest) GetLastModifiedBy() int64 {
-	if x != nil {
-		return x.LastModifiedBy
-	}
-	return 0
-}
-
-func (x *CreatePlanRequest) GetChannelId() int64 {
+func (x *UpdateChannelResponse) GetId() int64 {
 	if x != nil {
-		return x.ChannelId
+		return x.Id
 	}
 	return 0
 }
 
-type CreatePlanResponse struct {
+type DeleteChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new plan.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the channel to delete.
 }
 
-func (x *CreatePlanResponse) Reset() {
-	*x = CreatePlanResponse{}
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2537,13 +2679,13 @@ func (x *CreatePlanResponse) Reset() {
 	}
 }
 
-func (x *CreatePlanResponse) String() string {
+func (x *DeleteChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanResponse) ProtoMessage() {}
+func (*DeleteChannelRequest) ProtoMessage() {}
 
-func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2555,28 +2697,28 @@ func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanResponse.ProtoReflect.Descriptor instead.
-func (*CreatePlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreatePlanResponse) GetId() int64 {
+func (x *DeleteChannelRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetPlanRequest struct {
+type DeleteChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the plan to retrieve.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the channel was successfully deleted.
 }
 
-func (x *GetPlanRequest) Reset() {
-	*x = GetPlanRequest{}
+func (x *DeleteChannelResponse) Reset() {
+	*x = DeleteChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2584,13 +2726,13 @@ func (x *GetPlanRequest) Reset() {
 	}
 }
 
-func (x *GetPlanRequest) String() string {
+func (x *DeleteChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlanRequest) ProtoMessage() {}
+func (*DeleteChannelResponse) ProtoMessage() {}
 
-func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2602,28 +2744,28 @@ func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
-func (*GetPlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetPlanRequest) GetId() int64 {
+func (x *DeleteChannelResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GetPlanResponse struct {
+type GetPlanChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // The retrieved plan.
+	PlanId int64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // ID of the plan.
 }
 
-func (x *GetPlanResponse) Reset() {
-	*x = GetPlanResponse{}
+func (x *GetPlanChannelsRequest) Reset() {
+	*x = GetPlanChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2631,13 +2773,13 @@ func (x *GetPlanResponse) Reset() {
 	}
 }
 
-func (x *GetPlanResponse) String() string {
+func (x *GetPlanChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlanResponse) ProtoMessage() {}
+func (*GetPlanChannelsRequest) ProtoMessage() {}
 
-func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPlanChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2649,30 +2791,28 @@ func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlanResponse.ProtoReflect.Descriptor instead.
-func (*GetPlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlanChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetPlanResponse) GetPlan() *Plan {
+func (x *GetPlanChannelsRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.Plan
+		return x.PlanId
 	}
-	return nil
+	return 0
 }
 
-type GetPlansRequest struct {
+type GetPlanChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId int64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // ID of the channel that includes the plans.
-	Limit     int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                          // Limit for pagination.
-	Offset    int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                        // Offset for pagination.
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"` // Channels the plan is attached to.
 }
 
-func (x *GetPlansRequest) Reset() {
-	*x = GetPlansRequest{}
+func (x *GetPlanChannelsResponse) Reset() {
+	*x = GetPlanChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2680,13 +2820,13 @@ func (x *GetPlansRequest) Reset() {
 	}
 }
 
-func (x *GetPlansRequest) String() string {
+func (x *GetPlanChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlansRequest) ProtoMessage() {}
+func (*GetPlanChannelsResponse) ProtoMessage() {}
 
-func (x *GetPlansRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPlanChannelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2698,42 +2838,36 @@ func (x *GetPlansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlansRequest.ProtoReflect.Descriptor instead.
-func (*GetPlansRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlanChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanChannelsResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetPlansRequest) GetChannelId() int64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *GetPlansRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetPlansRequest) GetOffset() int64 {
+func (x *GetPlanChannelsResponse) GetChannels() []*Channel {
 	if x != nil {
-		return x.Offset
+		return x.Channels
 	}
-	return 0
+	return nil
 }
 
-type GetPlansResponse struct {
+type Plan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plans []*Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"` // The retrieved list of plan.
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the plan.
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the plan.
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the plan.
+	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the plan.
+	LastModifiedBy int64                  `protobuf:"varint,5,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
+	IsPublished    bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`            //
+	Public         bool                   `protobuf:"varint,7,opt,name=public,proto3" json:"public,omitempty"`                                         //
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the plan was created.
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the plan was last modified.
 }
 
-func (x *GetPlansResponse) Reset() {
-	*x = GetPlansResponse{}
+func (x *Plan) Reset() {
+	*x = Plan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2741,13 +2875,13 @@ func (x *GetPlansResponse) Reset() {
 	}
 }
 
-func (x *GetPlansResponse) String() string {
+func (x *Plan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlansResponse) ProtoMessage() {}
+func (*Plan) ProtoMessage() {}
 
-func (x *GetPlansResponse) ProtoReflect() protoreflect.Message {
+func (x *Plan) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2759,33 +2893,88 @@ func (x *GetPlansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlansResponse.ProtoReflect.Descriptor instead.
-func (*GetPlansResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetPlansResponse) GetPlans() []*Plan {
+func (x *Plan) GetId() int64 {
 	if x != nil {
-		return x.Plans
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Plan) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Plan) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *Plan) GetIsPublished() bool {
+	if x != nil {
+		return x.IsPublished
+	}
+	return false
+}
+
+func (x *Plan) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Plan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdatePlanRequest struct {
+func (x *Plan) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+type CreatePlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the plan.
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                        // Name of the plan.
-	Description    *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`                          // Description of the plan.
-	LastModifiedBy int64   `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
-	IsPublished    *bool   `protobuf:"varint,5,opt,name=is_published,json=isPublished,proto3,oneof" json:"is_published,omitempty"`      // Has the plan been published.
-	Public         *bool   `protobuf:"varint,6,opt,name=public,proto3,oneof" json:"public,omitempty"`                                   // Is the plan public.
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the plan.
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the plan.
+	CreatedBy      int64  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the plan.
+	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
+	ChannelId      int64  `protobuf:"varint,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // Сhannel ID within which the plan is created.
 }
 
-func (x *UpdatePlanRequest) Reset() {
-	*x = UpdatePlanRequest{}
+func (x *CreatePlanRequest) Reset() {
+	*x = CreatePlanRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2793,13 +2982,13 @@ func (x *UpdatePlanRequest) Reset() {
 	}
 }
 
-func (x *UpdatePlanRequest) String() string {
+func (x *CreatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanRequest) ProtoMessage() {}
+func (*CreatePlanRequest) ProtoMessage() {}
 
-func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2811,63 +3000,56 @@ func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdatePlanRequest) GetId() int64 {
+func (x *CreatePlanRequest) GetName() string {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdatePlanRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdatePlanRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *CreatePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdatePlanRequest) GetLastModifiedBy() int64 {
+func (x *CreatePlanRequest) GetCreatedBy() int64 {
 	if x != nil {
-		return x.LastModifiedBy
+		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *UpdatePlanRequest) GetIsPublished() bool {
-	if x != nil && x.IsPublished != nil {
-		return *x.IsPublished
+func (x *CreatePlanRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
 	}
-	return false
+	return 0
 }
 
-func (x *UpdatePlanRequest) GetPublic() bool {
-	if x != nil && x.Public != nil {
-		return *x.Public
+func (x *CreatePlanRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return false
+	return 0
 }
 
-type UpdatePlanResponse struct {
+type CreatePlanResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated plan.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new plan.
 }
 
-func (x *UpdatePlanResponse) Reset() {
-	*x = UpdatePlanResponse{}
+func (x *CreatePlanResponse) Reset() {
+	*x = CreatePlanResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2875,13 +3057,13 @@ func (x *UpdatePlanResponse) Reset() {
 	}
 }
 
-func (x *UpdatePlanResponse) String() string {
+func (x *CreatePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanResponse) ProtoMessage() {}
+func (*CreatePlanResponse) ProtoMessage() {}
 
-func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
+func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2893,28 +3075,28 @@ func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlanResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdatePlanResponse) GetId() int64 {
+func (x *CreatePlanResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeletePlanRequest struct {
+type GetPlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the plan to delete.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the plan to retrieve.
 }
 
-func (x *DeletePlanRequest) Reset() {
-	*x = DeletePlanRequest{}
+func (x *GetPlanRequest) Reset() {
+	*x = GetPlanRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2922,13 +3104,13 @@ func (x *DeletePlanRequest) Reset() {
 	}
 }
 
-func (x *DeletePlanRequest) String() string {
+func (x *GetPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanRequest) ProtoMessage() {}
+func (*GetPlanRequest) ProtoMessage() {}
 
-func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2940,28 +3122,28 @@ func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
-func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeletePlanRequest) GetId() int64 {
+func (x *GetPlanRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeletePlanResponse struct {
+type GetPlanResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the plan was successfully deleted.
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // The retrieved plan.
 }
 
-func (x *DeletePlanResponse) Reset() {
-	*x = DeletePlanResponse{}
+func (x *GetPlanResponse) Reset() {
+	*x = GetPlanResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2969,13 +3151,13 @@ func (x *DeletePlanResponse) Reset() {
 	}
 }
 
-func (x *DeletePlanResponse) String() string {
+func (x *GetPlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanResponse) ProtoMessage() {}
+func (*GetPlanResponse) ProtoMessage() {}
 
-func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2987,33 +3169,30 @@ func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
-func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeletePlanResponse) GetSuccess() bool {
+func (x *GetPlanResponse) GetPlan() *Plan {
 	if x != nil {
-		return x.Success
+		return x.Plan
 	}
-	return false
+	return nil
 }
 
-type Lesson struct {
+type GetPlansRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the lesson.
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the lesson.
-	CreatedBy      int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the lesson.
-	LastModifiedBy int64                  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the lesson.
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the lesson was created.
-	Modified       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the lesson was last modified.
+	ChannelId int64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // ID of the channel that includes the plans.
+	Limit     int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                          // Limit for pagination.
+	Offset    int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                        // Offset for pagination.
 }
 
-func (x *Lesson) Reset() {
-	*x = Lesson{}
+func (x *GetPlansRequest) Reset() {
+	*x = GetPlansRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3021,13 +3200,13 @@ func (x *Lesson) Reset() {
 	}
 }
 
-func (x *Lesson) String() string {
+func (x *GetPlansRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lesson) ProtoMessage() {}
+func (*GetPlansRequest) ProtoMessage() {}
 
-func (x *Lesson) ProtoReflect() protoreflect.Message {
+func (x *GetPlansRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3039,82 +3218,109 @@ func (x *Lesson) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
-func (*Lesson) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlansRequest.ProtoReflect.Descriptor instead.
+func (*GetPlansRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Lesson) GetId() int64 {
+func (x *GetPlansRequest) GetChannelId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *Lesson) GetName() string {
+func (x *GetPlansRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Lesson) GetCreatedBy() int64 {
+func (x *GetPlansRequest) GetOffset() int64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Lesson) GetLastModifiedBy() int64 {
-	if x != nil {
-		return x.LastModifiedBy
+type GetPlansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plans []*Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"` // The retrieved list of plan.
+}
+
+func (x *GetPlansResponse) Reset() {
+	*x = GetPlansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Lesson) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *GetPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlansResponse) ProtoMessage() {}
+
+func (x *GetPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Lesson) GetModified() *timestamppb.Timestamp {
+// Deprecated: Use GetPlansResponse.ProtoReflect.Descriptor instead.
+func (*GetPlansResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPlansResponse) GetPlans() []*Plan {
 	if x != nil {
-		return x.Modified
+		return x.Plans
 	}
 	return nil
 }
 
-type CreateLessonRequest struct {
+type UpdatePlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the lesson.
-	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the lesson.
-	CreatedBy      int64  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the lesson.
-	LastModifiedBy int64  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the lesson.
-	PlanId         int64  `protobuf:"varint,5,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // Plan ID within which the lesson is created.
+	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the plan.
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                        // Name of the plan.
+	Description    *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`                          // Description of the plan.
+	LastModifiedBy int64   `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the plan.
+	IsPublished    *bool   `protobuf:"varint,5,opt,name=is_published,json=isPublished,proto3,oneof" json:"is_published,omitempty"`      // Has the plan been published.
+	Public         *bool   `protobuf:"varint,6,opt,name=public,proto3,oneof" json:"public,omitempty"`                                   // Is the plan public.
 }
 
-func (x *CreateLessonRequest) Reset() {
-	*x = CreateLessonRequest{}
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[46]
+		mi := &file_lp_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateLessonRequest) String() string {
+func (x *UpdatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLessonRequest) ProtoMessage() {}
+func (*UpdatePlanRequest) ProtoMessage() {}
 
-func (x *CreateLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[46]
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3125,71 +3331,78 @@ func (x *CreateLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLessonRequest.ProtoReflect.Descriptor instead.
-func (*CreateLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateLessonRequest) GetName() string {
+func (x *UpdatePlanRequest) GetId() int64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateLessonRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *UpdatePlanRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CreateLessonRequest) GetCreatedBy() int64 {
-	if x != nil {
-		return x.CreatedBy
+func (x *UpdatePlanRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateLessonRequest) GetLastModifiedBy() int64 {
+func (x *UpdatePlanRequest) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-func (x *CreateLessonRequest) GetPlanId() int64 {
-	if x != nil {
-		return x.PlanId
+func (x *UpdatePlanRequest) GetIsPublished() bool {
+	if x != nil && x.IsPublished != nil {
+		return *x.IsPublished
 	}
-	return 0
+	return false
 }
 
-type CreateLessonResponse struct {
+func (x *UpdatePlanRequest) GetPublic() bool {
+	if x != nil && x.Public != nil {
+		return *x.Public
+	}
+	return false
+}
+
+type UpdatePlanResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new lesson.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated plan.
 }
 
-func (x *CreateLessonResponse) Reset() {
-	*x = CreateLessonResponse{}
+func (x *UpdatePlanResponse) Reset() {
+	*x = UpdatePlanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[47]
+		mi := &file_lp_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateLessonResponse) String() string {
+func (x *UpdatePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLessonResponse) ProtoMessage() {}
+func (*UpdatePlanResponse) ProtoMessage() {}
 
-func (x *CreateLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[47]
+func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3200,43 +3413,43 @@ func (x *CreateLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLessonResponse.ProtoReflect.Descriptor instead.
-func (*CreateLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateLessonResponse) GetId() int64 {
+func (x *UpdatePlanResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetLessonRequest struct {
+type DeletePlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson to retrieve.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the plan to delete.
 }
 
-func (x *GetLessonRequest) Reset() {
-	*x = GetLessonRequest{}
+func (x *DeletePlanRequest) Reset() {
+	*x = DeletePlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[48]
+		mi := &file_lp_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLessonRequest) String() string {
+func (x *DeletePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLessonRequest) ProtoMessage() {}
+func (*DeletePlanRequest) ProtoMessage() {}
 
-func (x *GetLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[48]
+func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3247,43 +3460,43 @@ func (x *GetLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLessonRequest.ProtoReflect.Descriptor instead.
-func (*GetLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetLessonRequest) GetId() int64 {
+func (x *DeletePlanRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetLessonResponse struct {
+type DeletePlanResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lesson *Lesson `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"` // The retrieved lesson.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the plan was successfully deleted.
 }
 
-func (x *GetLessonResponse) Reset() {
-	*x = GetLessonResponse{}
+func (x *DeletePlanResponse) Reset() {
+	*x = DeletePlanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[49]
+		mi := &file_lp_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLessonResponse) String() string {
+func (x *DeletePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLessonResponse) ProtoMessage() {}
+func (*DeletePlanResponse) ProtoMessage() {}
 
-func (x *GetLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[49]
+func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,45 +3507,45 @@ func (x *GetLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLessonResponse.ProtoReflect.Descriptor instead.
-func (*GetLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetLessonResponse) GetLesson() *Lesson {
+func (x *DeletePlanResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Lesson
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetLessonsRequest struct {
+type ReorderPlansRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlanId int64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // ID of the plan that includes the lesson.
-	Limit  int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                 // Limit for pagination.
-	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`               // Offset for pagination.
+	ChannelId      int64   `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // ID of the channel whose plans are reordered.
+	OrderedIds     []int64 `protobuf:"varint,2,rep,packed,name=ordered_ids,json=orderedIds,proto3" json:"ordered_ids,omitempty"`        // IDs of all channel plans in the new order.
+	LastModifiedBy int64   `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who reorders the plans.
 }
 
-func (x *GetLessonsRequest) Reset() {
-	*x = GetLessonsRequest{}
+func (x *ReorderPlansRequest) Reset() {
+	*x = ReorderPlansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[50]
+		mi := &file_lp_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLessonsRequest) String() string {
+func (x *ReorderPlansRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLessonsRequest) ProtoMessage() {}
+func (*ReorderPlansRequest) ProtoMessage() {}
 
-func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[50]
+func (x *ReorderPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3343,57 +3556,57 @@ func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLessonsRequest.ProtoReflect.Descriptor instead.
-func (*GetLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ReorderPlansRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPlansRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetLessonsRequest) GetPlanId() int64 {
+func (x *ReorderPlansRequest) GetChannelId() int64 {
 	if x != nil {
-		return x.PlanId
+		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *GetLessonsRequest) GetLimit() int64 {
+func (x *ReorderPlansRequest) GetOrderedIds() []int64 {
 	if x != nil {
-		return x.Limit
+		return x.OrderedIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetLessonsRequest) GetOffset() int64 {
+func (x *ReorderPlansRequest) GetLastModifiedBy() int64 {
 	if x != nil {
-		return x.Offset
+		return x.LastModifiedBy
 	}
 	return 0
 }
 
-type GetLessonsResponse struct {
+type ReorderPlansResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lessons []*Lesson `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"` // The retrieved list of lesson.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the plans were successfully reordered.
 }
 
-func (x *GetLessonsResponse) Reset() {
-	*x = GetLessonsResponse{}
+func (x *ReorderPlansResponse) Reset() {
+	*x = ReorderPlansResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[51]
+		mi := &file_lp_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLessonsResponse) String() string {
+func (x *ReorderPlansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLessonsResponse) ProtoMessage() {}
+func (*ReorderPlansResponse) ProtoMessage() {}
 
-func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[51]
+func (x *ReorderPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3404,45 +3617,45 @@ func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLessonsResponse.ProtoReflect.Descriptor instead.
-func (*GetLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ReorderPlansResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPlansResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetLessonsResponse) GetLessons() []*Lesson {
+func (x *ReorderPlansResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Lessons
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateLessonRequest struct {
+type AttachPlanToChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the lesson.
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                        // Name of the lesson.
-	LastModifiedBy int64   `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the lesson.
+	PlanId         int64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // ID of the existing plan.
+	ChannelId      int64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // ID of the channel the plan is added to.
+	LastModifiedBy int64 `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modifies the channel.
 }
 
-func (x *UpdateLessonRequest) Reset() {
-	*x = UpdateLessonRequest{}
+func (x *AttachPlanToChannelRequest) Reset() {
+	*x = AttachPlanToChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[52]
+		mi := &file_lp_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateLessonRequest) String() string {
+func (x *AttachPlanToChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLessonRequest) ProtoMessage() {}
+func (*AttachPlanToChannelRequest) ProtoMessage() {}
 
-func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[52]
+func (x *AttachPlanToChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3453,57 +3666,57 @@ func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLessonRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use AttachPlanToChannelRequest.ProtoReflect.Descriptor instead.
+func (*AttachPlanToChannelRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateLessonRequest) GetId() int64 {
+func (x *AttachPlanToChannelRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PlanId
 	}
 	return 0
 }
 
-func (x *UpdateLessonRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *AttachPlanToChannelRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLessonRequest) GetLastModifiedBy() int64 {
+func (x *AttachPlanToChannelRequest) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-type UpdateLessonResponse struct {
+type AttachPlanToChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated lesson.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the plan was successfully added to the channel.
 }
 
-func (x *UpdateLessonResponse) Reset() {
-	*x = UpdateLessonResponse{}
+func (x *AttachPlanToChannelResponse) Reset() {
+	*x = AttachPlanToChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[53]
+		mi := &file_lp_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateLessonResponse) String() string {
+func (x *AttachPlanToChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLessonResponse) ProtoMessage() {}
+func (*AttachPlanToChannelResponse) ProtoMessage() {}
 
-func (x *UpdateLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[53]
+func (x *AttachPlanToChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,43 +3727,45 @@ func (x *UpdateLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLessonResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use AttachPlanToChannelResponse.ProtoReflect.Descriptor instead.
+func (*AttachPlanToChannelResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateLessonResponse) GetId() int64 {
+func (x *AttachPlanToChannelResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type DeleteLessonRequest struct {
+type DetachPlanFromChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson to delete.
+	PlanId         int64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // ID of the plan.
+	ChannelId      int64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // ID of the channel the plan is removed from.
+	LastModifiedBy int64 `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modifies the channel.
 }
 
-func (x *DeleteLessonRequest) Reset() {
-	*x = DeleteLessonRequest{}
+func (x *DetachPlanFromChannelRequest) Reset() {
+	*x = DetachPlanFromChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[54]
+		mi := &file_lp_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLessonRequest) String() string {
+func (x *DetachPlanFromChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLessonRequest) ProtoMessage() {}
+func (*DetachPlanFromChannelRequest) ProtoMessage() {}
 
-func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[54]
+func (x *DetachPlanFromChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3561,43 +3776,57 @@ func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLessonRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use DetachPlanFromChannelRequest.ProtoReflect.Descriptor instead.
+func (*DetachPlanFromChannelRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DeleteLessonRequest) GetId() int64 {
+func (x *DetachPlanFromChannelRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PlanId
 	}
 	return 0
 }
 
-type DeleteLessonResponse struct {
+func (x *DetachPlanFromChannelRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *DetachPlanFromChannelRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+type DetachPlanFromChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lesson was successfully deleted.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the plan was successfully removed from the channel.
 }
 
-func (x *DeleteLessonResponse) Reset() {
-	*x = DeleteLessonResponse{}
+func (x *DetachPlanFromChannelResponse) Reset() {
+	*x = DetachPlanFromChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[55]
+		mi := &file_lp_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLessonResponse) String() string {
+func (x *DetachPlanFromChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLessonResponse) ProtoMessage() {}
+func (*DetachPlanFromChannelResponse) ProtoMessage() {}
 
-func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[55]
+func (x *DetachPlanFromChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3608,57 +3837,46 @@ func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLessonResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DetachPlanFromChannelResponse.ProtoReflect.Descriptor instead.
+func (*DetachPlanFromChannelResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteLessonResponse) GetSuccess() bool {
+func (x *DetachPlanFromChannelResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type QuestionPage struct {
+type ClonePlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // ID of the page.
-	LessonId       int64                  `protobuf:"varint,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                                     // Lesson ID within which the page is created.
-	CreatedBy      int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                  // User ID who creates the page.
-	LastModifiedBy int64                  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`                 // ID of the user who modified the page.
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                   // Timestamp when the page was created.
-	Modified       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`                                                      // Timestamp when the page was last modified.
-	ContentType    ContentType            `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=lp.v1.ContentType" json:"content_type,omitempty"`     // Сontent type of page
-	QuestionType   QuestionType           `protobuf:"varint,8,opt,name=question_type,json=questionType,proto3,enum=lp.v1.QuestionType" json:"question_type,omitempty"` // Question type for question
-	Question       string                 `protobuf:"bytes,9,opt,name=question,proto3" json:"question,omitempty"`                                                      // Question.
-	OptionA        string                 `protobuf:"bytes,10,opt,name=option_a,json=optionA,proto3" json:"option_a,omitempty"`                                        // Option answer.
-	OptionB        string                 `protobuf:"bytes,11,opt,name=option_b,json=optionB,proto3" json:"option_b,omitempty"`                                        // Option answer.
-	OptionC        string                 `protobuf:"bytes,12,opt,name=option_c,json=optionC,proto3" json:"option_c,omitempty"`                                        // Option answer.
-	OptionD        string                 `protobuf:"bytes,13,opt,name=option_d,json=optionD,proto3" json:"option_d,omitempty"`                                        // Option answer.
-	OptionE        string                 `protobuf:"bytes,14,opt,name=option_e,json=optionE,proto3" json:"option_e,omitempty"`                                        // Option answer.
-	Answer         string                 `protobuf:"bytes,15,opt,name=answer,proto3" json:"answer,omitempty"`                                                         // Answer for question.
+	PlanId    int64  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`          // ID of the plan to copy with its lessons, pages and questions.
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // Name of the copy.
+	ChannelId int64  `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // ID of the channel the copy is added to.
+	CreatedBy int64  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // User ID who copies the plan.
 }
 
-func (x *QuestionPage) Reset() {
-	*x = QuestionPage{}
+func (x *ClonePlanRequest) Reset() {
+	*x = ClonePlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[56]
+		mi := &file_lp_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuestionPage) String() string {
+func (x *ClonePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionPage) ProtoMessage() {}
+func (*ClonePlanRequest) ProtoMessage() {}
 
-func (x *QuestionPage) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[56]
+func (x *ClonePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3669,150 +3887,128 @@ func (x *QuestionPage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionPage.ProtoReflect.Descriptor instead.
-func (*QuestionPage) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ClonePlanRequest.ProtoReflect.Descriptor instead.
+func (*ClonePlanRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *QuestionPage) GetId() int64 {
+func (x *ClonePlanRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PlanId
 	}
 	return 0
 }
 
-func (x *QuestionPage) GetLessonId() int64 {
+func (x *ClonePlanRequest) GetName() string {
 	if x != nil {
-		return x.LessonId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *QuestionPage) GetCreatedBy() int64 {
+func (x *ClonePlanRequest) GetChannelId() int64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *QuestionPage) GetLastModifiedBy() int64 {
+func (x *ClonePlanRequest) GetCreatedBy() int64 {
 	if x != nil {
-		return x.LastModifiedBy
+		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *QuestionPage) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *QuestionPage) GetModified() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Modified
-	}
-	return nil
-}
-
-func (x *QuestionPage) GetContentType() ContentType {
-	if x != nil {
-		return x.ContentType
-	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
-}
-
-func (x *QuestionPage) GetQuestionType() QuestionType {
-	if x != nil {
-		return x.QuestionType
-	}
-	return QuestionType_QUESTION_TYPE_UNSPECIFIED
-}
+type ClonePlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *QuestionPage) GetQuestion() string {
-	if x != nil {
-		return x.Question
-	}
-	return ""
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the copy.
 }
 
-func (x *QuestionPage) GetOptionA() string {
-	if x != nil {
-		return x.OptionA
+func (x *ClonePlanResponse) Reset() {
+	*x = ClonePlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *QuestionPage) GetOptionB() string {
-	if x != nil {
-		return x.OptionB
-	}
-	return ""
+func (x *ClonePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QuestionPage) GetOptionC() string {
-	if x != nil {
-		return x.OptionC
-	}
-	return ""
-}
+func (*ClonePlanResponse) ProtoMessage() {}
 
-func (x *QuestionPage) GetOptionD() string {
-	if x != nil {
-		return x.OptionD
+func (x *ClonePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *QuestionPage) GetOptionE() string {
-	if x != nil {
-		return x.OptionE
-	}
-	return ""
+// Deprecated: Use ClonePlanResponse.ProtoReflect.Descriptor instead.
+func (*ClonePlanResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *QuestionPage) GetAnswer() string {
+func (x *ClonePlanResponse) GetId() int64 {
 	if x != nil {
-		return x.Answer
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type CreateQuestionPageRequest struct {
+type Lesson struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LessonId       int64   `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // Lesson ID within which the page is created.
-	CreatedBy      int64   `protobuf:"varint,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the lesson.
-	LastModifiedBy int64   `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the page.
-	Question       string  `protobuf:"bytes,6,opt,name=question,proto3" json:"question,omitempty"`                                      // Question.
-	OptionA        string  `protobuf:"bytes,7,opt,name=option_a,json=optionA,proto3" json:"option_a,omitempty"`                         // Option answer.
-	OptionB        string  `protobuf:"bytes,8,opt,name=option_b,json=optionB,proto3" json:"option_b,omitempty"`                         // Option answer.
-	OptionC        *string `protobuf:"bytes,9,opt,name=option_c,json=optionC,proto3,oneof" json:"option_c,omitempty"`                   // Option answer.
-	OptionD        *string `protobuf:"bytes,10,opt,name=option_d,json=optionD,proto3,oneof" json:"option_d,omitempty"`                  // Option answer.
-	OptionE        *string `protobuf:"bytes,11,opt,name=option_e,json=optionE,proto3,oneof" json:"option_e,omitempty"`                  // Option answer.
-	Answer         Answer  `protobuf:"varint,12,opt,name=answer,proto3,enum=lp.v1.Answer" json:"answer,omitempty"`                      // Answer for question.
+	Id                       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                // ID of the lesson.
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                             // Name of the lesson.
+	CreatedBy                int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                                 // User ID who creates the lesson.
+	LastModifiedBy           int64                  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`                                // ID of the user who modified the lesson.
+	CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                  // Timestamp when the lesson was created.
+	Modified                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`                                                                     // Timestamp when the lesson was last modified.
+	MaxAttempts              int64                  `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                           // Maximum number of attempts per user, 0 means unlimited.
+	AttemptCooldownSeconds   int64                  `protobuf:"varint,8,opt,name=attempt_cooldown_seconds,json=attemptCooldownSeconds,proto3" json:"attempt_cooldown_seconds,omitempty"`        // Seconds a user must wait between attempts, 0 means no cooldown.
+	TimeLimitSeconds         int64                  `protobuf:"varint,9,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`                          // Seconds given to complete an attempt, 0 means no time limit.
+	RequireAllPagesViewed    bool                   `protobuf:"varint,10,opt,name=require_all_pages_viewed,json=requireAllPagesViewed,proto3" json:"require_all_pages_viewed,omitempty"`        // Indicates if all content pages must be viewed to complete an attempt.
+	ShuffleQuestions         bool                   `protobuf:"varint,11,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`                           // Indicates if question pages are shown in random order in every attempt.
+	ShuffleOptions           bool                   `protobuf:"varint,12,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`                                 // Indicates if options of questions are shown in random order in every attempt.
+	PagesVersion             int64                  `protobuf:"varint,13,opt,name=pages_version,json=pagesVersion,proto3" json:"pages_version,omitempty"`                                       // Version of page order, changes whenever pages are added, removed or reordered.
+	Description              string                 `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`                                                              // Description of the lesson.
+	EstimatedDurationMinutes int64                  `protobuf:"varint,15,opt,name=estimated_duration_minutes,json=estimatedDurationMinutes,proto3" json:"estimated_duration_minutes,omitempty"` // Estimated time to complete the lesson in minutes, 0 means not set.
+	Difficulty               Difficulty             `protobuf:"varint,16,opt,name=difficulty,proto3,enum=lp.v1.Difficulty" json:"difficulty,omitempty"`                                         // Difficulty level of the lesson.
+	LearningObjectives       []string               `protobuf:"bytes,17,rep,name=learning_objectives,json=learningObjectives,proto3" json:"learning_objectives,omitempty"`                      // What learners achieve by completing the lesson.
+	CoverImageUrl            string                 `protobuf:"bytes,18,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`                                   // URL of the lesson cover image.
 }
 
-func (x *CreateQuestionPageRequest) Reset() {
-	*x = CreateQuestionPageRequest{}
+func (x *Lesson) Reset() {
+	*x = Lesson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[57]
+		mi := &file_lp_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQuestionPageRequest) String() string {
+func (x *Lesson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionPageRequest) ProtoMessage() {}
+func (*Lesson) ProtoMessage() {}
 
-func (x *CreateQuestionPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[57]
+func (x *Lesson) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3823,153 +4019,162 @@ func (x *CreateQuestionPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionPageRequest.ProtoReflect.Descriptor instead.
-func (*CreateQuestionPageRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
+func (*Lesson) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateQuestionPageRequest) GetLessonId() int64 {
+func (x *Lesson) GetId() int64 {
 	if x != nil {
-		return x.LessonId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetCreatedBy() int64 {
+func (x *Lesson) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Lesson) GetCreatedBy() int64 {
 	if x != nil {
 		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetLastModifiedBy() int64 {
+func (x *Lesson) GetLastModifiedBy() int64 {
 	if x != nil {
 		return x.LastModifiedBy
 	}
 	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetQuestion() string {
+func (x *Lesson) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Question
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateQuestionPageRequest) GetOptionA() string {
+func (x *Lesson) GetModified() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OptionA
+		return x.Modified
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateQuestionPageRequest) GetOptionB() string {
+func (x *Lesson) GetMaxAttempts() int64 {
 	if x != nil {
-		return x.OptionB
+		return x.MaxAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetOptionC() string {
-	if x != nil && x.OptionC != nil {
-		return *x.OptionC
+func (x *Lesson) GetAttemptCooldownSeconds() int64 {
+	if x != nil {
+		return x.AttemptCooldownSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetOptionD() string {
-	if x != nil && x.OptionD != nil {
-		return *x.OptionD
+func (x *Lesson) GetTimeLimitSeconds() int64 {
+	if x != nil {
+		return x.TimeLimitSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateQuestionPageRequest) GetOptionE() string {
-	if x != nil && x.OptionE != nil {
-		return *x.OptionE
+func (x *Lesson) GetRequireAllPagesViewed() bool {
+	if x != nil {
+		return x.RequireAllPagesViewed
 	}
-	return ""
+	return false
 }
 
-func (x *CreateQuestionPageRequest) GetAnswer() Answer {
+func (x *Lesson) GetShuffleQuestions() bool {
 	if x != nil {
-		return x.Answer
+		return x.ShuffleQuestions
 	}
-	return Answer_ANSWER_UNSPECIFIED
+	return false
 }
 
-type CreateQuestionPageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new question page.
+func (x *Lesson) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
 }
 
-func (x *CreateQuestionPageResponse) Reset() {
-	*x = CreateQuestionPageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[58]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Lesson) GetPagesVersion() int64 {
+	if x != nil {
+		return x.PagesVersion
 	}
+	return 0
 }
 
-func (x *CreateQuestionPageResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Lesson) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*CreateQuestionPageResponse) ProtoMessage() {}
+func (x *Lesson) GetEstimatedDurationMinutes() int64 {
+	if x != nil {
+		return x.EstimatedDurationMinutes
+	}
+	return 0
+}
 
-func (x *CreateQuestionPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[58]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Lesson) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
 	}
-	return mi.MessageOf(x)
+	return Difficulty_DIFFICULTY_UNSPECIFIED
 }
 
-// Deprecated: Use CreateQuestionPageResponse.ProtoReflect.Descriptor instead.
-func (*CreateQuestionPageResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{58}
+func (x *Lesson) GetLearningObjectives() []string {
+	if x != nil {
+		return x.LearningObjectives
+	}
+	return nil
 }
 
-func (x *CreateQuestionPageResponse) GetId() int64 {
+func (x *Lesson) GetCoverImageUrl() string {
 	if x != nil {
-		return x.Id
+		return x.CoverImageUrl
 	}
-	return 0
+	return ""
 }
 
-type GetQuestionPageRequest struct {
+type LearningObjectives struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the question page to retrieve.
+	Objectives []string `protobuf:"bytes,1,rep,name=objectives,proto3" json:"objectives,omitempty"` // What learners achieve by completing the lesson.
 }
 
-func (x *GetQuestionPageRequest) Reset() {
-	*x = GetQuestionPageRequest{}
+func (x *LearningObjectives) Reset() {
+	*x = LearningObjectives{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[59]
+		mi := &file_lp_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetQuestionPageRequest) String() string {
+func (x *LearningObjectives) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuestionPageRequest) ProtoMessage() {}
+func (*LearningObjectives) ProtoMessage() {}
 
-func (x *GetQuestionPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[59]
+func (x *LearningObjectives) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3980,43 +4185,57 @@ func (x *GetQuestionPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuestionPageRequest.ProtoReflect.Descriptor instead.
-func (*GetQuestionPageRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use LearningObjectives.ProtoReflect.Descriptor instead.
+func (*LearningObjectives) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetQuestionPageRequest) GetId() int64 {
+func (x *LearningObjectives) GetObjectives() []string {
 	if x != nil {
-		return x.Id
+		return x.Objectives
 	}
-	return 0
+	return nil
 }
 
-type GetQuestionPageResponse struct {
+type CreateLessonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionPage *QuestionPage `protobuf:"bytes,1,opt,name=question_page,json=questionPage,proto3" json:"question_page,omitempty"` // The retrieved question page.
+	Name                     string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                             // Name of the lesson.
+	Description              string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                               // Description of the lesson.
+	CreatedBy                int64      `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                                 // User ID who creates the lesson.
+	LastModifiedBy           int64      `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`                                // ID of the user who modified the lesson.
+	PlanId                   int64      `protobuf:"varint,5,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                                                          // Plan ID within which the lesson is created.
+	MaxAttempts              int64      `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                           // Maximum number of attempts per user, 0 means unlimited.
+	AttemptCooldownSeconds   int64      `protobuf:"varint,7,opt,name=attempt_cooldown_seconds,json=attemptCooldownSeconds,proto3" json:"attempt_cooldown_seconds,omitempty"`        // Seconds a user must wait between attempts, 0 means no cooldown.
+	TimeLimitSeconds         int64      `protobuf:"varint,8,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`                          // Seconds given to complete an attempt, 0 means no time limit.
+	RequireAllPagesViewed    bool       `protobuf:"varint,9,opt,name=require_all_pages_viewed,json=requireAllPagesViewed,proto3" json:"require_all_pages_viewed,omitempty"`         // Indicates if all content pages must be viewed to complete an attempt.
+	ShuffleQuestions         bool       `protobuf:"varint,10,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`                           // Indicates if question pages are shown in random order in every attempt.
+	ShuffleOptions           bool       `protobuf:"varint,11,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`                                 // Indicates if options of questions are shown in random order in every attempt.
+	EstimatedDurationMinutes int64      `protobuf:"varint,12,opt,name=estimated_duration_minutes,json=estimatedDurationMinutes,proto3" json:"estimated_duration_minutes,omitempty"` // Estimated time to complete the lesson in minutes, 0 means not set.
+	Difficulty               Difficulty `protobuf:"varint,13,opt,name=difficulty,proto3,enum=lp.v1.Difficulty" json:"difficulty,omitempty"`                                         // Difficulty level of the lesson.
+	LearningObjectives       []string   `protobuf:"bytes,14,rep,name=learning_objectives,json=learningObjectives,proto3" json:"learning_objectives,omitempty"`                      // What learners achieve by completing the lesson.
+	CoverImageUrl            string     `protobuf:"bytes,15,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`                                   // URL of the lesson cover image.
 }
 
-func (x *GetQuestionPageResponse) Reset() {
-	*x = GetQuestionPageResponse{}
+func (x *CreateLessonRequest) Reset() {
+	*x = CreateLessonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[60]
+		mi := &file_lp_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetQuestionPageResponse) String() string {
+func (x *CreateLessonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuestionPageResponse) ProtoMessage() {}
+func (*CreateLessonRequest) ProtoMessage() {}
 
-func (x *GetQuestionPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[60]
+func (x *CreateLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4027,139 +4246,126 @@ func (x *GetQuestionPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuestionPageResponse.ProtoReflect.Descriptor instead.
-func (*GetQuestionPageResponse) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CreateLessonRequest.ProtoReflect.Descriptor instead.
+func (*CreateLessonRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetQuestionPageResponse) GetQuestionPage() *QuestionPage {
+func (x *CreateLessonRequest) GetName() string {
 	if x != nil {
-		return x.QuestionPage
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpdateQuestionPageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the lesson.
-	LastModifiedBy int64   `protobuf:"varint,2,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the lesson.
-	Question       *string `protobuf:"bytes,3,opt,name=question,proto3,oneof" json:"question,omitempty"`
-	OptionA        *string `protobuf:"bytes,4,opt,name=option_a,json=optionA,proto3,oneof" json:"option_a,omitempty"`
-	OptionB        *string `protobuf:"bytes,5,opt,name=option_b,json=optionB,proto3,oneof" json:"option_b,omitempty"`
-	OptionC        *string `protobuf:"bytes,6,opt,name=option_c,json=optionC,proto3,oneof" json:"option_c,omitempty"`
-	OptionD        *string `protobuf:"bytes,7,opt,name=option_d,json=optionD,proto3,oneof" json:"option_d,omitempty"`
-	OptionE        *string `protobuf:"bytes,8,opt,name=option_e,json=optionE,proto3,oneof" json:"option_e,omitempty"`
-	Answer         *Answer `protobuf:"varint,9,opt,name=answer,proto3,enum=lp.v1.Answer,oneof" json:"answer,omitempty"`
+func (x *CreateLessonRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *UpdateQuestionPageRequest) Reset() {
-	*x = UpdateQuestionPageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lp_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateLessonRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
 	}
+	return 0
 }
 
-func (x *UpdateQuestionPageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateLessonRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
 }
 
-func (*UpdateQuestionPageRequest) ProtoMessage() {}
-
-func (x *UpdateQuestionPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lp_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateLessonRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateQuestionPageRequest.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionPageRequest) Descriptor() ([]byte, []int) {
-	return file_lp_proto_rawDescGZIP(), []int{61}
+func (x *CreateLessonRequest) GetMaxAttempts() int64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
 }
 
-func (x *UpdateQuestionPageRequest) GetId() int64 {
+func (x *CreateLessonRequest) GetAttemptCooldownSeconds() int64 {
 	if x != nil {
-		return x.Id
+		return x.AttemptCooldownSeconds
 	}
 	return 0
 }
 
-func (x *UpdateQuestionPageRequest) GetLastModifiedBy() int64 {
+func (x *CreateLessonRequest) GetTimeLimitSeconds() int64 {
 	if x != nil {
-		return x.LastModifiedBy
+		return x.TimeLimitSeconds
 	}
 	return 0
 }
 
-func (x *UpdateQuestionPageRequest) GetQuestion() string {
-	if x != nil && x.Question != nil {
-		return *x.Question
+func (x *CreateLessonRequest) GetRequireAllPagesViewed() bool {
+	if x != nil {
+		return x.RequireAllPagesViewed
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateQuestionPageRequest) GetOptionA() string {
-	if x != nil && x.OptionA != nil {
-		return *x.OptionA
+func (x *CreateLessonRequest) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateQuestionPageRequest) GetOptionB() string {
-	if x != nil && x.OptionB != nil {
-		return *x.OptionB
+func (x *CreateLessonRequest) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateQuestionPageRequest) GetOptionC() string {
-	if x != nil && x.OptionC != nil {
-		return *x.OptionC
+func (x *CreateLessonRequest) GetEstimatedDurationMinutes() int64 {
+	if x != nil {
+		return x.EstimatedDurationMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateQuestionPageRequest) GetOptionD() string {
-	if x != nil && x.OptionD != nil {
-		return *x.OptionD
+func (x *CreateLessonRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
 	}
-	return ""
+	return Difficulty_DIFFICULTY_UNSPECIFIED
 }
 
-func (x *UpdateQuestionPageRequest) GetOptionE() string {
-	if x != nil && x.OptionE != nil {
-		return *x.OptionE
+func (x *CreateLessonRequest) GetLearningObjectives() []string {
+	if x != nil {
+		return x.LearningObjectives
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateQuestionPageRequest) GetAnswer() Answer {
-	if x != nil && x.Answer != nil {
-		return *x.Answer
+func (x *CreateLessonRequest) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
 	}
-	return Answer_ANSWER_UNSPECIFIED
+	return ""
 }
 
-type UpdateQuestionPageResponse struct {
+type CreateLessonResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated pdf page.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new lesson.
 }
 
-func (x *UpdateQuestionPageResponse) Reset() {
-	*x = UpdateQuestionPageResponse{}
+func (x *CreateLessonResponse) Reset() {
+	*x = CreateLessonResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4167,13 +4373,13 @@ func (x *UpdateQuestionPageResponse) Reset() {
 	}
 }
 
-func (x *UpdateQuestionPageResponse) String() string {
+func (x *CreateLessonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuestionPageResponse) ProtoMessage() {}
+func (*CreateLessonResponse) ProtoMessage() {}
 
-func (x *UpdateQuestionPageResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateLessonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4185,31 +4391,28 @@ func (x *UpdateQuestionPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuestionPageResponse.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionPageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateLessonResponse.ProtoReflect.Descriptor instead.
+func (*CreateLessonResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateQuestionPageResponse) GetId() int64 {
+func (x *CreateLessonResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type CreateAttemptRequest struct {
+type GetLessonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LessonId  int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
-	PlanId    int64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	ChannelId int64 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	UserId    int64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson to retrieve.
 }
 
-func (x *CreateAttemptRequest) Reset() {
-	*x = CreateAttemptRequest{}
+func (x *GetLessonRequest) Reset() {
+	*x = GetLessonRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4217,13 +4420,13 @@ func (x *CreateAttemptRequest) Reset() {
 	}
 }
 
-func (x *CreateAttemptRequest) String() string {
+func (x *GetLessonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAttemptRequest) ProtoMessage() {}
+func (*GetLessonRequest) ProtoMessage() {}
 
-func (x *CreateAttemptRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLessonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4235,50 +4438,28 @@ func (x *CreateAttemptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAttemptRequest.ProtoReflect.Descriptor instead.
-func (*CreateAttemptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLessonRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonRequest) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *CreateAttemptRequest) GetLessonId() int64 {
-	if x != nil {
-		return x.LessonId
-	}
-	return 0
-}
-
-func (x *CreateAttemptRequest) GetPlanId() int64 {
-	if x != nil {
-		return x.PlanId
-	}
-	return 0
-}
-
-func (x *CreateAttemptRequest) GetChannelId() int64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *CreateAttemptRequest) GetUserId() int64 {
+func (x *GetLessonRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-type CreateAttemptResponse struct {
+type GetLessonResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created attempt.
-	Success bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Lesson *Lesson `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"` // The retrieved lesson.
 }
 
-func (x *CreateAttemptResponse) Reset() {
-	*x = CreateAttemptResponse{}
+func (x *GetLessonResponse) Reset() {
+	*x = GetLessonResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_lp_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4286,13 +4467,13 @@ func (x *CreateAttemptResponse) Reset() {
 	}
 }
 
-func (x *CreateAttemptResponse) String() string {
+func (x *GetLessonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAttemptResponse) ProtoMessage() {}
+func (*GetLessonResponse) ProtoMessage() {}
 
-func (x *CreateAttemptResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLessonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lp_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4304,841 +4485,8542 @@ func (x *CreateAttemptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAttemptResponse.ProtoReflect.Descriptor instead.
-func (*CreateAttemptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLessonResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonResponse) Descriptor() ([]byte, []int) {
 	return file_lp_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CreateAttemptResponse) GetId() int64 {
+func (x *GetLessonResponse) GetLesson() *Lesson {
 	if x != nil {
-		return x.Id
+		return x.Lesson
+	}
+	return nil
+}
+
+type GetLessonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId int64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // ID of the plan that includes the lesson.
+	Limit  int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                 // Limit for pagination.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`               // Offset for pagination.
+}
+
+func (x *GetLessonsRequest) Reset() {
+	*x = GetLessonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonsRequest) ProtoMessage() {}
+
+func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetLessonsRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
 	}
 	return 0
 }
 
-func (x *CreateAttemptResponse) GetSuccess() bool {
+func (x *GetLessonsRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Success
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-var File_lp_proto protoreflect.FileDescriptor
+func (x *GetLessonsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
 
-var file_lp_proto_rawDesc = []byte{
-	0x0a, 0x08, 0x6c, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6c, 0x70, 0x2e, 0x76,
-	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xaa, 0x02, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
-	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x76, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a,
-	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x42, 0x79, 0x22, 0x75, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65,
-	0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29,
-	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81,
-	0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x75, 0x0a, 0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a,
-	0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d,
-	0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01,
-	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x6b, 0x0a, 0x07, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x64, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x77,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x64,
-	0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08,
-	0x70, 0x64, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62,
-	0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x64, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x64, 0x66, 0x46, 0x69,
-	0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xc0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x37, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x07, 0x70, 0x64, 0x66, 0x50, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x64, 0x66, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x07, 0x70, 0x64, 0x66, 0x50, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x22, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
-	0x39, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x64,
-	0x66, 0x50, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x23, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c,
-	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x22, 0xb7, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68,
-	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x42, 0x79, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x73,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x41, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x22, 0xa9, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x42, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a,
-	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x22, 0xc3, 0x02, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28,
-	0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36,
-	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6c,
-	0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x87, 0x02,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c,
-	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a,
-	0x0c, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x88,
-	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x24, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xad, 0x01,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x26, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x06, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x6c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c,
-	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61,
-	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
-	0x22, 0x71, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0xa3, 0x04, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x35,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x12, 0x19, 0x0a, 0x08,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x81, 0x03, 0x0a, 0x19, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x88, 0x01, 0x01,
-	0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x88, 0x01, 0x01,
-	0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x88, 0x01, 0x01,
-	0x12, 0x25, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52,
-	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x22, 0x2c,
-	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x38, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x19,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x48, 0x06, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x1a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x2a, 0x58, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49, 0x44,
-	0x45, 0x4f, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x44, 0x46, 0x10, 0x03, 0x12, 0x0c, 0x0a,
-	0x08, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x3e, 0x0a, 0x0c, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x51,
-	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x55,
-	0x4c, 0x54, 0x49, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x06, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f,
-	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x45, 0x10, 0x05, 0x32, 0xb1, 0x0d, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x16,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x59, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x6c,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x6c, 0x70, 0x2e, 0x6c, 0x70,
-	0x2e, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type GetLessonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lessons []*Lesson `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"` // The retrieved list of lesson.
 }
 
-var (
-	file_lp_proto_rawDescOnce sync.Once
-	file_lp_proto_rawDescData = file_lp_proto_rawDesc
-)
+func (x *GetLessonsResponse) Reset() {
+	*x = GetLessonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_lp_proto_rawDescGZIP() []byte {
-	file_lp_proto_rawDescOnce.Do(func() {
-		file_lp_proto_rawDescData = protoimpl.X.CompressGZIP(file_lp_proto_rawDescData)
-	})
-	return file_lp_proto_rawDescData
+func (x *GetLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_lp_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_lp_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
-var file_lp_proto_goTypes = []any{
-	(ContentType)(0),                   // 0: lp.v1.ContentType
-	(QuestionType)(0),                  // 1: lp.v1.QuestionType
-	(Answer)(0),                        // 2: lp.v1.Answer
-	(*BasePage)(nil),                   // 3: lp.v1.BasePage
-	(*CreateBasePage)(nil),             // 4: lp.v1.CreateBasePage
-	(*UpdateBasePage)(nil),             // 5: lp.v1.UpdateBasePage
-	(*ImagePage)(nil),                  // 6: lp.v1.ImagePage
-	(*CreateImagePage)(nil),            // 7: lp.v1.CreateImagePage
-	(*UpdateImagePage)(nil),            // 8: lp.v1.UpdateImagePage
-	(*VideoPage)(nil),                  // 9: lp.v1.VideoPage
-	(*CreateVideoPage)(nil),            // 10: lp.v1.CreateVideoPage
-	(*UpdateVideoPage)(nil),            // 11: lp.v1.UpdateVideoPage
-	(*PDFPage)(nil),                    // 12: lp.v1.PDFPage
-	(*CreatePDFPage)(nil),              // 13: lp.v1.CreatePDFPage
-	(*UpdatePDFPage)(nil),              // 14: lp.v1.UpdatePDFPage
-	(*CreatePageRequest)(nil),          // 15: lp.v1.CreatePageRequest
-	(*CreatePageResponse)(nil),         // 16: lp.v1.CreatePageResponse
-	(*GetPageRequest)(nil),             // 17: lp.v1.GetPageRequest
-	(*GetPageResponse)(nil),            // 18: lp.v1.GetPageResponse
-	(*GetPagesRequest)(nil),            // 19: lp.v1.GetPagesRequest
-	(*GetPagesResponse)(nil),           // 20: lp.v1.GetPagesResponse
-	(*UpdatePageRequest)(nil),          // 21: lp.v1.UpdatePageRequest
-	(*UpdatePageResponse)(nil),         // 22: lp.v1.UpdatePageResponse
-	(*DeletePageRequest)(nil),          // 23: lp.v1.DeletePageRequest
-	(*DeletePageResponse)(nil),         // 24: lp.v1.DeletePageResponse
-	(*Channel)(nil),                    // 25: lp.v1.Channel
-	(*ChannelWithPlans)(nil),           // 26: lp.v1.ChannelWithPlans
-	(*CreateChannelRequest)(nil),       // 27: lp.v1.CreateChannelRequest
-	(*CreateChannelResponse)(nil),      // 28: lp.v1.CreateChannelResponse
-	(*GetChannelRequest)(nil),          // 29: lp.v1.GetChannelRequest
-	(*GetChannelResponse)(nil),         // 30: lp.v1.GetChannelResponse
-	(*GetChannelsRequest)(nil),         // 31: lp.v1.GetChannelsRequest
-	(*GetChannelsResponse)(nil),        // 32: lp.v1.GetChannelsResponse
-	(*UpdateChannelRequest)(nil),       // 33: lp.v1.UpdateChannelRequest
-	(*UpdateChannelResponse)(nil),      // 34: lp.v1.UpdateChannelResponse
-	(*DeleteChannelRequest)(nil),       // 35: lp.v1.DeleteChannelRequest
-	(*DeleteChannelResponse)(nil),      // 36: lp.v1.DeleteChannelResponse
-	(*Plan)(nil),                       // 37: lp.v1.Plan
-	(*CreatePlanRequest)(nil),          // 38: lp.v1.CreatePlanRequest
-	(*CreatePlanResponse)(nil),         // 39: lp.v1.CreatePlanResponse
-	(*GetPlanRequest)(nil),             // 40: lp.v1.GetPlanRequest
-	(*GetPlanResponse)(nil),            // 41: lp.v1.GetPlanResponse
-	(*GetPlansRequest)(nil),            // 42: lp.v1.GetPlansRequest
-	(*GetPlansResponse)(nil),           // 43: lp.v1.GetPlansResponse
-	(*UpdatePlanRequest)(nil),          // 44: lp.v1.UpdatePlanRequest
-	(*UpdatePlanResponse)(nil),         // 45: lp.v1.UpdatePlanResponse
-	(*DeletePlanRequest)(nil),          // 46: lp.v1.DeletePlanRequest
-	(*DeletePlanResponse)(nil),         // 47: lp.v1.DeletePlanResponse
-	(*Lesson)(nil),                     // 48: lp.v1.Lesson
-	(*CreateLessonRequest)(nil),        // 49: lp.v1.CreateLessonRequest
-	(*CreateLessonResponse)(nil),       // 50: lp.v1.CreateLessonResponse
-	(*GetLessonRequest)(nil),           // 51: lp.v1.GetLessonRequest
-	(*GetLessonResponse)(nil),          // 52: lp.v1.GetLessonResponse
-	(*GetLessonsRequest)(nil),          // 53: lp.v1.GetLessonsRequest
-	(*GetLessonsResponse)(nil),         // 54: lp.v1.GetLessonsResponse
-	(*UpdateLessonRequest)(nil),        // 55: lp.v1.UpdateLessonRequest
-	(*UpdateLessonResponse)(nil),       // 56: lp.v1.UpdateLessonResponse
-	(*DeleteLessonRequest)(nil),        // 57: lp.v1.DeleteLessonRequest
-	(*DeleteLessonResponse)(nil),       // 58: lp.v1.DeleteLessonResponse
-	(*QuestionPage)(nil),               // 59: lp.v1.QuestionPage
-	(*CreateQuestionPageRequest)(nil),  // 60: lp.v1.CreateQuestionPageRequest
-	(*CreateQuestionPageResponse)(nil), // 61: lp.v1.CreateQuestionPageResponse
-	(*GetQuestionPageRequest)(nil),     // 62: lp.v1.GetQuestionPageRequest
-	(*GetQuestionPageResponse)(nil),    // 63: lp.v1.GetQuestionPageResponse
-	(*UpdateQuestionPageRequest)(nil),  // 64: lp.v1.UpdateQuestionPageRequest
-	(*UpdateQuestionPageResponse)(nil), // 65: lp.v1.UpdateQuestionPageResponse
-	(*CreateAttemptRequest)(nil),       // 66: lp.v1.CreateAttemptRequest
-	(*CreateAttemptResponse)(nil),      // 67: lp.v1.CreateAttemptResponse
-	(*timestamppb.Timestamp)(nil),      // 68: google.protobuf.Timestamp
+func (*GetLessonsResponse) ProtoMessage() {}
+
+func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_lp_proto_depIdxs = []int32{
-	68, // 0: lp.v1.BasePage.created_at:type_name -> google.protobuf.Timestamp
-	68, // 1: lp.v1.BasePage.modified:type_name -> google.protobuf.Timestamp
-	0,  // 2: lp.v1.BasePage.content_type:type_name -> lp.v1.ContentType
-	3,  // 3: lp.v1.ImagePage.base:type_name -> lp.v1.BasePage
-	4,  // 4: lp.v1.CreateImagePage.base:type_name -> lp.v1.CreateBasePage
-	5,  // 5: lp.v1.UpdateImagePage.base:type_name -> lp.v1.UpdateBasePage
-	3,  // 6: lp.v1.VideoPage.base:type_name -> lp.v1.BasePage
-	4,  // 7: lp.v1.CreateVideoPage.base:type_name -> lp.v1.CreateBasePage
-	5,  // 8: lp.v1.UpdateVideoPage.base:type_name -> lp.v1.UpdateBasePage
-	3,  // 9: lp.v1.PDFPage.base:type_name -> lp.v1.BasePage
-	4,  // 10: lp.v1.CreatePDFPage.base:type_name -> lp.v1.CreateBasePage
-	5,  // 11: lp.v1.UpdatePDFPage.base:type_name -> lp.v1.UpdateBasePage
-	7,  // 12: lp.v1.CreatePageRequest.image_page:type_name -> lp.v1.CreateImagePage
-	10, // 13: lp.v1.CreatePageRequest.video_page:type_name -> lp.v1.CreateVideoPage
-	13, // 14: lp.v1.CreatePageRequest.pdf_page:type_name -> lp.v1.CreatePDFPage
-	0,  // 15: lp.v1.GetPageRequest.content_type:type_name -> lp.v1.ContentType
-	6,  // 16: lp.v1.GetPageResponse.image_page:type_name -> lp.v1.ImagePage
-	9,  // 17: lp.v1.GetPageResponse.video_page:type_name -> lp.v1.VideoPage
-	12, // 18: lp.v1.GetPageResponse.pdf_page:type_name -> lp.v1.PDFPage
-	3,  // 19: lp.v1.GetPagesResponse.pages:type_name -> lp.v1.BasePage
-	8,  // 20: lp.v1.UpdatePageRequest.image_page:type_name -> lp.v1.UpdateImagePage
-	11, // 21: lp.v1.UpdatePageRequest.video_page:type_name -> lp.v1.UpdateVideoPage
-	14, // 22: lp.v1.UpdatePageRequest.pdf_page:type_name -> lp.v1.UpdatePDFPage
-	68, // 23: lp.v1.Channel.created_at:type_name -> google.protobuf.Timestamp
-	68, // 24: lp.v1.Channel.modified:type_name -> google.protobuf.Timestamp
-	68, // 25: lp.v1.ChannelWithPlans.created_at:type_name -> google.protobuf.Timestamp
-	68, // 26: lp.v1.ChannelWithPlans.modified:type_name -> google.protobuf.Timestamp
-	37, // 27: lp.v1.ChannelWithPlans.plans:type_name -> lp.v1.Plan
-	26, // 28: lp.v1.GetChannelResponse.channel:type_name -> lp.v1.ChannelWithPlans
-	25, // 29: lp.v1.GetChannelsResponse.channels:type_name -> lp.v1.Channel
-	68, // 30: lp.v1.Plan.created_at:type_name -> google.protobuf.Timestamp
-	68, // 31: lp.v1.Plan.modified:type_name -> google.protobuf.Timestamp
-	37, // 32: lp.v1.GetPlanResponse.plan:type_name -> lp.v1.Plan
-	37, // 33: lp.v1.GetPlansResponse.plans:type_name -> lp.v1.Plan
-	68, // 34: lp.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
-	68, // 35: lp.v1.Lesson.modified:type_name -> google.protobuf.Timestamp
-	48, // 36: lp.v1.GetLessonResponse.lesson:type_name -> lp.v1.Lesson
-	48, // 37: lp.v1.GetLessonsResponse.lessons:type_name -> lp.v1.Lesson
-	68, // 38: lp.v1.QuestionPage.created_at:type_name -> google.protobuf.Timestamp
-	68, // 39: lp.v1.QuestionPage.modified:type_name -> google.protobuf.Timestamp
-	0,  // 40: lp.v1.QuestionPage.content_type:type_name -> lp.v1.ContentType
-	1,  // 41: lp.v1.QuestionPage.question_type:type_name -> lp.v1.QuestionType
-	2,  // 42: lp.v1.CreateQuestionPageRequest.answer:type_name -> lp.v1.Answer
-	59, // 43: lp.v1.GetQuestionPageResponse.question_page:type_name -> lp.v1.QuestionPage
-	2,  // 44: lp.v1.UpdateQuestionPageRequest.answer:type_name -> lp.v1.Answer
-	27, // 45: lp.v1.LearningPlatform.CreateChannel:input_type -> lp.v1.CreateChannelRequest
-	29, // 46: lp.v1.LearningPlatform.GetChannel:input_type -> lp.v1.GetChannelRequest
-	31, // 47: lp.v1.LearningPlatform.GetChannels:input_type -> lp.v1.GetChannelsRequest
-	33, // 48: lp.v1.LearningPlatform.UpdateChannel:input_type -> lp.v1.UpdateChannelRequest
-	35, // 49: lp.v1.LearningPlatform.DeleteChannel:input_type -> lp.v1.DeleteChannelRequest
-	38, // 50: lp.v1.LearningPlatform.CreatePlan:input_type -> lp.v1.CreatePlanRequest
-	40, // 51: lp.v1.LearningPlatform.GetPlan:input_type -> lp.v1.GetPlanRequest
-	42, // 52: lp.v1.LearningPlatform.GetPlans:input_type -> lp.v1.GetPlansRequest
-	44, // 53: lp.v1.LearningPlatform.UpdatePlan:input_type -> lp.v1.UpdatePlanRequest
-	46, // 54: lp.v1.LearningPlatform.DeletePlan:input_type -> lp.v1.DeletePlanRequest
-	49, // 55: lp.v1.LearningPlatform.CreateLesson:input_type -> lp.v1.CreateLessonRequest
-	51, // 56: lp.v1.LearningPlatform.GetLesson:input_type -> lp.v1.GetLessonRequest
-	53, // 57: lp.v1.LearningPlatform.GetLessons:input_type -> lp.v1.GetLessonsRequest
-	55, // 58: lp.v1.LearningPlatform.UpdateLesson:input_type -> lp.v1.UpdateLessonRequest
-	57, // 59: lp.v1.LearningPlatform.DeleteLesson:input_type -> lp.v1.DeleteLessonRequest
-	15, // 60: lp.v1.LearningPlatform.CreatePage:input_type -> lp.v1.CreatePageRequest
-	17, // 61: lp.v1.LearningPlatform.GetPage:input_type -> lp.v1.GetPageRequest
-	19, // 62: lp.v1.LearningPlatform.GetPages:input_type -> lp.v1.GetPagesRequest
-	21, // 63: lp.v1.LearningPlatform.UpdatePage:input_type -> lp.v1.UpdatePageRequest
-	23, // 64: lp.v1.LearningPlatform.DeletePage:input_type -> lp.v1.DeletePageRequest
-	60, // 65: lp.v1.LearningPlatform.CreateQuestionPage:input_type -> lp.v1.CreateQuestionPageRequest
-	62, // 66: lp.v1.LearningPlatform.GetQuestionPage:input_type -> lp.v1.GetQuestionPageRequest
-	64, // 67: lp.v1.LearningPlatform.UpdateQuestionPage:input_type -> lp.v1.UpdateQuestionPageRequest
-	66, // 68: lp.v1.LearningPlatform.CreateAttempt:input_type -> lp.v1.CreateAttemptRequest
-	28, // 69: lp.v1.LearningPlatform.CreateChannel:output_type -> lp.v1.CreateChannelResponse
-	30, // 70: lp.v1.LearningPlatform.GetChannel:output_type -> lp.v1.GetChannelResponse
-	32, // 71: lp.v1.LearningPlatform.GetChannels:output_type -> lp.v1.GetChannelsResponse
-	34, // 72: lp.v1.LearningPlatform.UpdateChannel:output_type -> lp.v1.UpdateChannelResponse
-	36, // 73: lp.v1.LearningPlatform.DeleteChannel:output_type -> lp.v1.DeleteChannelResponse
-	39, // 74: lp.v1.LearningPlatform.CreatePlan:output_type -> lp.v1.CreatePlanResponse
-	41, // 75: lp.v1.LearningPlatform.GetPlan:output_type -> lp.v1.GetPlanResponse
-	43, // 76: lp.v1.LearningPlatform.GetPlans:output_type -> lp.v1.GetPlansResponse
-	45, // 77: lp.v1.LearningPlatform.UpdatePlan:output_type -> lp.v1.UpdatePlanResponse
-	47, // 78: lp.v1.LearningPlatform.DeletePlan:output_type -> lp.v1.DeletePlanResponse
-	50, // 79: lp.v1.LearningPlatform.CreateLesson:output_type -> lp.v1.CreateLessonResponse
-	52, // 80: lp.v1.LearningPlatform.GetLesson:output_type -> lp.v1.GetLessonResponse
-	54, // 81: lp.v1.LearningPlatform.GetLessons:output_type -> lp.v1.GetLessonsResponse
-	56, // 82: lp.v1.LearningPlatform.UpdateLesson:output_type -> lp.v1.UpdateLessonResponse
-	58, // 83: lp.v1.LearningPlatform.DeleteLesson:output_type -> lp.v1.DeleteLessonResponse
-	16, // 84: lp.v1.LearningPlatform.CreatePage:output_type -> lp.v1.CreatePageResponse
-	18, // 85: lp.v1.LearningPlatform.GetPage:output_type -> lp.v1.GetPageResponse
-	20, // 86: lp.v1.LearningPlatform.GetPages:output_type -> lp.v1.GetPagesResponse
-	22, // 87: lp.v1.LearningPlatform.UpdatePage:output_type -> lp.v1.UpdatePageResponse
-	24, // 88: lp.v1.LearningPlatform.DeletePage:output_type -> lp.v1.DeletePageResponse
-	61, // 89: lp.v1.LearningPlatform.CreateQuestionPage:output_type -> lp.v1.CreateQuestionPageResponse
-	63, // 90: lp.v1.LearningPlatform.GetQuestionPage:output_type -> lp.v1.GetQuestionPageResponse
-	65, // 91: lp.v1.LearningPlatform.UpdateQuestionPage:output_type -> lp.v1.UpdateQuestionPageResponse
-	67, // 92: lp.v1.LearningPlatform.CreateAttempt:output_type -> lp.v1.CreateAttemptResponse
-	69, // [69:93] is the sub-list for method output_type
-	45, // [45:69] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+
+// Deprecated: Use GetLessonsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{66}
 }
 
-func init() { file_lp_proto_init() }
-func file_lp_proto_init() {
-	if File_lp_proto != nil {
-		return
+func (x *GetLessonsResponse) GetLessons() []*Lesson {
+	if x != nil {
+		return x.Lessons
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_lp_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*BasePage); i {
+	return nil
+}
+
+type UpdateLessonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                      // ID of the lesson.
+	Name                     *string             `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                                             // Name of the lesson.
+	LastModifiedBy           int64               `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`                                      // ID of the user who modified the lesson.
+	MaxAttempts              *int64              `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3,oneof" json:"max_attempts,omitempty"`                                           // Maximum number of attempts per user, 0 means unlimited.
+	AttemptCooldownSeconds   *int64              `protobuf:"varint,5,opt,name=attempt_cooldown_seconds,json=attemptCooldownSeconds,proto3,oneof" json:"attempt_cooldown_seconds,omitempty"`        // Seconds a user must wait between attempts, 0 means no cooldown.
+	TimeLimitSeconds         *int64              `protobuf:"varint,6,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3,oneof" json:"time_limit_seconds,omitempty"`                          // Seconds given to complete an attempt, 0 means no time limit.
+	RequireAllPagesViewed    *bool               `protobuf:"varint,7,opt,name=require_all_pages_viewed,json=requireAllPagesViewed,proto3,oneof" json:"require_all_pages_viewed,omitempty"`         // Indicates if all content pages must be viewed to complete an attempt.
+	ShuffleQuestions         *bool               `protobuf:"varint,8,opt,name=shuffle_questions,json=shuffleQuestions,proto3,oneof" json:"shuffle_questions,omitempty"`                            // Indicates if question pages are shown in random order in every attempt.
+	ShuffleOptions           *bool               `protobuf:"varint,9,opt,name=shuffle_options,json=shuffleOptions,proto3,oneof" json:"shuffle_options,omitempty"`                                  // Indicates if options of questions are shown in random order in every attempt.
+	Description              *string             `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`                                                              // Description of the lesson.
+	EstimatedDurationMinutes *int64              `protobuf:"varint,11,opt,name=estimated_duration_minutes,json=estimatedDurationMinutes,proto3,oneof" json:"estimated_duration_minutes,omitempty"` // Estimated time to complete the lesson in minutes, 0 means not set.
+	Difficulty               *Difficulty         `protobuf:"varint,12,opt,name=difficulty,proto3,enum=lp.v1.Difficulty,oneof" json:"difficulty,omitempty"`                                         // Difficulty level of the lesson, DIFFICULTY_UNSPECIFIED clears it.
+	LearningObjectives       *LearningObjectives `protobuf:"bytes,13,opt,name=learning_objectives,json=learningObjectives,proto3" json:"learning_objectives,omitempty"`                            // Replaces learning objectives of the lesson when set.
+	CoverImageUrl            *string             `protobuf:"bytes,14,opt,name=cover_image_url,json=coverImageUrl,proto3,oneof" json:"cover_image_url,omitempty"`                                   // URL of the lesson cover image, empty string clears it.
+}
+
+func (x *UpdateLessonRequest) Reset() {
+	*x = UpdateLessonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLessonRequest) ProtoMessage() {}
+
+func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLessonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLessonRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateLessonRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLessonRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetMaxAttempts() int64 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetAttemptCooldownSeconds() int64 {
+	if x != nil && x.AttemptCooldownSeconds != nil {
+		return *x.AttemptCooldownSeconds
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetTimeLimitSeconds() int64 {
+	if x != nil && x.TimeLimitSeconds != nil {
+		return *x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetRequireAllPagesViewed() bool {
+	if x != nil && x.RequireAllPagesViewed != nil {
+		return *x.RequireAllPagesViewed
+	}
+	return false
+}
+
+func (x *UpdateLessonRequest) GetShuffleQuestions() bool {
+	if x != nil && x.ShuffleQuestions != nil {
+		return *x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *UpdateLessonRequest) GetShuffleOptions() bool {
+	if x != nil && x.ShuffleOptions != nil {
+		return *x.ShuffleOptions
+	}
+	return false
+}
+
+func (x *UpdateLessonRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLessonRequest) GetEstimatedDurationMinutes() int64 {
+	if x != nil && x.EstimatedDurationMinutes != nil {
+		return *x.EstimatedDurationMinutes
+	}
+	return 0
+}
+
+func (x *UpdateLessonRequest) GetDifficulty() Difficulty {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *UpdateLessonRequest) GetLearningObjectives() *LearningObjectives {
+	if x != nil {
+		return x.LearningObjectives
+	}
+	return nil
+}
+
+func (x *UpdateLessonRequest) GetCoverImageUrl() string {
+	if x != nil && x.CoverImageUrl != nil {
+		return *x.CoverImageUrl
+	}
+	return ""
+}
+
+type UpdateLessonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated lesson.
+}
+
+func (x *UpdateLessonResponse) Reset() {
+	*x = UpdateLessonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLessonResponse) ProtoMessage() {}
+
+func (x *UpdateLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLessonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLessonResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateLessonResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLessonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson to delete.
+}
+
+func (x *DeleteLessonRequest) Reset() {
+	*x = DeleteLessonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonRequest) ProtoMessage() {}
+
+func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLessonRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteLessonRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLessonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lesson was successfully deleted.
+}
+
+func (x *DeleteLessonResponse) Reset() {
+	*x = DeleteLessonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLessonResponse) ProtoMessage() {}
+
+func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLessonResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLessonResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteLessonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReorderLessonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId         int64   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // ID of the plan whose lessons are reordered.
+	OrderedIds     []int64 `protobuf:"varint,2,rep,packed,name=ordered_ids,json=orderedIds,proto3" json:"ordered_ids,omitempty"`        // IDs of all plan lessons in the new order.
+	LastModifiedBy int64   `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who reorders the lessons.
+}
+
+func (x *ReorderLessonsRequest) Reset() {
+	*x = ReorderLessonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsRequest) ProtoMessage() {}
+
+func (x *ReorderLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ReorderLessonsRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *ReorderLessonsRequest) GetOrderedIds() []int64 {
+	if x != nil {
+		return x.OrderedIds
+	}
+	return nil
+}
+
+func (x *ReorderLessonsRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+type ReorderLessonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lessons were successfully reordered.
+}
+
+func (x *ReorderLessonsResponse) Reset() {
+	*x = ReorderLessonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLessonsResponse) ProtoMessage() {}
+
+func (x *ReorderLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReorderLessonsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AttachLessonToPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId       int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // ID of the existing lesson.
+	PlanId         int64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // ID of the plan the lesson is added to.
+	LastModifiedBy int64 `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modifies the plan.
+}
+
+func (x *AttachLessonToPlanRequest) Reset() {
+	*x = AttachLessonToPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachLessonToPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachLessonToPlanRequest) ProtoMessage() {}
+
+func (x *AttachLessonToPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachLessonToPlanRequest.ProtoReflect.Descriptor instead.
+func (*AttachLessonToPlanRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AttachLessonToPlanRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *AttachLessonToPlanRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *AttachLessonToPlanRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+type AttachLessonToPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lesson was successfully added to the plan.
+}
+
+func (x *AttachLessonToPlanResponse) Reset() {
+	*x = AttachLessonToPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachLessonToPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachLessonToPlanResponse) ProtoMessage() {}
+
+func (x *AttachLessonToPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachLessonToPlanResponse.ProtoReflect.Descriptor instead.
+func (*AttachLessonToPlanResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AttachLessonToPlanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DetachLessonFromPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId       int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // ID of the lesson.
+	PlanId         int64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                           // ID of the plan the lesson is removed from.
+	LastModifiedBy int64 `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modifies the plan.
+}
+
+func (x *DetachLessonFromPlanRequest) Reset() {
+	*x = DetachLessonFromPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachLessonFromPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachLessonFromPlanRequest) ProtoMessage() {}
+
+func (x *DetachLessonFromPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachLessonFromPlanRequest.ProtoReflect.Descriptor instead.
+func (*DetachLessonFromPlanRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DetachLessonFromPlanRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *DetachLessonFromPlanRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *DetachLessonFromPlanRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+type DetachLessonFromPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lesson was successfully removed from the plan.
+}
+
+func (x *DetachLessonFromPlanResponse) Reset() {
+	*x = DetachLessonFromPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachLessonFromPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachLessonFromPlanResponse) ProtoMessage() {}
+
+func (x *DetachLessonFromPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachLessonFromPlanResponse.ProtoReflect.Descriptor instead.
+func (*DetachLessonFromPlanResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DetachLessonFromPlanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CloneLessonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId  int64  `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`    // ID of the lesson to copy with its pages and questions.
+	PlanId    int64  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`          // ID of the plan the copy is added to.
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                             // Name of the copy, the name of the source lesson if empty.
+	CreatedBy int64  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // User ID who copies the lesson.
+}
+
+func (x *CloneLessonRequest) Reset() {
+	*x = CloneLessonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneLessonRequest) ProtoMessage() {}
+
+func (x *CloneLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneLessonRequest.ProtoReflect.Descriptor instead.
+func (*CloneLessonRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CloneLessonRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *CloneLessonRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *CloneLessonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneLessonRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type CloneLessonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the copy.
+}
+
+func (x *CloneLessonResponse) Reset() {
+	*x = CloneLessonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneLessonResponse) ProtoMessage() {}
+
+func (x *CloneLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneLessonResponse.ProtoReflect.Descriptor instead.
+func (*CloneLessonResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CloneLessonResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MoveLessonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId       int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // ID of the lesson to move.
+	FromPlanId     int64 `protobuf:"varint,2,opt,name=from_plan_id,json=fromPlanId,proto3" json:"from_plan_id,omitempty"`             // ID of the plan the lesson is moved from.
+	ToPlanId       int64 `protobuf:"varint,3,opt,name=to_plan_id,json=toPlanId,proto3" json:"to_plan_id,omitempty"`                   // ID of the plan the lesson is moved to.
+	LastModifiedBy int64 `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modifies the plans.
+}
+
+func (x *MoveLessonRequest) Reset() {
+	*x = MoveLessonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLessonRequest) ProtoMessage() {}
+
+func (x *MoveLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLessonRequest.ProtoReflect.Descriptor instead.
+func (*MoveLessonRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MoveLessonRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *MoveLessonRequest) GetFromPlanId() int64 {
+	if x != nil {
+		return x.FromPlanId
+	}
+	return 0
+}
+
+func (x *MoveLessonRequest) GetToPlanId() int64 {
+	if x != nil {
+		return x.ToPlanId
+	}
+	return 0
+}
+
+func (x *MoveLessonRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+type MoveLessonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the lesson was successfully moved.
+}
+
+func (x *MoveLessonResponse) Reset() {
+	*x = MoveLessonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveLessonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLessonResponse) ProtoMessage() {}
+
+func (x *MoveLessonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLessonResponse.ProtoReflect.Descriptor instead.
+func (*MoveLessonResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MoveLessonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ShortAnswerQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptedAnswers []string  `protobuf:"bytes,1,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`     // Accepted answers, or patterns matched against the whole answer in regex mode.
+	MatchMode       MatchMode `protobuf:"varint,2,opt,name=match_mode,json=matchMode,proto3,enum=lp.v1.MatchMode" json:"match_mode,omitempty"` // How the user answer is compared with accepted answers.
+	TrimWhitespace  bool      `protobuf:"varint,3,opt,name=trim_whitespace,json=trimWhitespace,proto3" json:"trim_whitespace,omitempty"`       // Ignore leading, trailing and repeated whitespace in the user answer.
+}
+
+func (x *ShortAnswerQuestion) Reset() {
+	*x = ShortAnswerQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortAnswerQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortAnswerQuestion) ProtoMessage() {}
+
+func (x *ShortAnswerQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortAnswerQuestion.ProtoReflect.Descriptor instead.
+func (*ShortAnswerQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ShortAnswerQuestion) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *ShortAnswerQuestion) GetMatchMode() MatchMode {
+	if x != nil {
+		return x.MatchMode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ShortAnswerQuestion) GetTrimWhitespace() bool {
+	if x != nil {
+		return x.TrimWhitespace
+	}
+	return false
+}
+
+type MultiSelectQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradingMode GradingMode `protobuf:"varint,2,opt,name=grading_mode,json=gradingMode,proto3,enum=lp.v1.GradingMode" json:"grading_mode,omitempty"` // How the selected options are scored.
+}
+
+func (x *MultiSelectQuestion) Reset() {
+	*x = MultiSelectQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSelectQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSelectQuestion) ProtoMessage() {}
+
+func (x *MultiSelectQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSelectQuestion.ProtoReflect.Descriptor instead.
+func (*MultiSelectQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MultiSelectQuestion) GetGradingMode() GradingMode {
+	if x != nil {
+		return x.GradingMode
+	}
+	return GradingMode_GRADING_MODE_UNSPECIFIED
+}
+
+type UpdateMultiSelectQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradingMode *GradingMode `protobuf:"varint,2,opt,name=grading_mode,json=gradingMode,proto3,enum=lp.v1.GradingMode,oneof" json:"grading_mode,omitempty"` // How the selected options are scored.
+}
+
+func (x *UpdateMultiSelectQuestion) Reset() {
+	*x = UpdateMultiSelectQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMultiSelectQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMultiSelectQuestion) ProtoMessage() {}
+
+func (x *UpdateMultiSelectQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMultiSelectQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateMultiSelectQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateMultiSelectQuestion) GetGradingMode() GradingMode {
+	if x != nil && x.GradingMode != nil {
+		return *x.GradingMode
+	}
+	return GradingMode_GRADING_MODE_UNSPECIFIED
+}
+
+type UpdateShortAnswerQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptedAnswers []string   `protobuf:"bytes,1,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`           // Replaces accepted answers if not empty.
+	MatchMode       *MatchMode `protobuf:"varint,2,opt,name=match_mode,json=matchMode,proto3,enum=lp.v1.MatchMode,oneof" json:"match_mode,omitempty"` // How the user answer is compared with accepted answers.
+	TrimWhitespace  *bool      `protobuf:"varint,3,opt,name=trim_whitespace,json=trimWhitespace,proto3,oneof" json:"trim_whitespace,omitempty"`       // Ignore leading, trailing and repeated whitespace in the user answer.
+}
+
+func (x *UpdateShortAnswerQuestion) Reset() {
+	*x = UpdateShortAnswerQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShortAnswerQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortAnswerQuestion) ProtoMessage() {}
+
+func (x *UpdateShortAnswerQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShortAnswerQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateShortAnswerQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateShortAnswerQuestion) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *UpdateShortAnswerQuestion) GetMatchMode() MatchMode {
+	if x != nil && x.MatchMode != nil {
+		return *x.MatchMode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *UpdateShortAnswerQuestion) GetTrimWhitespace() bool {
+	if x != nil && x.TrimWhitespace != nil {
+		return *x.TrimWhitespace
+	}
+	return false
+}
+
+type TrueFalseQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer bool `protobuf:"varint,1,opt,name=answer,proto3" json:"answer,omitempty"` // Whether the statement of the question is true.
+}
+
+func (x *TrueFalseQuestion) Reset() {
+	*x = TrueFalseQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrueFalseQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrueFalseQuestion) ProtoMessage() {}
+
+func (x *TrueFalseQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrueFalseQuestion.ProtoReflect.Descriptor instead.
+func (*TrueFalseQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TrueFalseQuestion) GetAnswer() bool {
+	if x != nil {
+		return x.Answer
+	}
+	return false
+}
+
+type UpdateTrueFalseQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer *bool `protobuf:"varint,1,opt,name=answer,proto3,oneof" json:"answer,omitempty"` // Whether the statement of the question is true.
+}
+
+func (x *UpdateTrueFalseQuestion) Reset() {
+	*x = UpdateTrueFalseQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTrueFalseQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrueFalseQuestion) ProtoMessage() {}
+
+func (x *UpdateTrueFalseQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrueFalseQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateTrueFalseQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateTrueFalseQuestion) GetAnswer() bool {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return false
+}
+
+type NumericQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer    *float64 `protobuf:"fixed64,1,opt,name=answer,proto3,oneof" json:"answer,omitempty"`                     // Correct value, required unless the range is set.
+	Tolerance float64  `protobuf:"fixed64,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"`                     // Allowed absolute deviation from the correct value.
+	MinValue  *float64 `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"` // Lower bound of the accepted range, inclusive.
+	MaxValue  *float64 `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"` // Upper bound of the accepted range, inclusive.
+}
+
+func (x *NumericQuestion) Reset() {
+	*x = NumericQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumericQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericQuestion) ProtoMessage() {}
+
+func (x *NumericQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericQuestion.ProtoReflect.Descriptor instead.
+func (*NumericQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *NumericQuestion) GetAnswer() float64 {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return 0
+}
+
+func (x *NumericQuestion) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *NumericQuestion) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *NumericQuestion) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+type UpdateNumericQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer    *float64 `protobuf:"fixed64,1,opt,name=answer,proto3,oneof" json:"answer,omitempty"`                     // Correct value.
+	Tolerance *float64 `protobuf:"fixed64,2,opt,name=tolerance,proto3,oneof" json:"tolerance,omitempty"`               // Allowed absolute deviation from the correct value.
+	MinValue  *float64 `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"` // Lower bound of the accepted range, inclusive.
+	MaxValue  *float64 `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"` // Upper bound of the accepted range, inclusive.
+}
+
+func (x *UpdateNumericQuestion) Reset() {
+	*x = UpdateNumericQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNumericQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNumericQuestion) ProtoMessage() {}
+
+func (x *UpdateNumericQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNumericQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateNumericQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateNumericQuestion) GetAnswer() float64 {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return 0
+}
+
+func (x *UpdateNumericQuestion) GetTolerance() float64 {
+	if x != nil && x.Tolerance != nil {
+		return *x.Tolerance
+	}
+	return 0
+}
+
+func (x *UpdateNumericQuestion) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *UpdateNumericQuestion) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+type FillBlank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptedAnswers []string `protobuf:"bytes,1,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"` // Accepted answers for the blank, or patterns in regex mode.
+}
+
+func (x *FillBlank) Reset() {
+	*x = FillBlank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillBlank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillBlank) ProtoMessage() {}
+
+func (x *FillBlank) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillBlank.ProtoReflect.Descriptor instead.
+func (*FillBlank) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FillBlank) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+type FillBlanksQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blanks         []*FillBlank `protobuf:"bytes,1,rep,name=blanks,proto3" json:"blanks,omitempty"`                                              // Blanks in the order they appear in the question passage.
+	MatchMode      MatchMode    `protobuf:"varint,2,opt,name=match_mode,json=matchMode,proto3,enum=lp.v1.MatchMode" json:"match_mode,omitempty"` // How the user answers are compared with accepted answers.
+	TrimWhitespace bool         `protobuf:"varint,3,opt,name=trim_whitespace,json=trimWhitespace,proto3" json:"trim_whitespace,omitempty"`       // Ignore leading, trailing and repeated whitespace in the user answers.
+}
+
+func (x *FillBlanksQuestion) Reset() {
+	*x = FillBlanksQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillBlanksQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillBlanksQuestion) ProtoMessage() {}
+
+func (x *FillBlanksQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillBlanksQuestion.ProtoReflect.Descriptor instead.
+func (*FillBlanksQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FillBlanksQuestion) GetBlanks() []*FillBlank {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+func (x *FillBlanksQuestion) GetMatchMode() MatchMode {
+	if x != nil {
+		return x.MatchMode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *FillBlanksQuestion) GetTrimWhitespace() bool {
+	if x != nil {
+		return x.TrimWhitespace
+	}
+	return false
+}
+
+type UpdateFillBlanksQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blanks         []*FillBlank `protobuf:"bytes,1,rep,name=blanks,proto3" json:"blanks,omitempty"`                                                    // Replaces all blanks if not empty.
+	MatchMode      *MatchMode   `protobuf:"varint,2,opt,name=match_mode,json=matchMode,proto3,enum=lp.v1.MatchMode,oneof" json:"match_mode,omitempty"` // How the user answers are compared with accepted answers.
+	TrimWhitespace *bool        `protobuf:"varint,3,opt,name=trim_whitespace,json=trimWhitespace,proto3,oneof" json:"trim_whitespace,omitempty"`       // Ignore leading, trailing and repeated whitespace in the user answers.
+}
+
+func (x *UpdateFillBlanksQuestion) Reset() {
+	*x = UpdateFillBlanksQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFillBlanksQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFillBlanksQuestion) ProtoMessage() {}
+
+func (x *UpdateFillBlanksQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFillBlanksQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateFillBlanksQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateFillBlanksQuestion) GetBlanks() []*FillBlank {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+func (x *UpdateFillBlanksQuestion) GetMatchMode() MatchMode {
+	if x != nil && x.MatchMode != nil {
+		return *x.MatchMode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *UpdateFillBlanksQuestion) GetTrimWhitespace() bool {
+	if x != nil && x.TrimWhitespace != nil {
+		return *x.TrimWhitespace
+	}
+	return false
+}
+
+type OrderingQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Items in the correct order.
+}
+
+func (x *OrderingQuestion) Reset() {
+	*x = OrderingQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderingQuestion) ProtoMessage() {}
+
+func (x *OrderingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderingQuestion.ProtoReflect.Descriptor instead.
+func (*OrderingQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *OrderingQuestion) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateOrderingQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Replaces all items if not empty.
+}
+
+func (x *UpdateOrderingQuestion) Reset() {
+	*x = UpdateOrderingQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOrderingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderingQuestion) ProtoMessage() {}
+
+func (x *UpdateOrderingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderingQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateOrderingQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateOrderingQuestion) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MatchingPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left  string `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`   // Left item.
+	Right string `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"` // Right item matched to the left one.
+}
+
+func (x *MatchingPair) Reset() {
+	*x = MatchingPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchingPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingPair) ProtoMessage() {}
+
+func (x *MatchingPair) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingPair.ProtoReflect.Descriptor instead.
+func (*MatchingPair) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *MatchingPair) GetLeft() string {
+	if x != nil {
+		return x.Left
+	}
+	return ""
+}
+
+func (x *MatchingPair) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+type MatchingQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*MatchingPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // Correct pairs.
+}
+
+func (x *MatchingQuestion) Reset() {
+	*x = MatchingQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingQuestion) ProtoMessage() {}
+
+func (x *MatchingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingQuestion.ProtoReflect.Descriptor instead.
+func (*MatchingQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *MatchingQuestion) GetPairs() []*MatchingPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type UpdateMatchingQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*MatchingPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // Replaces all pairs if not empty.
+}
+
+func (x *UpdateMatchingQuestion) Reset() {
+	*x = UpdateMatchingQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMatchingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMatchingQuestion) ProtoMessage() {}
+
+func (x *UpdateMatchingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMatchingQuestion.ProtoReflect.Descriptor instead.
+func (*UpdateMatchingQuestion) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateMatchingQuestion) GetPairs() []*MatchingPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID of the option, empty for a new option.
+	Text      string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                             // Text of the option.
+	IsCorrect bool   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"` // Indicates if the option is a correct answer.
+	Feedback  string `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`                     // Shown to the user who has chosen the option.
+}
+
+func (x *Option) Reset() {
+	*x = Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Option) ProtoMessage() {}
+
+func (x *Option) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Option.ProtoReflect.Descriptor instead.
+func (*Option) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Option) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Option) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Option) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *Option) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+type OptionFeedback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionId int64  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"` // ID of the chosen option.
+	Feedback string `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"`                  // Feedback of the option.
+}
+
+func (x *OptionFeedback) Reset() {
+	*x = OptionFeedback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionFeedback) ProtoMessage() {}
+
+func (x *OptionFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionFeedback.ProtoReflect.Descriptor instead.
+func (*OptionFeedback) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *OptionFeedback) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *OptionFeedback) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+type QuestionPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // ID of the page.
+	LessonId       int64                  `protobuf:"varint,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                                     // Lesson ID within which the page is created.
+	CreatedBy      int64                  `protobuf:"varint,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                  // User ID who creates the page.
+	LastModifiedBy int64                  `protobuf:"varint,4,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`                 // ID of the user who modified the page.
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                   // Timestamp when the page was created.
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`                                                      // Timestamp when the page was last modified.
+	ContentType    ContentType            `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=lp.v1.ContentType" json:"content_type,omitempty"`     // Сontent type of page
+	QuestionType   QuestionType           `protobuf:"varint,8,opt,name=question_type,json=questionType,proto3,enum=lp.v1.QuestionType" json:"question_type,omitempty"` // Question type for question
+	Question       string                 `protobuf:"bytes,9,opt,name=question,proto3" json:"question,omitempty"`                                                      // Question.
+	Options        []*Option              `protobuf:"bytes,23,rep,name=options,proto3" json:"options,omitempty"`                                                       // Options of a multichoice or multi-select question in display order.
+	Explanation    string                 `protobuf:"bytes,24,opt,name=explanation,proto3" json:"explanation,omitempty"`                                               // Explains the correct answer.
+	Position       int64                  `protobuf:"varint,25,opt,name=position,proto3" json:"position,omitempty"`                                                    // Zero-based position of the page within the lesson.
+	// Types that are assignable to Details:
+	//
+	//	*QuestionPage_ShortAnswer
+	//	*QuestionPage_MultiSelect
+	//	*QuestionPage_TrueFalse
+	//	*QuestionPage_Numeric
+	//	*QuestionPage_FillBlanks
+	//	*QuestionPage_Ordering
+	//	*QuestionPage_Matching
+	Details isQuestionPage_Details `protobuf_oneof:"details"`
+}
+
+func (x *QuestionPage) Reset() {
+	*x = QuestionPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionPage) ProtoMessage() {}
+
+func (x *QuestionPage) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionPage.ProtoReflect.Descriptor instead.
+func (*QuestionPage) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *QuestionPage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuestionPage) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *QuestionPage) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *QuestionPage) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *QuestionPage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *QuestionPage) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *QuestionPage) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *QuestionPage) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionPage) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (m *QuestionPage) GetDetails() isQuestionPage_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetShortAnswer() *ShortAnswerQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_ShortAnswer); ok {
+		return x.ShortAnswer
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetMultiSelect() *MultiSelectQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_MultiSelect); ok {
+		return x.MultiSelect
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetTrueFalse() *TrueFalseQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_TrueFalse); ok {
+		return x.TrueFalse
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetNumeric() *NumericQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_Numeric); ok {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetFillBlanks() *FillBlanksQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_FillBlanks); ok {
+		return x.FillBlanks
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetOrdering() *OrderingQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_Ordering); ok {
+		return x.Ordering
+	}
+	return nil
+}
+
+func (x *QuestionPage) GetMatching() *MatchingQuestion {
+	if x, ok := x.GetDetails().(*QuestionPage_Matching); ok {
+		return x.Matching
+	}
+	return nil
+}
+
+type isQuestionPage_Details interface {
+	isQuestionPage_Details()
+}
+
+type QuestionPage_ShortAnswer struct {
+	ShortAnswer *ShortAnswerQuestion `protobuf:"bytes,16,opt,name=short_answer,json=shortAnswer,proto3,oneof"` // Details of a short-answer question.
+}
+
+type QuestionPage_MultiSelect struct {
+	MultiSelect *MultiSelectQuestion `protobuf:"bytes,17,opt,name=multi_select,json=multiSelect,proto3,oneof"` // Details of a multi-select question.
+}
+
+type QuestionPage_TrueFalse struct {
+	TrueFalse *TrueFalseQuestion `protobuf:"bytes,18,opt,name=true_false,json=trueFalse,proto3,oneof"` // Details of a true/false question.
+}
+
+type QuestionPage_Numeric struct {
+	Numeric *NumericQuestion `protobuf:"bytes,19,opt,name=numeric,proto3,oneof"` // Details of a numeric question.
+}
+
+type QuestionPage_FillBlanks struct {
+	FillBlanks *FillBlanksQuestion `protobuf:"bytes,20,opt,name=fill_blanks,json=fillBlanks,proto3,oneof"` // Details of a fill-in-the-blank question.
+}
+
+type QuestionPage_Ordering struct {
+	Ordering *OrderingQuestion `protobuf:"bytes,21,opt,name=ordering,proto3,oneof"` // Details of an ordering question.
+}
+
+type QuestionPage_Matching struct {
+	Matching *MatchingQuestion `protobuf:"bytes,22,opt,name=matching,proto3,oneof"` // Details of a matching question.
+}
+
+func (*QuestionPage_ShortAnswer) isQuestionPage_Details() {}
+
+func (*QuestionPage_MultiSelect) isQuestionPage_Details() {}
+
+func (*QuestionPage_TrueFalse) isQuestionPage_Details() {}
+
+func (*QuestionPage_Numeric) isQuestionPage_Details() {}
+
+func (*QuestionPage_FillBlanks) isQuestionPage_Details() {}
+
+func (*QuestionPage_Ordering) isQuestionPage_Details() {}
+
+func (*QuestionPage_Matching) isQuestionPage_Details() {}
+
+type CreateQuestionPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId       int64     `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // Lesson ID within which the page is created.
+	CreatedBy      int64     `protobuf:"varint,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // User ID who creates the lesson.
+	LastModifiedBy int64     `protobuf:"varint,3,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the page.
+	Question       string    `protobuf:"bytes,6,opt,name=question,proto3" json:"question,omitempty"`                                      // Question.
+	Options        []*Option `protobuf:"bytes,20,rep,name=options,proto3" json:"options,omitempty"`                                       // Options of a multichoice or multi-select question in display order, at least two.
+	Explanation    string    `protobuf:"bytes,21,opt,name=explanation,proto3" json:"explanation,omitempty"`                               // Explains the correct answer, shown after the question is answered.
+	Position       *int64    `protobuf:"varint,22,opt,name=position,proto3,oneof" json:"position,omitempty"`                              // Position to insert the page at, appends the page if unset.
+	// Types that are assignable to Details:
+	//
+	//	*CreateQuestionPageRequest_ShortAnswer
+	//	*CreateQuestionPageRequest_MultiSelect
+	//	*CreateQuestionPageRequest_TrueFalse
+	//	*CreateQuestionPageRequest_Numeric
+	//	*CreateQuestionPageRequest_FillBlanks
+	//	*CreateQuestionPageRequest_Ordering
+	//	*CreateQuestionPageRequest_Matching
+	Details isCreateQuestionPageRequest_Details `protobuf_oneof:"details"`
+}
+
+func (x *CreateQuestionPageRequest) Reset() {
+	*x = CreateQuestionPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionPageRequest) ProtoMessage() {}
+
+func (x *CreateQuestionPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionPageRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionPageRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateQuestionPageRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *CreateQuestionPageRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *CreateQuestionPageRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *CreateQuestionPageRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreateQuestionPageRequest) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CreateQuestionPageRequest) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (m *CreateQuestionPageRequest) GetDetails() isCreateQuestionPageRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetShortAnswer() *ShortAnswerQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_ShortAnswer); ok {
+		return x.ShortAnswer
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetMultiSelect() *MultiSelectQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_MultiSelect); ok {
+		return x.MultiSelect
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetTrueFalse() *TrueFalseQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_TrueFalse); ok {
+		return x.TrueFalse
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetNumeric() *NumericQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_Numeric); ok {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetFillBlanks() *FillBlanksQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_FillBlanks); ok {
+		return x.FillBlanks
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetOrdering() *OrderingQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_Ordering); ok {
+		return x.Ordering
+	}
+	return nil
+}
+
+func (x *CreateQuestionPageRequest) GetMatching() *MatchingQuestion {
+	if x, ok := x.GetDetails().(*CreateQuestionPageRequest_Matching); ok {
+		return x.Matching
+	}
+	return nil
+}
+
+type isCreateQuestionPageRequest_Details interface {
+	isCreateQuestionPageRequest_Details()
+}
+
+type CreateQuestionPageRequest_ShortAnswer struct {
+	ShortAnswer *ShortAnswerQuestion `protobuf:"bytes,13,opt,name=short_answer,json=shortAnswer,proto3,oneof"` // Creates a short-answer question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_MultiSelect struct {
+	MultiSelect *MultiSelectQuestion `protobuf:"bytes,14,opt,name=multi_select,json=multiSelect,proto3,oneof"` // Creates a multi-select question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_TrueFalse struct {
+	TrueFalse *TrueFalseQuestion `protobuf:"bytes,15,opt,name=true_false,json=trueFalse,proto3,oneof"` // Creates a true/false question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_Numeric struct {
+	Numeric *NumericQuestion `protobuf:"bytes,16,opt,name=numeric,proto3,oneof"` // Creates a numeric question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_FillBlanks struct {
+	FillBlanks *FillBlanksQuestion `protobuf:"bytes,17,opt,name=fill_blanks,json=fillBlanks,proto3,oneof"` // Creates a fill-in-the-blank question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_Ordering struct {
+	Ordering *OrderingQuestion `protobuf:"bytes,18,opt,name=ordering,proto3,oneof"` // Creates an ordering question instead of a multichoice one.
+}
+
+type CreateQuestionPageRequest_Matching struct {
+	Matching *MatchingQuestion `protobuf:"bytes,19,opt,name=matching,proto3,oneof"` // Creates a matching question instead of a multichoice one.
+}
+
+func (*CreateQuestionPageRequest_ShortAnswer) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_MultiSelect) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_TrueFalse) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_Numeric) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_FillBlanks) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_Ordering) isCreateQuestionPageRequest_Details() {}
+
+func (*CreateQuestionPageRequest_Matching) isCreateQuestionPageRequest_Details() {}
+
+type CreateQuestionPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the new question page.
+}
+
+func (x *CreateQuestionPageResponse) Reset() {
+	*x = CreateQuestionPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionPageResponse) ProtoMessage() {}
+
+func (x *CreateQuestionPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionPageResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionPageResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateQuestionPageResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetQuestionPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the question page to retrieve.
+}
+
+func (x *GetQuestionPageRequest) Reset() {
+	*x = GetQuestionPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionPageRequest) ProtoMessage() {}
+
+func (x *GetQuestionPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionPageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionPageRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetQuestionPageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetQuestionPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionPage *QuestionPage `protobuf:"bytes,1,opt,name=question_page,json=questionPage,proto3" json:"question_page,omitempty"` // The retrieved question page.
+}
+
+func (x *GetQuestionPageResponse) Reset() {
+	*x = GetQuestionPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionPageResponse) ProtoMessage() {}
+
+func (x *GetQuestionPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionPageResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionPageResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetQuestionPageResponse) GetQuestionPage() *QuestionPage {
+	if x != nil {
+		return x.QuestionPage
+	}
+	return nil
+}
+
+type UpdateQuestionPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the lesson.
+	LastModifiedBy int64     `protobuf:"varint,2,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who modified the lesson.
+	Question       *string   `protobuf:"bytes,3,opt,name=question,proto3,oneof" json:"question,omitempty"`
+	Options        []*Option `protobuf:"bytes,17,rep,name=options,proto3" json:"options,omitempty"`               // Replaces all options if not empty, options without ID are added.
+	Explanation    *string   `protobuf:"bytes,18,opt,name=explanation,proto3,oneof" json:"explanation,omitempty"` // Explains the correct answer, empty string removes it.
+	// Types that are assignable to Details:
+	//
+	//	*UpdateQuestionPageRequest_ShortAnswer
+	//	*UpdateQuestionPageRequest_MultiSelect
+	//	*UpdateQuestionPageRequest_TrueFalse
+	//	*UpdateQuestionPageRequest_Numeric
+	//	*UpdateQuestionPageRequest_FillBlanks
+	//	*UpdateQuestionPageRequest_Ordering
+	//	*UpdateQuestionPageRequest_Matching
+	Details isUpdateQuestionPageRequest_Details `protobuf_oneof:"details"`
+}
+
+func (x *UpdateQuestionPageRequest) Reset() {
+	*x = UpdateQuestionPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateQuestionPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionPageRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionPageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionPageRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateQuestionPageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateQuestionPageRequest) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *UpdateQuestionPageRequest) GetQuestion() string {
+	if x != nil && x.Question != nil {
+		return *x.Question
+	}
+	return ""
+}
+
+func (x *UpdateQuestionPageRequest) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetExplanation() string {
+	if x != nil && x.Explanation != nil {
+		return *x.Explanation
+	}
+	return ""
+}
+
+func (m *UpdateQuestionPageRequest) GetDetails() isUpdateQuestionPageRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetShortAnswer() *UpdateShortAnswerQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_ShortAnswer); ok {
+		return x.ShortAnswer
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetMultiSelect() *UpdateMultiSelectQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_MultiSelect); ok {
+		return x.MultiSelect
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetTrueFalse() *UpdateTrueFalseQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_TrueFalse); ok {
+		return x.TrueFalse
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetNumeric() *UpdateNumericQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_Numeric); ok {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetFillBlanks() *UpdateFillBlanksQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_FillBlanks); ok {
+		return x.FillBlanks
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetOrdering() *UpdateOrderingQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_Ordering); ok {
+		return x.Ordering
+	}
+	return nil
+}
+
+func (x *UpdateQuestionPageRequest) GetMatching() *UpdateMatchingQuestion {
+	if x, ok := x.GetDetails().(*UpdateQuestionPageRequest_Matching); ok {
+		return x.Matching
+	}
+	return nil
+}
+
+type isUpdateQuestionPageRequest_Details interface {
+	isUpdateQuestionPageRequest_Details()
+}
+
+type UpdateQuestionPageRequest_ShortAnswer struct {
+	ShortAnswer *UpdateShortAnswerQuestion `protobuf:"bytes,10,opt,name=short_answer,json=shortAnswer,proto3,oneof"` // Updates details of a short-answer question.
+}
+
+type UpdateQuestionPageRequest_MultiSelect struct {
+	MultiSelect *UpdateMultiSelectQuestion `protobuf:"bytes,11,opt,name=multi_select,json=multiSelect,proto3,oneof"` // Updates details of a multi-select question.
+}
+
+type UpdateQuestionPageRequest_TrueFalse struct {
+	TrueFalse *UpdateTrueFalseQuestion `protobuf:"bytes,12,opt,name=true_false,json=trueFalse,proto3,oneof"` // Updates details of a true/false question.
+}
+
+type UpdateQuestionPageRequest_Numeric struct {
+	Numeric *UpdateNumericQuestion `protobuf:"bytes,13,opt,name=numeric,proto3,oneof"` // Updates details of a numeric question.
+}
+
+type UpdateQuestionPageRequest_FillBlanks struct {
+	FillBlanks *UpdateFillBlanksQuestion `protobuf:"bytes,14,opt,name=fill_blanks,json=fillBlanks,proto3,oneof"` // Updates details of a fill-in-the-blank question.
+}
+
+type UpdateQuestionPageRequest_Ordering struct {
+	Ordering *UpdateOrderingQuestion `protobuf:"bytes,15,opt,name=ordering,proto3,oneof"` // Updates details of an ordering question.
+}
+
+type UpdateQuestionPageRequest_Matching struct {
+	Matching *UpdateMatchingQuestion `protobuf:"bytes,16,opt,name=matching,proto3,oneof"` // Updates details of a matching question.
+}
+
+func (*UpdateQuestionPageRequest_ShortAnswer) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_MultiSelect) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_TrueFalse) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_Numeric) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_FillBlanks) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_Ordering) isUpdateQuestionPageRequest_Details() {}
+
+func (*UpdateQuestionPageRequest_Matching) isUpdateQuestionPageRequest_Details() {}
+
+type UpdateQuestionPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the updated pdf page.
+}
+
+func (x *UpdateQuestionPageResponse) Reset() {
+	*x = UpdateQuestionPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateQuestionPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionPageResponse) ProtoMessage() {}
+
+func (x *UpdateQuestionPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionPageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionPageResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateQuestionPageResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteQuestionPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the question page to delete.
+}
+
+func (x *DeleteQuestionPageRequest) Reset() {
+	*x = DeleteQuestionPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionPageRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionPageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionPageRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteQuestionPageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteQuestionPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the question page was successfully deleted.
+}
+
+func (x *DeleteQuestionPageResponse) Reset() {
+	*x = DeleteQuestionPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionPageResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionPageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionPageResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteQuestionPageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetQuestionPagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // ID of the lesson that includes the question pages.
+	Limit    int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                       // Limit for pagination.
+	Offset   int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                     // Offset for pagination.
+}
+
+func (x *GetQuestionPagesRequest) Reset() {
+	*x = GetQuestionPagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionPagesRequest) ProtoMessage() {}
+
+func (x *GetQuestionPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionPagesRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionPagesRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetQuestionPagesRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *GetQuestionPagesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQuestionPagesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetQuestionPagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionPages []*QuestionPage `protobuf:"bytes,1,rep,name=question_pages,json=questionPages,proto3" json:"question_pages,omitempty"` // The retrieved list of question pages.
+}
+
+func (x *GetQuestionPagesResponse) Reset() {
+	*x = GetQuestionPagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionPagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionPagesResponse) ProtoMessage() {}
+
+func (x *GetQuestionPagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionPagesResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionPagesResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetQuestionPagesResponse) GetQuestionPages() []*QuestionPage {
+	if x != nil {
+		return x.QuestionPages
+	}
+	return nil
+}
+
+type QuestionBank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID of the question bank.
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Name of the question bank.
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // Description of the question bank.
+	LessonId       int64                  `protobuf:"varint,4,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                     // ID of the lesson owning the bank, if any.
+	ChannelId      int64                  `protobuf:"varint,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // ID of the channel owning the bank, if any.
+	CreatedBy      int64                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // ID of the user who created the bank.
+	LastModifiedBy int64                  `protobuf:"varint,7,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"` // ID of the user who last modified the bank.
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Timestamp when the bank was created.
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=modified,proto3" json:"modified,omitempty"`                                      // Timestamp when the bank was last modified.
+}
+
+func (x *QuestionBank) Reset() {
+	*x = QuestionBank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionBank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionBank) ProtoMessage() {}
+
+func (x *QuestionBank) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionBank.ProtoReflect.Descriptor instead.
+func (*QuestionBank) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *QuestionBank) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuestionBank) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuestionBank) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuestionBank) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *QuestionBank) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *QuestionBank) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *QuestionBank) GetLastModifiedBy() int64 {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return 0
+}
+
+func (x *QuestionBank) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QuestionBank) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+type QuestionBankItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                       // ID of the bank item.
+	PageId     int64      `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                 // ID of the question page.
+	Tag        string     `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                                      // Tag of the question within the bank.
+	Difficulty Difficulty `protobuf:"varint,4,opt,name=difficulty,proto3,enum=lp.v1.Difficulty" json:"difficulty,omitempty"` // Difficulty of the question within the bank.
+}
+
+func (x *QuestionBankItem) Reset() {
+	*x = QuestionBankItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionBankItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionBankItem) ProtoMessage() {}
+
+func (x *QuestionBankItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionBankItem.ProtoReflect.Descriptor instead.
+func (*QuestionBankItem) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *QuestionBankItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuestionBankItem) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *QuestionBankItem) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *QuestionBankItem) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+type QuestionDraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankId     int64      `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`                 // ID of the question bank to draw from.
+	Count      int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                                 // Number of questions drawn in every attempt.
+	Tag        string     `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                                      // Draw only questions with the tag, if set.
+	Difficulty Difficulty `protobuf:"varint,4,opt,name=difficulty,proto3,enum=lp.v1.Difficulty" json:"difficulty,omitempty"` // Draw only questions of the difficulty, if set.
+}
+
+func (x *QuestionDraw) Reset() {
+	*x = QuestionDraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDraw) ProtoMessage() {}
+
+func (x *QuestionDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDraw.ProtoReflect.Descriptor instead.
+func (*QuestionDraw) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *QuestionDraw) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *QuestionDraw) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QuestionDraw) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *QuestionDraw) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+type CreateQuestionBankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                             // Name of the question bank.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`               // Description of the question bank.
+	LessonId    int64  `protobuf:"varint,3,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`    // ID of the lesson owning the bank. Exactly one of lesson_id and channel_id must be set.
+	ChannelId   int64  `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // ID of the channel owning the bank.
+	CreatedBy   int64  `protobuf:"varint,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // ID of the user creating the bank.
+}
+
+func (x *CreateQuestionBankRequest) Reset() {
+	*x = CreateQuestionBankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionBankRequest) ProtoMessage() {}
+
+func (x *CreateQuestionBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionBankRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionBankRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateQuestionBankRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateQuestionBankRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateQuestionBankRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *CreateQuestionBankRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *CreateQuestionBankRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type CreateQuestionBankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created question bank.
+}
+
+func (x *CreateQuestionBankResponse) Reset() {
+	*x = CreateQuestionBankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionBankResponse) ProtoMessage() {}
+
+func (x *CreateQuestionBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionBankResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionBankResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateQuestionBankResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetQuestionBankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the question bank to retrieve.
+}
+
+func (x *GetQuestionBankRequest) Reset() {
+	*x = GetQuestionBankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionBankRequest) ProtoMessage() {}
+
+func (x *GetQuestionBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionBankRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionBankRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetQuestionBankRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetQuestionBankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bank  *QuestionBank       `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank,omitempty"`   // The retrieved question bank.
+	Items []*QuestionBankItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // Questions of the bank.
+}
+
+func (x *GetQuestionBankResponse) Reset() {
+	*x = GetQuestionBankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionBankResponse) ProtoMessage() {}
+
+func (x *GetQuestionBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionBankResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionBankResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetQuestionBankResponse) GetBank() *QuestionBank {
+	if x != nil {
+		return x.Bank
+	}
+	return nil
+}
+
+func (x *GetQuestionBankResponse) GetItems() []*QuestionBankItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetQuestionBanksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId  *int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`    // Filter by lesson ID.
+	ChannelId *int64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"` // Filter by channel ID.
+	Limit     int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                // Limit for pagination.
+	Offset    int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                              // Offset for pagination.
+}
+
+func (x *GetQuestionBanksRequest) Reset() {
+	*x = GetQuestionBanksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionBanksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionBanksRequest) ProtoMessage() {}
+
+func (x *GetQuestionBanksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionBanksRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionBanksRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetQuestionBanksRequest) GetLessonId() int64 {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return 0
+}
+
+func (x *GetQuestionBanksRequest) GetChannelId() int64 {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetQuestionBanksRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQuestionBanksRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetQuestionBanksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Banks []*QuestionBank `protobuf:"bytes,1,rep,name=banks,proto3" json:"banks,omitempty"` // The retrieved list of question banks.
+}
+
+func (x *GetQuestionBanksResponse) Reset() {
+	*x = GetQuestionBanksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionBanksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionBanksResponse) ProtoMessage() {}
+
+func (x *GetQuestionBanksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionBanksResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionBanksResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetQuestionBanksResponse) GetBanks() []*QuestionBank {
+	if x != nil {
+		return x.Banks
+	}
+	return nil
+}
+
+type DeleteQuestionBankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the question bank to delete.
+}
+
+func (x *DeleteQuestionBankRequest) Reset() {
+	*x = DeleteQuestionBankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionBankRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionBankRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionBankRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeleteQuestionBankRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteQuestionBankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the question bank was successfully deleted.
+}
+
+func (x *DeleteQuestionBankResponse) Reset() {
+	*x = DeleteQuestionBankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionBankResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionBankResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionBankResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeleteQuestionBankResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddQuestionToBankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankId     int64      `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`                 // ID of the question bank.
+	PageId     int64      `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                 // ID of the question page to add.
+	Tag        string     `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                                      // Tag of the question within the bank.
+	Difficulty Difficulty `protobuf:"varint,4,opt,name=difficulty,proto3,enum=lp.v1.Difficulty" json:"difficulty,omitempty"` // Difficulty of the question within the bank.
+}
+
+func (x *AddQuestionToBankRequest) Reset() {
+	*x = AddQuestionToBankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddQuestionToBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddQuestionToBankRequest) ProtoMessage() {}
+
+func (x *AddQuestionToBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddQuestionToBankRequest.ProtoReflect.Descriptor instead.
+func (*AddQuestionToBankRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AddQuestionToBankRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *AddQuestionToBankRequest) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *AddQuestionToBankRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *AddQuestionToBankRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+type AddQuestionToBankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created bank item.
+}
+
+func (x *AddQuestionToBankResponse) Reset() {
+	*x = AddQuestionToBankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddQuestionToBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddQuestionToBankResponse) ProtoMessage() {}
+
+func (x *AddQuestionToBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddQuestionToBankResponse.ProtoReflect.Descriptor instead.
+func (*AddQuestionToBankResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AddQuestionToBankResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveQuestionFromBankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankId int64 `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"` // ID of the question bank.
+	PageId int64 `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"` // ID of the question page to remove.
+}
+
+func (x *RemoveQuestionFromBankRequest) Reset() {
+	*x = RemoveQuestionFromBankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveQuestionFromBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveQuestionFromBankRequest) ProtoMessage() {}
+
+func (x *RemoveQuestionFromBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveQuestionFromBankRequest.ProtoReflect.Descriptor instead.
+func (*RemoveQuestionFromBankRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RemoveQuestionFromBankRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *RemoveQuestionFromBankRequest) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+type RemoveQuestionFromBankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the question was successfully removed.
+}
+
+func (x *RemoveQuestionFromBankResponse) Reset() {
+	*x = RemoveQuestionFromBankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveQuestionFromBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveQuestionFromBankResponse) ProtoMessage() {}
+
+func (x *RemoveQuestionFromBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveQuestionFromBankResponse.ProtoReflect.Descriptor instead.
+func (*RemoveQuestionFromBankResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RemoveQuestionFromBankResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetLessonQuestionDrawsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId int64           `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // ID of the lesson.
+	Draws    []*QuestionDraw `protobuf:"bytes,2,rep,name=draws,proto3" json:"draws,omitempty"`                        // Draw rules replacing the current ones. Empty list turns drawing off.
+}
+
+func (x *SetLessonQuestionDrawsRequest) Reset() {
+	*x = SetLessonQuestionDrawsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLessonQuestionDrawsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLessonQuestionDrawsRequest) ProtoMessage() {}
+
+func (x *SetLessonQuestionDrawsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLessonQuestionDrawsRequest.ProtoReflect.Descriptor instead.
+func (*SetLessonQuestionDrawsRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SetLessonQuestionDrawsRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *SetLessonQuestionDrawsRequest) GetDraws() []*QuestionDraw {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+type SetLessonQuestionDrawsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indicates if the draw rules were successfully set.
+}
+
+func (x *SetLessonQuestionDrawsResponse) Reset() {
+	*x = SetLessonQuestionDrawsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLessonQuestionDrawsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLessonQuestionDrawsResponse) ProtoMessage() {}
+
+func (x *SetLessonQuestionDrawsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLessonQuestionDrawsResponse.ProtoReflect.Descriptor instead.
+func (*SetLessonQuestionDrawsResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetLessonQuestionDrawsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLessonQuestionDrawsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // ID of the lesson.
+}
+
+func (x *GetLessonQuestionDrawsRequest) Reset() {
+	*x = GetLessonQuestionDrawsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonQuestionDrawsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonQuestionDrawsRequest) ProtoMessage() {}
+
+func (x *GetLessonQuestionDrawsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonQuestionDrawsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonQuestionDrawsRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetLessonQuestionDrawsRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+type GetLessonQuestionDrawsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draws []*QuestionDraw `protobuf:"bytes,1,rep,name=draws,proto3" json:"draws,omitempty"` // Draw rules of the lesson.
+}
+
+func (x *GetLessonQuestionDrawsResponse) Reset() {
+	*x = GetLessonQuestionDrawsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonQuestionDrawsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonQuestionDrawsResponse) ProtoMessage() {}
+
+func (x *GetLessonQuestionDrawsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonQuestionDrawsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonQuestionDrawsResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetLessonQuestionDrawsResponse) GetDraws() []*QuestionDraw {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+type CreateAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId  int64 `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	PlanId    int64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	ChannelId int64 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId    int64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Resume    bool  `protobuf:"varint,5,opt,name=resume,proto3" json:"resume,omitempty"` // Return the incomplete attempt of the user for the lesson instead of creating a new one.
+}
+
+func (x *CreateAttemptRequest) Reset() {
+	*x = CreateAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttemptRequest) ProtoMessage() {}
+
+func (x *CreateAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttemptRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CreateAttemptRequest) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *CreateAttemptRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *CreateAttemptRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *CreateAttemptRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAttemptRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type CreateAttemptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created attempt.
+	Success bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Resumed bool  `protobuf:"varint,3,opt,name=resumed,proto3" json:"resumed,omitempty"` // Indicates if an existing incomplete attempt was returned.
+}
+
+func (x *CreateAttemptResponse) Reset() {
+	*x = CreateAttemptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttemptResponse) ProtoMessage() {}
+
+func (x *CreateAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttemptResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CreateAttemptResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateAttemptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateAttemptResponse) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type SubmitQuestionAnswerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonAttemptId   int64           `protobuf:"varint,1,opt,name=lesson_attempt_id,json=lessonAttemptId,proto3" json:"lesson_attempt_id,omitempty"`               // ID of the lesson attempt.
+	PageId            int64           `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                                            // ID of the answered question page.
+	TextAnswer        string          `protobuf:"bytes,4,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`                                 // Answer given by the user to a short-answer question.
+	BoolAnswer        *bool           `protobuf:"varint,6,opt,name=bool_answer,json=boolAnswer,proto3,oneof" json:"bool_answer,omitempty"`                          // Answer given by the user to a true/false question.
+	NumericAnswer     *float64        `protobuf:"fixed64,7,opt,name=numeric_answer,json=numericAnswer,proto3,oneof" json:"numeric_answer,omitempty"`                // Answer given by the user to a numeric question.
+	BlankAnswers      []string        `protobuf:"bytes,8,rep,name=blank_answers,json=blankAnswers,proto3" json:"blank_answers,omitempty"`                           // Answers given by the user to the blanks, in order.
+	OrderedItems      []string        `protobuf:"bytes,9,rep,name=ordered_items,json=orderedItems,proto3" json:"ordered_items,omitempty"`                           // Items of an ordering question in the order given by the user.
+	MatchedPairs      []*MatchingPair `protobuf:"bytes,10,rep,name=matched_pairs,json=matchedPairs,proto3" json:"matched_pairs,omitempty"`                          // Pairs made by the user in a matching question.
+	OptionId          int64           `protobuf:"varint,11,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`                                     // ID of the option chosen by the user in a multichoice question.
+	SelectedOptionIds []int64         `protobuf:"varint,12,rep,packed,name=selected_option_ids,json=selectedOptionIds,proto3" json:"selected_option_ids,omitempty"` // IDs of the options selected by the user in a multi-select question.
+}
+
+func (x *SubmitQuestionAnswerRequest) Reset() {
+	*x = SubmitQuestionAnswerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitQuestionAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuestionAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitQuestionAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuestionAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitQuestionAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SubmitQuestionAnswerRequest) GetLessonAttemptId() int64 {
+	if x != nil {
+		return x.LessonAttemptId
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerRequest) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerRequest) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+func (x *SubmitQuestionAnswerRequest) GetBoolAnswer() bool {
+	if x != nil && x.BoolAnswer != nil {
+		return *x.BoolAnswer
+	}
+	return false
+}
+
+func (x *SubmitQuestionAnswerRequest) GetNumericAnswer() float64 {
+	if x != nil && x.NumericAnswer != nil {
+		return *x.NumericAnswer
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerRequest) GetBlankAnswers() []string {
+	if x != nil {
+		return x.BlankAnswers
+	}
+	return nil
+}
+
+func (x *SubmitQuestionAnswerRequest) GetOrderedItems() []string {
+	if x != nil {
+		return x.OrderedItems
+	}
+	return nil
+}
+
+func (x *SubmitQuestionAnswerRequest) GetMatchedPairs() []*MatchingPair {
+	if x != nil {
+		return x.MatchedPairs
+	}
+	return nil
+}
+
+func (x *SubmitQuestionAnswerRequest) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerRequest) GetSelectedOptionIds() []int64 {
+	if x != nil {
+		return x.SelectedOptionIds
+	}
+	return nil
+}
+
+type SubmitQuestionAnswerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID of the question page attempt.
+	IsSuccessful bool              `protobuf:"varint,2,opt,name=is_successful,json=isSuccessful,proto3" json:"is_successful,omitempty"` // Indicates if the answer is correct.
+	Score        int64             `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`                                   // Score of the answer in percent, below 100 for partially correct answers.
+	Explanation  string            `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`                        // Explanation of the correct answer.
+	Feedback     []*OptionFeedback `protobuf:"bytes,5,rep,name=feedback,proto3" json:"feedback,omitempty"`                              // Feedback of the options chosen by the user.
+}
+
+func (x *SubmitQuestionAnswerResponse) Reset() {
+	*x = SubmitQuestionAnswerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitQuestionAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuestionAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitQuestionAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuestionAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitQuestionAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SubmitQuestionAnswerResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerResponse) GetIsSuccessful() bool {
+	if x != nil {
+		return x.IsSuccessful
+	}
+	return false
+}
+
+func (x *SubmitQuestionAnswerResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmitQuestionAnswerResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *SubmitQuestionAnswerResponse) GetFeedback() []*OptionFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+type CompleteAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson attempt to complete.
+}
+
+func (x *CompleteAttemptRequest) Reset() {
+	*x = CompleteAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAttemptRequest) ProtoMessage() {}
+
+func (x *CompleteAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAttemptRequest.ProtoReflect.Descriptor instead.
+func (*CompleteAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CompleteAttemptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CompleteAttemptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // ID of the completed lesson attempt.
+	PercentageScore int64 `protobuf:"varint,2,opt,name=percentage_score,json=percentageScore,proto3" json:"percentage_score,omitempty"` // Average score of the question answers in percent.
+	IsSuccessful    bool  `protobuf:"varint,3,opt,name=is_successful,json=isSuccessful,proto3" json:"is_successful,omitempty"`          // Indicates if the score reached the pass threshold.
+}
+
+func (x *CompleteAttemptResponse) Reset() {
+	*x = CompleteAttemptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAttemptResponse) ProtoMessage() {}
+
+func (x *CompleteAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAttemptResponse.ProtoReflect.Descriptor instead.
+func (*CompleteAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CompleteAttemptResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompleteAttemptResponse) GetPercentageScore() int64 {
+	if x != nil {
+		return x.PercentageScore
+	}
+	return 0
+}
+
+func (x *CompleteAttemptResponse) GetIsSuccessful() bool {
+	if x != nil {
+		return x.IsSuccessful
+	}
+	return false
+}
+
+type LessonAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                   // ID of the lesson attempt.
+	LessonId        int64                  `protobuf:"varint,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                       // ID of the attempted lesson.
+	PlanId          int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                             // Plan ID within which the lesson is attempted.
+	ChannelId       int64                  `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                    // Channel ID within which the lesson is attempted.
+	UserId          int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                             // ID of the user who makes the attempt.
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                     // Timestamp when the attempt was started.
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                           // Timestamp when the attempt was completed.
+	IsComplete      bool                   `protobuf:"varint,8,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`                 // Indicates if the attempt is completed.
+	IsSuccessful    bool                   `protobuf:"varint,9,opt,name=is_successful,json=isSuccessful,proto3" json:"is_successful,omitempty"`           // Indicates if the attempt is passed.
+	PercentageScore int64                  `protobuf:"varint,10,opt,name=percentage_score,json=percentageScore,proto3" json:"percentage_score,omitempty"` // Percentage of correctly answered questions.
+}
+
+func (x *LessonAttempt) Reset() {
+	*x = LessonAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LessonAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonAttempt) ProtoMessage() {}
+
+func (x *LessonAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonAttempt.ProtoReflect.Descriptor instead.
+func (*LessonAttempt) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *LessonAttempt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LessonAttempt) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *LessonAttempt) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *LessonAttempt) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *LessonAttempt) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LessonAttempt) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LessonAttempt) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *LessonAttempt) GetIsComplete() bool {
+	if x != nil {
+		return x.IsComplete
+	}
+	return false
+}
+
+func (x *LessonAttempt) GetIsSuccessful() bool {
+	if x != nil {
+		return x.IsSuccessful
+	}
+	return false
+}
+
+func (x *LessonAttempt) GetPercentageScore() int64 {
+	if x != nil {
+		return x.PercentageScore
+	}
+	return 0
+}
+
+type PageAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // ID of the page attempt.
+	PageId       int64                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                                           // ID of the attempted page.
+	ContentType  ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=lp.v1.ContentType" json:"content_type,omitempty"`     // Сontent type of page.
+	QuestionType QuestionType           `protobuf:"varint,4,opt,name=question_type,json=questionType,proto3,enum=lp.v1.QuestionType" json:"question_type,omitempty"` // Question type for question page.
+	UserAnswer   string                 `protobuf:"bytes,5,opt,name=user_answer,json=userAnswer,proto3" json:"user_answer,omitempty"`                                // Answer given by the user.
+	IsSuccessful bool                   `protobuf:"varint,6,opt,name=is_successful,json=isSuccessful,proto3" json:"is_successful,omitempty"`                         // Indicates if the answer is correct.
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                   // Timestamp when the page attempt was created.
+	Modified     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=modified,proto3" json:"modified,omitempty"`                                                      // Timestamp when the page attempt was last modified.
+	IsViewed     bool                   `protobuf:"varint,9,opt,name=is_viewed,json=isViewed,proto3" json:"is_viewed,omitempty"`                                     // Indicates if the content page was viewed.
+	ViewedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`                                     // Timestamp when the content page was first viewed.
+	Progress     int64                  `protobuf:"varint,11,opt,name=progress,proto3" json:"progress,omitempty"`                                                    // Progress on the content page, e.g. video seconds watched or PDF pages read.
+	Score        int64                  `protobuf:"varint,12,opt,name=score,proto3" json:"score,omitempty"`                                                          // Score of the answer in percent.
+	Position     int64                  `protobuf:"varint,13,opt,name=position,proto3" json:"position,omitempty"`                                                    // Position of the page within the attempt, starting from 1.
+	OptionOrder  []int64                `protobuf:"varint,14,rep,packed,name=option_order,json=optionOrder,proto3" json:"option_order,omitempty"`                    // IDs of the question options in the order shown in the attempt.
+	BankId       int64                  `protobuf:"varint,15,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`                                          // ID of the question bank the question was drawn from.
+	Draw         *QuestionDraw          `protobuf:"bytes,16,opt,name=draw,proto3" json:"draw,omitempty"`                                                             // Draw rule the question was drawn by, if it was drawn from a bank.
+}
+
+func (x *PageAttempt) Reset() {
+	*x = PageAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageAttempt) ProtoMessage() {}
+
+func (x *PageAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageAttempt.ProtoReflect.Descriptor instead.
+func (*PageAttempt) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *PageAttempt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *PageAttempt) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *PageAttempt) GetUserAnswer() string {
+	if x != nil {
+		return x.UserAnswer
+	}
+	return ""
+}
+
+func (x *PageAttempt) GetIsSuccessful() bool {
+	if x != nil {
+		return x.IsSuccessful
+	}
+	return false
+}
+
+func (x *PageAttempt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PageAttempt) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *PageAttempt) GetIsViewed() bool {
+	if x != nil {
+		return x.IsViewed
+	}
+	return false
+}
+
+func (x *PageAttempt) GetViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedAt
+	}
+	return nil
+}
+
+func (x *PageAttempt) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetOptionOrder() []int64 {
+	if x != nil {
+		return x.OptionOrder
+	}
+	return nil
+}
+
+func (x *PageAttempt) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *PageAttempt) GetDraw() *QuestionDraw {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+type GetAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the lesson attempt to retrieve.
+}
+
+func (x *GetAttemptRequest) Reset() {
+	*x = GetAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttemptRequest) ProtoMessage() {}
+
+func (x *GetAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttemptRequest.ProtoReflect.Descriptor instead.
+func (*GetAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetAttemptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAttemptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt      *LessonAttempt `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`                               // The retrieved lesson attempt.
+	PageAttempts []*PageAttempt `protobuf:"bytes,2,rep,name=page_attempts,json=pageAttempts,proto3" json:"page_attempts,omitempty"` // Page attempts of the lesson attempt.
+}
+
+func (x *GetAttemptResponse) Reset() {
+	*x = GetAttemptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttemptResponse) ProtoMessage() {}
+
+func (x *GetAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttemptResponse.ProtoReflect.Descriptor instead.
+func (*GetAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetAttemptResponse) GetAttempt() *LessonAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *GetAttemptResponse) GetPageAttempts() []*PageAttempt {
+	if x != nil {
+		return x.PageAttempts
+	}
+	return nil
+}
+
+type ListAttemptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    *int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`          // Filter by user ID.
+	LessonId  *int64 `protobuf:"varint,2,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`    // Filter by lesson ID.
+	PlanId    *int64 `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3,oneof" json:"plan_id,omitempty"`          // Filter by plan ID.
+	ChannelId *int64 `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"` // Filter by channel ID.
+	Limit     int64  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                // Limit for pagination.
+	Offset    int64  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`                              // Offset for pagination.
+}
+
+func (x *ListAttemptsRequest) Reset() {
+	*x = ListAttemptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttemptsRequest) ProtoMessage() {}
+
+func (x *ListAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListAttemptsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetLessonId() int64 {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetPlanId() int64 {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetChannelId() int64 {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts []*LessonAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"` // The retrieved list of lesson attempts.
+}
+
+func (x *ListAttemptsResponse) Reset() {
+	*x = ListAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttemptsResponse) ProtoMessage() {}
+
+func (x *ListAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListAttemptsResponse) GetAttempts() []*LessonAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type MarkPageViewedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonAttemptId int64  `protobuf:"varint,1,opt,name=lesson_attempt_id,json=lessonAttemptId,proto3" json:"lesson_attempt_id,omitempty"` // ID of the lesson attempt.
+	PageId          int64  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`                              // ID of the viewed content page.
+	Progress        *int64 `protobuf:"varint,3,opt,name=progress,proto3,oneof" json:"progress,omitempty"`                                  // Progress on the page, e.g. video seconds watched or PDF pages read.
+}
+
+func (x *MarkPageViewedRequest) Reset() {
+	*x = MarkPageViewedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPageViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPageViewedRequest) ProtoMessage() {}
+
+func (x *MarkPageViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPageViewedRequest.ProtoReflect.Descriptor instead.
+func (*MarkPageViewedRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *MarkPageViewedRequest) GetLessonAttemptId() int64 {
+	if x != nil {
+		return x.LessonAttemptId
+	}
+	return 0
+}
+
+func (x *MarkPageViewedRequest) GetPageId() int64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *MarkPageViewedRequest) GetProgress() int64 {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return 0
+}
+
+type MarkPageViewedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID of the page attempt.
+	IsViewed bool  `protobuf:"varint,2,opt,name=is_viewed,json=isViewed,proto3" json:"is_viewed,omitempty"` // Indicates if the content page was viewed.
+	Progress int64 `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`                 // Progress on the content page.
+}
+
+func (x *MarkPageViewedResponse) Reset() {
+	*x = MarkPageViewedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPageViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPageViewedResponse) ProtoMessage() {}
+
+func (x *MarkPageViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPageViewedResponse.ProtoReflect.Descriptor instead.
+func (*MarkPageViewedResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *MarkPageViewedResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarkPageViewedResponse) GetIsViewed() bool {
+	if x != nil {
+		return x.IsViewed
+	}
+	return false
+}
+
+func (x *MarkPageViewedResponse) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type LessonProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonId      int64                  `protobuf:"varint,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`                 // ID of the lesson.
+	LessonName    string                 `protobuf:"bytes,2,opt,name=lesson_name,json=lessonName,proto3" json:"lesson_name,omitempty"`            // Name of the lesson.
+	Status        LessonStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=lp.v1.LessonStatus" json:"status,omitempty"`             // Status of the lesson for the user.
+	BestScore     int64                  `protobuf:"varint,4,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`              // Best percentage score among completed attempts.
+	AttemptsCount int64                  `protobuf:"varint,5,opt,name=attempts_count,json=attemptsCount,proto3" json:"attempts_count,omitempty"`  // Number of attempts made by the user.
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"` // Timestamp when the last attempt was started.
+}
+
+func (x *LessonProgress) Reset() {
+	*x = LessonProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LessonProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonProgress) ProtoMessage() {}
+
+func (x *LessonProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonProgress.ProtoReflect.Descriptor instead.
+func (*LessonProgress) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *LessonProgress) GetLessonId() int64 {
+	if x != nil {
+		return x.LessonId
+	}
+	return 0
+}
+
+func (x *LessonProgress) GetLessonName() string {
+	if x != nil {
+		return x.LessonName
+	}
+	return ""
+}
+
+func (x *LessonProgress) GetStatus() LessonStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LessonStatus_LESSON_STATUS_UNSPECIFIED
+}
+
+func (x *LessonProgress) GetBestScore() int64 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *LessonProgress) GetAttemptsCount() int64 {
+	if x != nil {
+		return x.AttemptsCount
+	}
+	return 0
+}
+
+func (x *LessonProgress) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+type PlanProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId               int64             `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                                           // ID of the plan.
+	PlanName             string            `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`                                      // Name of the plan.
+	TotalLessons         int64             `protobuf:"varint,3,opt,name=total_lessons,json=totalLessons,proto3" json:"total_lessons,omitempty"`                         // Number of lessons in the plan.
+	PassedLessons        int64             `protobuf:"varint,4,opt,name=passed_lessons,json=passedLessons,proto3" json:"passed_lessons,omitempty"`                      // Number of passed lessons.
+	CompletionPercentage int64             `protobuf:"varint,5,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"` // Percentage of passed lessons.
+	Lessons              []*LessonProgress `protobuf:"bytes,6,rep,name=lessons,proto3" json:"lessons,omitempty"`                                                        // Progress on each lesson of the plan.
+}
+
+func (x *PlanProgress) Reset() {
+	*x = PlanProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgress) ProtoMessage() {}
+
+func (x *PlanProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgress.ProtoReflect.Descriptor instead.
+func (*PlanProgress) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *PlanProgress) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *PlanProgress) GetTotalLessons() int64 {
+	if x != nil {
+		return x.TotalLessons
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetPassedLessons() int64 {
+	if x != nil {
+		return x.PassedLessons
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetCompletionPercentage() int64 {
+	if x != nil {
+		return x.CompletionPercentage
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetLessons() []*LessonProgress {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+type GetPlanProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID of the user.
+	PlanId int64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // ID of the plan.
+}
+
+func (x *GetPlanProgressRequest) Reset() {
+	*x = GetPlanProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanProgressRequest) ProtoMessage() {}
+
+func (x *GetPlanProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanProgressRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetPlanProgressRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPlanProgressRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type GetPlanProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress *PlanProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"` // Progress of the user on the plan.
+}
+
+func (x *GetPlanProgressResponse) Reset() {
+	*x = GetPlanProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanProgressResponse) ProtoMessage() {}
+
+func (x *GetPlanProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanProgressResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetPlanProgressResponse) GetProgress() *PlanProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type GetChannelProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // ID of the user.
+	ChannelId int64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // ID of the channel.
+}
+
+func (x *GetChannelProgressRequest) Reset() {
+	*x = GetChannelProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelProgressRequest) ProtoMessage() {}
+
+func (x *GetChannelProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelProgressRequest) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetChannelProgressRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetChannelProgressRequest) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+type GetChannelProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId            int64           `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                                  // ID of the channel.
+	TotalLessons         int64           `protobuf:"varint,2,opt,name=total_lessons,json=totalLessons,proto3" json:"total_lessons,omitempty"`                         // Number of lessons in all plans of the channel.
+	PassedLessons        int64           `protobuf:"varint,3,opt,name=passed_lessons,json=passedLessons,proto3" json:"passed_lessons,omitempty"`                      // Number of passed lessons.
+	CompletionPercentage int64           `protobuf:"varint,4,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"` // Percentage of passed lessons.
+	Plans                []*PlanProgress `protobuf:"bytes,5,rep,name=plans,proto3" json:"plans,omitempty"`                                                            // Progress on each plan of the channel.
+}
+
+func (x *GetChannelProgressResponse) Reset() {
+	*x = GetChannelProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lp_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelProgressResponse) ProtoMessage() {}
+
+func (x *GetChannelProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lp_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelProgressResponse) Descriptor() ([]byte, []int) {
+	return file_lp_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetChannelProgressResponse) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetChannelProgressResponse) GetTotalLessons() int64 {
+	if x != nil {
+		return x.TotalLessons
+	}
+	return 0
+}
+
+func (x *GetChannelProgressResponse) GetPassedLessons() int64 {
+	if x != nil {
+		return x.PassedLessons
+	}
+	return 0
+}
+
+func (x *GetChannelProgressResponse) GetCompletionPercentage() int64 {
+	if x != nil {
+		return x.CompletionPercentage
+	}
+	return 0
+}
+
+func (x *GetChannelProgressResponse) GetPlans() []*PlanProgress {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+var File_lp_proto protoreflect.FileDescriptor
+
+var file_lp_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x6c, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x75,
+	0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x75, 0x0a,
+	0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x46, 0x69,
+	0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x07,
+	0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c,
+	0x70, 0x64, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x64, 0x66, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x64, 0x66,
+	0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x70, 0x64, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x64, 0x66, 0x50, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x24,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xac, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x64, 0x66, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x44, 0x46, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x64, 0x66,
+	0x50, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x39, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a,
+	0x08, 0x70, 0x64, 0x66, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x44,
+	0x46, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x64, 0x66, 0x50, 0x61, 0x67, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x97, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x4d, 0x6f,
+	0x76, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x57, 0x69, 0x74, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28,
+	0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x95,
+	0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x42, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x27, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xc3,
+	0x02, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x22, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x24, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x7f, 0x0a, 0x13, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x7e, 0x0a, 0x1a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x6e, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x42, 0x79, 0x22, 0x37, 0x0a, 0x1b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x6e, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x80, 0x01, 0x0a,
+	0x1c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x39, 0x0a, 0x1d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x0a, 0x10, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93,
+	0x06, 0x0a, 0x06, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41,
+	0x6c, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68,
+	0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x6c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x91, 0x05, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x38, 0x0a,
+	0x18, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x41, 0x6c, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x26,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x06, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x73, 0x22, 0xa2, 0x07, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0c,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x16, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x05, 0x52, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c,
+	0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x06, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x1a, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x08, 0x52, 0x18, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36,
+	0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x48, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x12,
+	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x0d, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x1b, 0x0a,
+	0x19, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x26, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7b, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x42, 0x79, 0x22, 0x32, 0x0a, 0x16, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7b, 0x0a, 0x19, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x36, 0x0a, 0x1a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x0a,
+	0x1b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x38, 0x0a, 0x1c,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9a, 0x01, 0x0a,
+	0x11, 0x4d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2e, 0x0a, 0x12, 0x4d, 0x6f, 0x76,
+	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x0a,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x6d, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x0c, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x22, 0x77, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3a, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x02, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x01, 0x0a,
+	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74,
+	0x72, 0x69, 0x6d, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x6d, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x72, 0x69,
+	0x6d, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x11,
+	0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xb7, 0x01, 0x0a,
+	0x0f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x09, 0x46, 0x69, 0x6c,
+	0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x61, 0x6e,
+	0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x72,
+	0x69, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xcb, 0x01, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b,
+	0x73, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x61,
+	0x6e, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x72, 0x69,
+	0x6d, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x2e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3d,
+	0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x43, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x22, 0x67, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x49, 0x0a, 0x0e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xd3, 0x07, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x35, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x62, 0x6c,
+	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61,
+	0x6e, 0x6b, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x0a,
+	0x10, 0x10, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x52, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xfe, 0x05, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72,
+	0x75, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x75, 0x65,
+	0x46, 0x61, 0x6c, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x6c, 0x5f, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b,
+	0x73, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x35,
+	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08,
+	0x07, 0x10, 0x0d, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x52, 0x08, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x52, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x22, 0xf5, 0x05, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x42, 0x0a, 0x0b, 0x66,
+	0x69, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x12,
+	0x3b, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x08,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x0a, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x52, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x2c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x6e, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x72, 0x61, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x22, 0xac, 0x01, 0x0a, 0x19, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62,
+	0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x04,
+	0x62, 0x61, 0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x22, 0x45, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x62, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b,
+	0x52, 0x05, 0x62, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x91, 0x01, 0x0a,
+	0x18, 0x41, 0x64, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x42, 0x61,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x31, 0x0a,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x22, 0x2b, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a,
+	0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x22, 0x3a, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x67, 0x0a, 0x1d,
+	0x53, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x77, 0x73, 0x22, 0x3a, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x4b, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x22, 0x9c, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x22, 0xf4, 0x03, 0x0a, 0x1b, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d,
+	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50,
+	0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x10, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22,
+	0xbe, 0x01, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x22, 0x28, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x79, 0x0a, 0x17, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x22, 0xf0, 0x02, 0x0a, 0x0d, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xe9, 0x04, 0x0a, 0x0b, 0x50, 0x61, 0x67,
+	0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x64,
+	0x72, 0x61, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x04,
+	0x64, 0x72, 0x61, 0x77, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x37, 0x0a, 0x0d, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x01, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1c, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22,
+	0x8a, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x61, 0x0a, 0x16,
+	0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x56, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x85, 0x02, 0x0a, 0x0e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x2f, 0x0a, 0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
+	0x22, 0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xe7, 0x01,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2a, 0x58, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x44,
+	0x46, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x2a, 0xab, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x4e, 0x53, 0x57,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x52, 0x55, 0x45, 0x5f, 0x46, 0x41,
+	0x4c, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43,
+	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b,
+	0x53, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10,
+	0x07, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x2a,
+	0x65, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x49, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52,
+	0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x41, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10, 0x03, 0x2a, 0x48, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43,
+	0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x53, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x52, 0x44,
+	0x10, 0x03, 0x2a, 0x67, 0x0a, 0x0c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
+	0x53, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbd, 0x20, 0x0a, 0x10,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x19,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x15, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12,
+	0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c,
+	0x61, 0x6e, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x50, 0x6c, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x23, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x6c,
+	0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x12, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x20, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x46,
+	0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4d, 0x6f, 0x76,
+	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b,
+	0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x6e, 0x6b, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x6c, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73,
+	0x12, 0x24, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x18, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x12, 0x1c, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61,
+	0x67, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x67, 0x65,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x6c,
+	0x70, 0x2e, 0x6c, 0x70, 0x2e, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lp_proto_rawDescOnce sync.Once
+	file_lp_proto_rawDescData = file_lp_proto_rawDesc
+)
+
+func file_lp_proto_rawDescGZIP() []byte {
+	file_lp_proto_rawDescOnce.Do(func() {
+		file_lp_proto_rawDescData = protoimpl.X.CompressGZIP(file_lp_proto_rawDescData)
+	})
+	return file_lp_proto_rawDescData
+}
+
+var file_lp_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_lp_proto_msgTypes = make([]protoimpl.MessageInfo, 149)
+var file_lp_proto_goTypes = []any{
+	(ContentType)(0),                       // 0: lp.v1.ContentType
+	(QuestionType)(0),                      // 1: lp.v1.QuestionType
+	(MatchMode)(0),                         // 2: lp.v1.MatchMode
+	(GradingMode)(0),                       // 3: lp.v1.GradingMode
+	(Difficulty)(0),                        // 4: lp.v1.Difficulty
+	(LessonStatus)(0),                      // 5: lp.v1.LessonStatus
+	(*BasePage)(nil),                       // 6: lp.v1.BasePage
+	(*CreateBasePage)(nil),                 // 7: lp.v1.CreateBasePage
+	(*UpdateBasePage)(nil),                 // 8: lp.v1.UpdateBasePage
+	(*ImagePage)(nil),                      // 9: lp.v1.ImagePage
+	(*CreateImagePage)(nil),                // 10: lp.v1.CreateImagePage
+	(*UpdateImagePage)(nil),                // 11: lp.v1.UpdateImagePage
+	(*VideoPage)(nil),                      // 12: lp.v1.VideoPage
+	(*CreateVideoPage)(nil),                // 13: lp.v1.CreateVideoPage
+	(*UpdateVideoPage)(nil),                // 14: lp.v1.UpdateVideoPage
+	(*PDFPage)(nil),                        // 15: lp.v1.PDFPage
+	(*CreatePDFPage)(nil),                  // 16: lp.v1.CreatePDFPage
+	(*UpdatePDFPage)(nil),                  // 17: lp.v1.UpdatePDFPage
+	(*CreatePageRequest)(nil),              // 18: lp.v1.CreatePageRequest
+	(*CreatePageResponse)(nil),             // 19: lp.v1.CreatePageResponse
+	(*GetPageRequest)(nil),                 // 20: lp.v1.GetPageRequest
+	(*GetPageResponse)(nil),                // 21: lp.v1.GetPageResponse
+	(*GetPagesRequest)(nil),                // 22: lp.v1.GetPagesRequest
+	(*GetPagesResponse)(nil),               // 23: lp.v1.GetPagesResponse
+	(*UpdatePageRequest)(nil),              // 24: lp.v1.UpdatePageRequest
+	(*UpdatePageResponse)(nil),             // 25: lp.v1.UpdatePageResponse
+	(*DeletePageRequest)(nil),              // 26: lp.v1.DeletePageRequest
+	(*DeletePageResponse)(nil),             // 27: lp.v1.DeletePageResponse
+	(*ReorderPagesRequest)(nil),            // 28: lp.v1.ReorderPagesRequest
+	(*ReorderPagesResponse)(nil),           // 29: lp.v1.ReorderPagesResponse
+	(*MovePageRequest)(nil),                // 30: lp.v1.MovePageRequest
+	(*MovePageResponse)(nil),               // 31: lp.v1.MovePageResponse
+	(*Channel)(nil),                        // 32: lp.v1.Channel
+	(*ChannelWithPlans)(nil),               // 33: lp.v1.ChannelWithPlans
+	(*CreateChannelRequest)(nil),           // 34: lp.v1.CreateChannelRequest
+	(*CreateChannelResponse)(nil),          // 35: lp.v1.CreateChannelResponse
+	(*GetChannelRequest)(nil),              // 36: lp.v1.GetChannelRequest
+	(*GetChannelResponse)(nil),             // 37: lp.v1.GetChannelResponse
+	(*GetChannelsRequest)(nil),             // 38: lp.v1.GetChannelsRequest
+	(*GetChannelsResponse)(nil),            // 39: lp.v1.GetChannelsResponse
+	(*UpdateChannelRequest)(nil),           // 40: lp.v1.UpdateChannelRequest
+	(*UpdateChannelResponse)(nil),          // 41: lp.v1.UpdateChannelResponse
+	(*DeleteChannelRequest)(nil),           // 42: lp.v1.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),          // 43: lp.v1.DeleteChannelResponse
+	(*GetPlanChannelsRequest)(nil),         // 44: lp.v1.GetPlanChannelsRequest
+	(*GetPlanChannelsResponse)(nil),        // 45: lp.v1.GetPlanChannelsResponse
+	(*Plan)(nil),                           // 46: lp.v1.Plan
+	(*CreatePlanRequest)(nil),              // 47: lp.v1.CreatePlanRequest
+	(*CreatePlanResponse)(nil),             // 48: lp.v1.CreatePlanResponse
+	(*GetPlanRequest)(nil),                 // 49: lp.v1.GetPlanRequest
+	(*GetPlanResponse)(nil),                // 50: lp.v1.GetPlanResponse
+	(*GetPlansRequest)(nil),                // 51: lp.v1.GetPlansRequest
+	(*GetPlansResponse)(nil),               // 52: lp.v1.GetPlansResponse
+	(*UpdatePlanRequest)(nil),              // 53: lp.v1.UpdatePlanRequest
+	(*UpdatePlanResponse)(nil),             // 54: lp.v1.UpdatePlanResponse
+	(*DeletePlanRequest)(nil),              // 55: lp.v1.DeletePlanRequest
+	(*DeletePlanResponse)(nil),             // 56: lp.v1.DeletePlanResponse
+	(*ReorderPlansRequest)(nil),            // 57: lp.v1.ReorderPlansRequest
+	(*ReorderPlansResponse)(nil),           // 58: lp.v1.ReorderPlansResponse
+	(*AttachPlanToChannelRequest)(nil),     // 59: lp.v1.AttachPlanToChannelRequest
+	(*AttachPlanToChannelResponse)(nil),    // 60: lp.v1.AttachPlanToChannelResponse
+	(*DetachPlanFromChannelRequest)(nil),   // 61: lp.v1.DetachPlanFromChannelRequest
+	(*DetachPlanFromChannelResponse)(nil),  // 62: lp.v1.DetachPlanFromChannelResponse
+	(*ClonePlanRequest)(nil),               // 63: lp.v1.ClonePlanRequest
+	(*ClonePlanResponse)(nil),              // 64: lp.v1.ClonePlanResponse
+	(*Lesson)(nil),                         // 65: lp.v1.Lesson
+	(*LearningObjectives)(nil),             // 66: lp.v1.LearningObjectives
+	(*CreateLessonRequest)(nil),            // 67: lp.v1.CreateLessonRequest
+	(*CreateLessonResponse)(nil),           // 68: lp.v1.CreateLessonResponse
+	(*GetLessonRequest)(nil),               // 69: lp.v1.GetLessonRequest
+	(*GetLessonResponse)(nil),              // 70: lp.v1.GetLessonResponse
+	(*GetLessonsRequest)(nil),              // 71: lp.v1.GetLessonsRequest
+	(*GetLessonsResponse)(nil),             // 72: lp.v1.GetLessonsResponse
+	(*UpdateLessonRequest)(nil),            // 73: lp.v1.UpdateLessonRequest
+	(*UpdateLessonResponse)(nil),           // 74: lp.v1.UpdateLessonResponse
+	(*DeleteLessonRequest)(nil),            // 75: lp.v1.DeleteLessonRequest
+	(*DeleteLessonResponse)(nil),           // 76: lp.v1.DeleteLessonResponse
+	(*ReorderLessonsRequest)(nil),          // 77: lp.v1.ReorderLessonsRequest
+	(*ReorderLessonsResponse)(nil),         // 78: lp.v1.ReorderLessonsResponse
+	(*AttachLessonToPlanRequest)(nil),      // 79: lp.v1.AttachLessonToPlanRequest
+	(*AttachLessonToPlanResponse)(nil),     // 80: lp.v1.AttachLessonToPlanResponse
+	(*DetachLessonFromPlanRequest)(nil),    // 81: lp.v1.DetachLessonFromPlanRequest
+	(*DetachLessonFromPlanResponse)(nil),   // 82: lp.v1.DetachLessonFromPlanResponse
+	(*CloneLessonRequest)(nil),             // 83: lp.v1.CloneLessonRequest
+	(*CloneLessonResponse)(nil),            // 84: lp.v1.CloneLessonResponse
+	(*MoveLessonRequest)(nil),              // 85: lp.v1.MoveLessonRequest
+	(*MoveLessonResponse)(nil),             // 86: lp.v1.MoveLessonResponse
+	(*ShortAnswerQuestion)(nil),            // 87: lp.v1.ShortAnswerQuestion
+	(*MultiSelectQuestion)(nil),            // 88: lp.v1.MultiSelectQuestion
+	(*UpdateMultiSelectQuestion)(nil),      // 89: lp.v1.UpdateMultiSelectQuestion
+	(*UpdateShortAnswerQuestion)(nil),      // 90: lp.v1.UpdateShortAnswerQuestion
+	(*TrueFalseQuestion)(nil),              // 91: lp.v1.TrueFalseQuestion
+	(*UpdateTrueFalseQuestion)(nil),        // 92: lp.v1.UpdateTrueFalseQuestion
+	(*NumericQuestion)(nil),                // 93: lp.v1.NumericQuestion
+	(*UpdateNumericQuestion)(nil),          // 94: lp.v1.UpdateNumericQuestion
+	(*FillBlank)(nil),                      // 95: lp.v1.FillBlank
+	(*FillBlanksQuestion)(nil),             // 96: lp.v1.FillBlanksQuestion
+	(*UpdateFillBlanksQuestion)(nil),       // 97: lp.v1.UpdateFillBlanksQuestion
+	(*OrderingQuestion)(nil),               // 98: lp.v1.OrderingQuestion
+	(*UpdateOrderingQuestion)(nil),         // 99: lp.v1.UpdateOrderingQuestion
+	(*MatchingPair)(nil),                   // 100: lp.v1.MatchingPair
+	(*MatchingQuestion)(nil),               // 101: lp.v1.MatchingQuestion
+	(*UpdateMatchingQuestion)(nil),         // 102: lp.v1.UpdateMatchingQuestion
+	(*Option)(nil),                         // 103: lp.v1.Option
+	(*OptionFeedback)(nil),                 // 104: lp.v1.OptionFeedback
+	(*QuestionPage)(nil),                   // 105: lp.v1.QuestionPage
+	(*CreateQuestionPageRequest)(nil),      // 106: lp.v1.CreateQuestionPageRequest
+	(*CreateQuestionPageResponse)(nil),     // 107: lp.v1.CreateQuestionPageResponse
+	(*GetQuestionPageRequest)(nil),         // 108: lp.v1.GetQuestionPageRequest
+	(*GetQuestionPageResponse)(nil),        // 109: lp.v1.GetQuestionPageResponse
+	(*UpdateQuestionPageRequest)(nil),      // 110: lp.v1.UpdateQuestionPageRequest
+	(*UpdateQuestionPageResponse)(nil),     // 111: lp.v1.UpdateQuestionPageResponse
+	(*DeleteQuestionPageRequest)(nil),      // 112: lp.v1.DeleteQuestionPageRequest
+	(*DeleteQuestionPageResponse)(nil),     // 113: lp.v1.DeleteQuestionPageResponse
+	(*GetQuestionPagesRequest)(nil),        // 114: lp.v1.GetQuestionPagesRequest
+	(*GetQuestionPagesResponse)(nil),       // 115: lp.v1.GetQuestionPagesResponse
+	(*QuestionBank)(nil),                   // 116: lp.v1.QuestionBank
+	(*QuestionBankItem)(nil),               // 117: lp.v1.QuestionBankItem
+	(*QuestionDraw)(nil),                   // 118: lp.v1.QuestionDraw
+	(*CreateQuestionBankRequest)(nil),      // 119: lp.v1.CreateQuestionBankRequest
+	(*CreateQuestionBankResponse)(nil),     // 120: lp.v1.CreateQuestionBankResponse
+	(*GetQuestionBankRequest)(nil),         // 121: lp.v1.GetQuestionBankRequest
+	(*GetQuestionBankResponse)(nil),        // 122: lp.v1.GetQuestionBankResponse
+	(*GetQuestionBanksRequest)(nil),        // 123: lp.v1.GetQuestionBanksRequest
+	(*GetQuestionBanksResponse)(nil),       // 124: lp.v1.GetQuestionBanksResponse
+	(*DeleteQuestionBankRequest)(nil),      // 125: lp.v1.DeleteQuestionBankRequest
+	(*DeleteQuestionBankResponse)(nil),     // 126: lp.v1.DeleteQuestionBankResponse
+	(*AddQuestionToBankRequest)(nil),       // 127: lp.v1.AddQuestionToBankRequest
+	(*AddQuestionToBankResponse)(nil),      // 128: lp.v1.AddQuestionToBankResponse
+	(*RemoveQuestionFromBankRequest)(nil),  // 129: lp.v1.RemoveQuestionFromBankRequest
+	(*RemoveQuestionFromBankResponse)(nil), // 130: lp.v1.RemoveQuestionFromBankResponse
+	(*SetLessonQuestionDrawsRequest)(nil),  // 131: lp.v1.SetLessonQuestionDrawsRequest
+	(*SetLessonQuestionDrawsResponse)(nil), // 132: lp.v1.SetLessonQuestionDrawsResponse
+	(*GetLessonQuestionDrawsRequest)(nil),  // 133: lp.v1.GetLessonQuestionDrawsRequest
+	(*GetLessonQuestionDrawsResponse)(nil), // 134: lp.v1.GetLessonQuestionDrawsResponse
+	(*CreateAttemptRequest)(nil),           // 135: lp.v1.CreateAttemptRequest
+	(*CreateAttemptResponse)(nil),          // 136: lp.v1.CreateAttemptResponse
+	(*SubmitQuestionAnswerRequest)(nil),    // 137: lp.v1.SubmitQuestionAnswerRequest
+	(*SubmitQuestionAnswerResponse)(nil),   // 138: lp.v1.SubmitQuestionAnswerResponse
+	(*CompleteAttemptRequest)(nil),         // 139: lp.v1.CompleteAttemptRequest
+	(*CompleteAttemptResponse)(nil),        // 140: lp.v1.CompleteAttemptResponse
+	(*LessonAttempt)(nil),                  // 141: lp.v1.LessonAttempt
+	(*PageAttempt)(nil),                    // 142: lp.v1.PageAttempt
+	(*GetAttemptRequest)(nil),              // 143: lp.v1.GetAttemptRequest
+	(*GetAttemptResponse)(nil),             // 144: lp.v1.GetAttemptResponse
+	(*ListAttemptsRequest)(nil),            // 145: lp.v1.ListAttemptsRequest
+	(*ListAttemptsResponse)(nil),           // 146: lp.v1.ListAttemptsResponse
+	(*MarkPageViewedRequest)(nil),          // 147: lp.v1.MarkPageViewedRequest
+	(*MarkPageViewedResponse)(nil),         // 148: lp.v1.MarkPageViewedResponse
+	(*LessonProgress)(nil),                 // 149: lp.v1.LessonProgress
+	(*PlanProgress)(nil),                   // 150: lp.v1.PlanProgress
+	(*GetPlanProgressRequest)(nil),         // 151: lp.v1.GetPlanProgressRequest
+	(*GetPlanProgressResponse)(nil),        // 152: lp.v1.GetPlanProgressResponse
+	(*GetChannelProgressRequest)(nil),      // 153: lp.v1.GetChannelProgressRequest
+	(*GetChannelProgressResponse)(nil),     // 154: lp.v1.GetChannelProgressResponse
+	(*timestamppb.Timestamp)(nil),          // 155: google.protobuf.Timestamp
+}
+var file_lp_proto_depIdxs = []int32{
+	155, // 0: lp.v1.BasePage.created_at:type_name -> google.protobuf.Timestamp
+	155, // 1: lp.v1.BasePage.modified:type_name -> google.protobuf.Timestamp
+	0,   // 2: lp.v1.BasePage.content_type:type_name -> lp.v1.ContentType
+	6,   // 3: lp.v1.ImagePage.base:type_name -> lp.v1.BasePage
+	7,   // 4: lp.v1.CreateImagePage.base:type_name -> lp.v1.CreateBasePage
+	8,   // 5: lp.v1.UpdateImagePage.base:type_name -> lp.v1.UpdateBasePage
+	6,   // 6: lp.v1.VideoPage.base:type_name -> lp.v1.BasePage
+	7,   // 7: lp.v1.CreateVideoPage.base:type_name -> lp.v1.CreateBasePage
+	8,   // 8: lp.v1.UpdateVideoPage.base:type_name -> lp.v1.UpdateBasePage
+	6,   // 9: lp.v1.PDFPage.base:type_name -> lp.v1.BasePage
+	7,   // 10: lp.v1.CreatePDFPage.base:type_name -> lp.v1.CreateBasePage
+	8,   // 11: lp.v1.UpdatePDFPage.base:type_name -> lp.v1.UpdateBasePage
+	10,  // 12: lp.v1.CreatePageRequest.image_page:type_name -> lp.v1.CreateImagePage
+	13,  // 13: lp.v1.CreatePageRequest.video_page:type_name -> lp.v1.CreateVideoPage
+	16,  // 14: lp.v1.CreatePageRequest.pdf_page:type_name -> lp.v1.CreatePDFPage
+	0,   // 15: lp.v1.GetPageRequest.content_type:type_name -> lp.v1.ContentType
+	9,   // 16: lp.v1.GetPageResponse.image_page:type_name -> lp.v1.ImagePage
+	12,  // 17: lp.v1.GetPageResponse.video_page:type_name -> lp.v1.VideoPage
+	15,  // 18: lp.v1.GetPageResponse.pdf_page:type_name -> lp.v1.PDFPage
+	6,   // 19: lp.v1.GetPagesResponse.pages:type_name -> lp.v1.BasePage
+	11,  // 20: lp.v1.UpdatePageRequest.image_page:type_name -> lp.v1.UpdateImagePage
+	14,  // 21: lp.v1.UpdatePageRequest.video_page:type_name -> lp.v1.UpdateVideoPage
+	17,  // 22: lp.v1.UpdatePageRequest.pdf_page:type_name -> lp.v1.UpdatePDFPage
+	155, // 23: lp.v1.Channel.created_at:type_name -> google.protobuf.Timestamp
+	155, // 24: lp.v1.Channel.modified:type_name -> google.protobuf.Timestamp
+	155, // 25: lp.v1.ChannelWithPlans.created_at:type_name -> google.protobuf.Timestamp
+	155, // 26: lp.v1.ChannelWithPlans.modified:type_name -> google.protobuf.Timestamp
+	46,  // 27: lp.v1.ChannelWithPlans.plans:type_name -> lp.v1.Plan
+	33,  // 28: lp.v1.GetChannelResponse.channel:type_name -> lp.v1.ChannelWithPlans
+	32,  // 29: lp.v1.GetChannelsResponse.channels:type_name -> lp.v1.Channel
+	32,  // 30: lp.v1.GetPlanChannelsResponse.channels:type_name -> lp.v1.Channel
+	155, // 31: lp.v1.Plan.created_at:type_name -> google.protobuf.Timestamp
+	155, // 32: lp.v1.Plan.modified:type_name -> google.protobuf.Timestamp
+	46,  // 33: lp.v1.GetPlanResponse.plan:type_name -> lp.v1.Plan
+	46,  // 34: lp.v1.GetPlansResponse.plans:type_name -> lp.v1.Plan
+	155, // 35: lp.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
+	155, // 36: lp.v1.Lesson.modified:type_name -> google.protobuf.Timestamp
+	4,   // 37: lp.v1.Lesson.difficulty:type_name -> lp.v1.Difficulty
+	4,   // 38: lp.v1.CreateLessonRequest.difficulty:type_name -> lp.v1.Difficulty
+	65,  // 39: lp.v1.GetLessonResponse.lesson:type_name -> lp.v1.Lesson
+	65,  // 40: lp.v1.GetLessonsResponse.lessons:type_name -> lp.v1.Lesson
+	4,   // 41: lp.v1.UpdateLessonRequest.difficulty:type_name -> lp.v1.Difficulty
+	66,  // 42: lp.v1.UpdateLessonRequest.learning_objectives:type_name -> lp.v1.LearningObjectives
+	2,   // 43: lp.v1.ShortAnswerQuestion.match_mode:type_name -> lp.v1.MatchMode
+	3,   // 44: lp.v1.MultiSelectQuestion.grading_mode:type_name -> lp.v1.GradingMode
+	3,   // 45: lp.v1.UpdateMultiSelectQuestion.grading_mode:type_name -> lp.v1.GradingMode
+	2,   // 46: lp.v1.UpdateShortAnswerQuestion.match_mode:type_name -> lp.v1.MatchMode
+	95,  // 47: lp.v1.FillBlanksQuestion.blanks:type_name -> lp.v1.FillBlank
+	2,   // 48: lp.v1.FillBlanksQuestion.match_mode:type_name -> lp.v1.MatchMode
+	95,  // 49: lp.v1.UpdateFillBlanksQuestion.blanks:type_name -> lp.v1.FillBlank
+	2,   // 50: lp.v1.UpdateFillBlanksQuestion.match_mode:type_name -> lp.v1.MatchMode
+	100, // 51: lp.v1.MatchingQuestion.pairs:type_name -> lp.v1.MatchingPair
+	100, // 52: lp.v1.UpdateMatchingQuestion.pairs:type_name -> lp.v1.MatchingPair
+	155, // 53: lp.v1.QuestionPage.created_at:type_name -> google.protobuf.Timestamp
+	155, // 54: lp.v1.QuestionPage.modified:type_name -> google.protobuf.Timestamp
+	0,   // 55: lp.v1.QuestionPage.content_type:type_name -> lp.v1.ContentType
+	1,   // 56: lp.v1.QuestionPage.question_type:type_name -> lp.v1.QuestionType
+	103, // 57: lp.v1.QuestionPage.options:type_name -> lp.v1.Option
+	87,  // 58: lp.v1.QuestionPage.short_answer:type_name -> lp.v1.ShortAnswerQuestion
+	88,  // 59: lp.v1.QuestionPage.multi_select:type_name -> lp.v1.MultiSelectQuestion
+	91,  // 60: lp.v1.QuestionPage.true_false:type_name -> lp.v1.TrueFalseQuestion
+	93,  // 61: lp.v1.QuestionPage.numeric:type_name -> lp.v1.NumericQuestion
+	96,  // 62: lp.v1.QuestionPage.fill_blanks:type_name -> lp.v1.FillBlanksQuestion
+	98,  // 63: lp.v1.QuestionPage.ordering:type_name -> lp.v1.OrderingQuestion
+	101, // 64: lp.v1.QuestionPage.matching:type_name -> lp.v1.MatchingQuestion
+	103, // 65: lp.v1.CreateQuestionPageRequest.options:type_name -> lp.v1.Option
+	87,  // 66: lp.v1.CreateQuestionPageRequest.short_answer:type_name -> lp.v1.ShortAnswerQuestion
+	88,  // 67: lp.v1.CreateQuestionPageRequest.multi_select:type_name -> lp.v1.MultiSelectQuestion
+	91,  // 68: lp.v1.CreateQuestionPageRequest.true_false:type_name -> lp.v1.TrueFalseQuestion
+	93,  // 69: lp.v1.CreateQuestionPageRequest.numeric:type_name -> lp.v1.NumericQuestion
+	96,  // 70: lp.v1.CreateQuestionPageRequest.fill_blanks:type_name -> lp.v1.FillBlanksQuestion
+	98,  // 71: lp.v1.CreateQuestionPageRequest.ordering:type_name -> lp.v1.OrderingQuestion
+	101, // 72: lp.v1.CreateQuestionPageRequest.matching:type_name -> lp.v1.MatchingQuestion
+	105, // 73: lp.v1.GetQuestionPageResponse.question_page:type_name -> lp.v1.QuestionPage
+	103, // 74: lp.v1.UpdateQuestionPageRequest.options:type_name -> lp.v1.Option
+	90,  // 75: lp.v1.UpdateQuestionPageRequest.short_answer:type_name -> lp.v1.UpdateShortAnswerQuestion
+	89,  // 76: lp.v1.UpdateQuestionPageRequest.multi_select:type_name -> lp.v1.UpdateMultiSelectQuestion
+	92,  // 77: lp.v1.UpdateQuestionPageRequest.true_false:type_name -> lp.v1.UpdateTrueFalseQuestion
+	94,  // 78: lp.v1.UpdateQuestionPageRequest.numeric:type_name -> lp.v1.UpdateNumericQuestion
+	97,  // 79: lp.v1.UpdateQuestionPageRequest.fill_blanks:type_name -> lp.v1.UpdateFillBlanksQuestion
+	99,  // 80: lp.v1.UpdateQuestionPageRequest.ordering:type_name -> lp.v1.UpdateOrderingQuestion
+	102, // 81: lp.v1.UpdateQuestionPageRequest.matching:type_name -> lp.v1.UpdateMatchingQuestion
+	105, // 82: lp.v1.GetQuestionPagesResponse.question_pages:type_name -> lp.v1.QuestionPage
+	155, // 83: lp.v1.QuestionBank.created_at:type_name -> google.protobuf.Timestamp
+	155, // 84: lp.v1.QuestionBank.modified:type_name -> google.protobuf.Timestamp
+	4,   // 85: lp.v1.QuestionBankItem.difficulty:type_name -> lp.v1.Difficulty
+	4,   // 86: lp.v1.QuestionDraw.difficulty:type_name -> lp.v1.Difficulty
+	116, // 87: lp.v1.GetQuestionBankResponse.bank:type_name -> lp.v1.QuestionBank
+	117, // 88: lp.v1.GetQuestionBankResponse.items:type_name -> lp.v1.QuestionBankItem
+	116, // 89: lp.v1.GetQuestionBanksResponse.banks:type_name -> lp.v1.QuestionBank
+	4,   // 90: lp.v1.AddQuestionToBankRequest.difficulty:type_name -> lp.v1.Difficulty
+	118, // 91: lp.v1.SetLessonQuestionDrawsRequest.draws:type_name -> lp.v1.QuestionDraw
+	118, // 92: lp.v1.GetLessonQuestionDrawsResponse.draws:type_name -> lp.v1.QuestionDraw
+	100, // 93: lp.v1.SubmitQuestionAnswerRequest.matched_pairs:type_name -> lp.v1.MatchingPair
+	104, // 94: lp.v1.SubmitQuestionAnswerResponse.feedback:type_name -> lp.v1.OptionFeedback
+	155, // 95: lp.v1.LessonAttempt.start_time:type_name -> google.protobuf.Timestamp
+	155, // 96: lp.v1.LessonAttempt.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 97: lp.v1.PageAttempt.content_type:type_name -> lp.v1.ContentType
+	1,   // 98: lp.v1.PageAttempt.question_type:type_name -> lp.v1.QuestionType
+	155, // 99: lp.v1.PageAttempt.created_at:type_name -> google.protobuf.Timestamp
+	155, // 100: lp.v1.PageAttempt.modified:type_name -> google.protobuf.Timestamp
+	155, // 101: lp.v1.PageAttempt.viewed_at:type_name -> google.protobuf.Timestamp
+	118, // 102: lp.v1.PageAttempt.draw:type_name -> lp.v1.QuestionDraw
+	141, // 103: lp.v1.GetAttemptResponse.attempt:type_name -> lp.v1.LessonAttempt
+	142, // 104: lp.v1.GetAttemptResponse.page_attempts:type_name -> lp.v1.PageAttempt
+	141, // 105: lp.v1.ListAttemptsResponse.attempts:type_name -> lp.v1.LessonAttempt
+	5,   // 106: lp.v1.LessonProgress.status:type_name -> lp.v1.LessonStatus
+	155, // 107: lp.v1.LessonProgress.last_attempt_at:type_name -> google.protobuf.Timestamp
+	149, // 108: lp.v1.PlanProgress.lessons:type_name -> lp.v1.LessonProgress
+	150, // 109: lp.v1.GetPlanProgressResponse.progress:type_name -> lp.v1.PlanProgress
+	150, // 110: lp.v1.GetChannelProgressResponse.plans:type_name -> lp.v1.PlanProgress
+	34,  // 111: lp.v1.LearningPlatform.CreateChannel:input_type -> lp.v1.CreateChannelRequest
+	36,  // 112: lp.v1.LearningPlatform.GetChannel:input_type -> lp.v1.GetChannelRequest
+	38,  // 113: lp.v1.LearningPlatform.GetChannels:input_type -> lp.v1.GetChannelsRequest
+	40,  // 114: lp.v1.LearningPlatform.UpdateChannel:input_type -> lp.v1.UpdateChannelRequest
+	42,  // 115: lp.v1.LearningPlatform.DeleteChannel:input_type -> lp.v1.DeleteChannelRequest
+	44,  // 116: lp.v1.LearningPlatform.GetPlanChannels:input_type -> lp.v1.GetPlanChannelsRequest
+	47,  // 117: lp.v1.LearningPlatform.CreatePlan:input_type -> lp.v1.CreatePlanRequest
+	49,  // 118: lp.v1.LearningPlatform.GetPlan:input_type -> lp.v1.GetPlanRequest
+	51,  // 119: lp.v1.LearningPlatform.GetPlans:input_type -> lp.v1.GetPlansRequest
+	53,  // 120: lp.v1.LearningPlatform.UpdatePlan:input_type -> lp.v1.UpdatePlanRequest
+	55,  // 121: lp.v1.LearningPlatform.DeletePlan:input_type -> lp.v1.DeletePlanRequest
+	57,  // 122: lp.v1.LearningPlatform.ReorderPlans:input_type -> lp.v1.ReorderPlansRequest
+	59,  // 123: lp.v1.LearningPlatform.AttachPlanToChannel:input_type -> lp.v1.AttachPlanToChannelRequest
+	61,  // 124: lp.v1.LearningPlatform.DetachPlanFromChannel:input_type -> lp.v1.DetachPlanFromChannelRequest
+	63,  // 125: lp.v1.LearningPlatform.ClonePlan:input_type -> lp.v1.ClonePlanRequest
+	67,  // 126: lp.v1.LearningPlatform.CreateLesson:input_type -> lp.v1.CreateLessonRequest
+	69,  // 127: lp.v1.LearningPlatform.GetLesson:input_type -> lp.v1.GetLessonRequest
+	71,  // 128: lp.v1.LearningPlatform.GetLessons:input_type -> lp.v1.GetLessonsRequest
+	73,  // 129: lp.v1.LearningPlatform.UpdateLesson:input_type -> lp.v1.UpdateLessonRequest
+	75,  // 130: lp.v1.LearningPlatform.DeleteLesson:input_type -> lp.v1.DeleteLessonRequest
+	77,  // 131: lp.v1.LearningPlatform.ReorderLessons:input_type -> lp.v1.ReorderLessonsRequest
+	79,  // 132: lp.v1.LearningPlatform.AttachLessonToPlan:input_type -> lp.v1.AttachLessonToPlanRequest
+	81,  // 133: lp.v1.LearningPlatform.DetachLessonFromPlan:input_type -> lp.v1.DetachLessonFromPlanRequest
+	83,  // 134: lp.v1.LearningPlatform.CloneLesson:input_type -> lp.v1.CloneLessonRequest
+	85,  // 135: lp.v1.LearningPlatform.MoveLesson:input_type -> lp.v1.MoveLessonRequest
+	18,  // 136: lp.v1.LearningPlatform.CreatePage:input_type -> lp.v1.CreatePageRequest
+	20,  // 137: lp.v1.LearningPlatform.GetPage:input_type -> lp.v1.GetPageRequest
+	22,  // 138: lp.v1.LearningPlatform.GetPages:input_type -> lp.v1.GetPagesRequest
+	24,  // 139: lp.v1.LearningPlatform.UpdatePage:input_type -> lp.v1.UpdatePageRequest
+	26,  // 140: lp.v1.LearningPlatform.DeletePage:input_type -> lp.v1.DeletePageRequest
+	28,  // 141: lp.v1.LearningPlatform.ReorderPages:input_type -> lp.v1.ReorderPagesRequest
+	30,  // 142: lp.v1.LearningPlatform.MovePage:input_type -> lp.v1.MovePageRequest
+	106, // 143: lp.v1.LearningPlatform.CreateQuestionPage:input_type -> lp.v1.CreateQuestionPageRequest
+	108, // 144: lp.v1.LearningPlatform.GetQuestionPage:input_type -> lp.v1.GetQuestionPageRequest
+	110, // 145: lp.v1.LearningPlatform.UpdateQuestionPage:input_type -> lp.v1.UpdateQuestionPageRequest
+	112, // 146: lp.v1.LearningPlatform.DeleteQuestionPage:input_type -> lp.v1.DeleteQuestionPageRequest
+	114, // 147: lp.v1.LearningPlatform.GetQuestionPages:input_type -> lp.v1.GetQuestionPagesRequest
+	119, // 148: lp.v1.LearningPlatform.CreateQuestionBank:input_type -> lp.v1.CreateQuestionBankRequest
+	121, // 149: lp.v1.LearningPlatform.GetQuestionBank:input_type -> lp.v1.GetQuestionBankRequest
+	123, // 150: lp.v1.LearningPlatform.GetQuestionBanks:input_type -> lp.v1.GetQuestionBanksRequest
+	125, // 151: lp.v1.LearningPlatform.DeleteQuestionBank:input_type -> lp.v1.DeleteQuestionBankRequest
+	127, // 152: lp.v1.LearningPlatform.AddQuestionToBank:input_type -> lp.v1.AddQuestionToBankRequest
+	129, // 153: lp.v1.LearningPlatform.RemoveQuestionFromBank:input_type -> lp.v1.RemoveQuestionFromBankRequest
+	131, // 154: lp.v1.LearningPlatform.SetLessonQuestionDraws:input_type -> lp.v1.SetLessonQuestionDrawsRequest
+	133, // 155: lp.v1.LearningPlatform.GetLessonQuestionDraws:input_type -> lp.v1.GetLessonQuestionDrawsRequest
+	135, // 156: lp.v1.LearningPlatform.CreateAttempt:input_type -> lp.v1.CreateAttemptRequest
+	137, // 157: lp.v1.LearningPlatform.SubmitQuestionAnswer:input_type -> lp.v1.SubmitQuestionAnswerRequest
+	139, // 158: lp.v1.LearningPlatform.CompleteAttempt:input_type -> lp.v1.CompleteAttemptRequest
+	143, // 159: lp.v1.LearningPlatform.GetAttempt:input_type -> lp.v1.GetAttemptRequest
+	145, // 160: lp.v1.LearningPlatform.ListAttempts:input_type -> lp.v1.ListAttemptsRequest
+	147, // 161: lp.v1.LearningPlatform.MarkPageViewed:input_type -> lp.v1.MarkPageViewedRequest
+	151, // 162: lp.v1.LearningPlatform.GetPlanProgress:input_type -> lp.v1.GetPlanProgressRequest
+	153, // 163: lp.v1.LearningPlatform.GetChannelProgress:input_type -> lp.v1.GetChannelProgressRequest
+	35,  // 164: lp.v1.LearningPlatform.CreateChannel:output_type -> lp.v1.CreateChannelResponse
+	37,  // 165: lp.v1.LearningPlatform.GetChannel:output_type -> lp.v1.GetChannelResponse
+	39,  // 166: lp.v1.LearningPlatform.GetChannels:output_type -> lp.v1.GetChannelsResponse
+	41,  // 167: lp.v1.LearningPlatform.UpdateChannel:output_type -> lp.v1.UpdateChannelResponse
+	43,  // 168: lp.v1.LearningPlatform.DeleteChannel:output_type -> lp.v1.DeleteChannelResponse
+	45,  // 169: lp.v1.LearningPlatform.GetPlanChannels:output_type -> lp.v1.GetPlanChannelsResponse
+	48,  // 170: lp.v1.LearningPlatform.CreatePlan:output_type -> lp.v1.CreatePlanResponse
+	50,  // 171: lp.v1.LearningPlatform.GetPlan:output_type -> lp.v1.GetPlanResponse
+	52,  // 172: lp.v1.LearningPlatform.GetPlans:output_type -> lp.v1.GetPlansResponse
+	54,  // 173: lp.v1.LearningPlatform.UpdatePlan:output_type -> lp.v1.UpdatePlanResponse
+	56,  // 174: lp.v1.LearningPlatform.DeletePlan:output_type -> lp.v1.DeletePlanResponse
+	58,  // 175: lp.v1.LearningPlatform.ReorderPlans:output_type -> lp.v1.ReorderPlansResponse
+	60,  // 176: lp.v1.LearningPlatform.AttachPlanToChannel:output_type -> lp.v1.AttachPlanToChannelResponse
+	62,  // 177: lp.v1.LearningPlatform.DetachPlanFromChannel:output_type -> lp.v1.DetachPlanFromChannelResponse
+	64,  // 178: lp.v1.LearningPlatform.ClonePlan:output_type -> lp.v1.ClonePlanResponse
+	68,  // 179: lp.v1.LearningPlatform.CreateLesson:output_type -> lp.v1.CreateLessonResponse
+	70,  // 180: lp.v1.LearningPlatform.GetLesson:output_type -> lp.v1.GetLessonResponse
+	72,  // 181: lp.v1.LearningPlatform.GetLessons:output_type -> lp.v1.GetLessonsResponse
+	74,  // 182: lp.v1.LearningPlatform.UpdateLesson:output_type -> lp.v1.UpdateLessonResponse
+	76,  // 183: lp.v1.LearningPlatform.DeleteLesson:output_type -> lp.v1.DeleteLessonResponse
+	78,  // 184: lp.v1.LearningPlatform.ReorderLessons:output_type -> lp.v1.ReorderLessonsResponse
+	80,  // 185: lp.v1.LearningPlatform.AttachLessonToPlan:output_type -> lp.v1.AttachLessonToPlanResponse
+	82,  // 186: lp.v1.LearningPlatform.DetachLessonFromPlan:output_type -> lp.v1.DetachLessonFromPlanResponse
+	84,  // 187: lp.v1.LearningPlatform.CloneLesson:output_type -> lp.v1.CloneLessonResponse
+	86,  // 188: lp.v1.LearningPlatform.MoveLesson:output_type -> lp.v1.MoveLessonResponse
+	19,  // 189: lp.v1.LearningPlatform.CreatePage:output_type -> lp.v1.CreatePageResponse
+	21,  // 190: lp.v1.LearningPlatform.GetPage:output_type -> lp.v1.GetPageResponse
+	23,  // 191: lp.v1.LearningPlatform.GetPages:output_type -> lp.v1.GetPagesResponse
+	25,  // 192: lp.v1.LearningPlatform.UpdatePage:output_type -> lp.v1.UpdatePageResponse
+	27,  // 193: lp.v1.LearningPlatform.DeletePage:output_type -> lp.v1.DeletePageResponse
+	29,  // 194: lp.v1.LearningPlatform.ReorderPages:output_type -> lp.v1.ReorderPagesResponse
+	31,  // 195: lp.v1.LearningPlatform.MovePage:output_type -> lp.v1.MovePageResponse
+	107, // 196: lp.v1.LearningPlatform.CreateQuestionPage:output_type -> lp.v1.CreateQuestionPageResponse
+	109, // 197: lp.v1.LearningPlatform.GetQuestionPage:output_type -> lp.v1.GetQuestionPageResponse
+	111, // 198: lp.v1.LearningPlatform.UpdateQuestionPage:output_type -> lp.v1.UpdateQuestionPageResponse
+	113, // 199: lp.v1.LearningPlatform.DeleteQuestionPage:output_type -> lp.v1.DeleteQuestionPageResponse
+	115, // 200: lp.v1.LearningPlatform.GetQuestionPages:output_type -> lp.v1.GetQuestionPagesResponse
+	120, // 201: lp.v1.LearningPlatform.CreateQuestionBank:output_type -> lp.v1.CreateQuestionBankResponse
+	122, // 202: lp.v1.LearningPlatform.GetQuestionBank:output_type -> lp.v1.GetQuestionBankResponse
+	124, // 203: lp.v1.LearningPlatform.GetQuestionBanks:output_type -> lp.v1.GetQuestionBanksResponse
+	126, // 204: lp.v1.LearningPlatform.DeleteQuestionBank:output_type -> lp.v1.DeleteQuestionBankResponse
+	128, // 205: lp.v1.LearningPlatform.AddQuestionToBank:output_type -> lp.v1.AddQuestionToBankResponse
+	130, // 206: lp.v1.LearningPlatform.RemoveQuestionFromBank:output_type -> lp.v1.RemoveQuestionFromBankResponse
+	132, // 207: lp.v1.LearningPlatform.SetLessonQuestionDraws:output_type -> lp.v1.SetLessonQuestionDrawsResponse
+	134, // 208: lp.v1.LearningPlatform.GetLessonQuestionDraws:output_type -> lp.v1.GetLessonQuestionDrawsResponse
+	136, // 209: lp.v1.LearningPlatform.CreateAttempt:output_type -> lp.v1.CreateAttemptResponse
+	138, // 210: lp.v1.LearningPlatform.SubmitQuestionAnswer:output_type -> lp.v1.SubmitQuestionAnswerResponse
+	140, // 211: lp.v1.LearningPlatform.CompleteAttempt:output_type -> lp.v1.CompleteAttemptResponse
+	144, // 212: lp.v1.LearningPlatform.GetAttempt:output_type -> lp.v1.GetAttemptResponse
+	146, // 213: lp.v1.LearningPlatform.ListAttempts:output_type -> lp.v1.ListAttemptsResponse
+	148, // 214: lp.v1.LearningPlatform.MarkPageViewed:output_type -> lp.v1.MarkPageViewedResponse
+	152, // 215: lp.v1.LearningPlatform.GetPlanProgress:output_type -> lp.v1.GetPlanProgressResponse
+	154, // 216: lp.v1.LearningPlatform.GetChannelProgress:output_type -> lp.v1.GetChannelProgressResponse
+	164, // [164:217] is the sub-list for method output_type
+	111, // [111:164] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
+}
+
+func init() { file_lp_proto_init() }
+func file_lp_proto_init() {
+	if File_lp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lp_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*BasePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateBasePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateBasePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ImagePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateImagePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateImagePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*VideoPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateVideoPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateVideoPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*PDFPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePDFPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePDFPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderPagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderPagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*MovePageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*MovePageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*Channel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelWithPlans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*Plan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderPlansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderPlansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachPlanToChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachPlanToChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachPlanFromChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachPlanFromChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ClonePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ClonePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*Lesson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*LearningObjectives); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLessonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLessonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateLessonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateLessonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteLessonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteLessonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderLessonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderLessonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachLessonToPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5149,8 +13031,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateBasePage); i {
+		file_lp_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachLessonToPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5161,8 +13043,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateBasePage); i {
+		file_lp_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachLessonFromPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5173,8 +13055,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ImagePage); i {
+		file_lp_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachLessonFromPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5185,8 +13067,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateImagePage); i {
+		file_lp_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneLessonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5197,8 +13079,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateImagePage); i {
+		file_lp_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneLessonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5209,8 +13091,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*VideoPage); i {
+		file_lp_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveLessonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5221,8 +13103,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateVideoPage); i {
+		file_lp_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveLessonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5233,8 +13115,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateVideoPage); i {
+		file_lp_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*ShortAnswerQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5245,8 +13127,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PDFPage); i {
+		file_lp_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*MultiSelectQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5257,8 +13139,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePDFPage); i {
+		file_lp_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMultiSelectQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5269,8 +13151,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePDFPage); i {
+		file_lp_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateShortAnswerQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5281,8 +13163,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePageRequest); i {
+		file_lp_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*TrueFalseQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5293,8 +13175,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePageResponse); i {
+		file_lp_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTrueFalseQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5305,8 +13187,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPageRequest); i {
+		file_lp_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*NumericQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5317,8 +13199,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPageResponse); i {
+		file_lp_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateNumericQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5329,8 +13211,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPagesRequest); i {
+		file_lp_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*FillBlank); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5341,8 +13223,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPagesResponse); i {
+		file_lp_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*FillBlanksQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5353,8 +13235,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePageRequest); i {
+		file_lp_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFillBlanksQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5365,8 +13247,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePageResponse); i {
+		file_lp_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderingQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5377,8 +13259,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePageRequest); i {
+		file_lp_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateOrderingQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5389,8 +13271,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePageResponse); i {
+		file_lp_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchingPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5401,8 +13283,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*Channel); i {
+		file_lp_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchingQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5413,8 +13295,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelWithPlans); i {
+		file_lp_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMatchingQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5425,8 +13307,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateChannelRequest); i {
+		file_lp_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*Option); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5437,8 +13319,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateChannelResponse); i {
+		file_lp_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*OptionFeedback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5449,8 +13331,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChannelRequest); i {
+		file_lp_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*QuestionPage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5461,8 +13343,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChannelResponse); i {
+		file_lp_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateQuestionPageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5473,8 +13355,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChannelsRequest); i {
+		file_lp_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateQuestionPageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5485,8 +13367,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChannelsResponse); i {
+		file_lp_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionPageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5497,8 +13379,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateChannelRequest); i {
+		file_lp_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionPageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5509,8 +13391,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateChannelResponse); i {
+		file_lp_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateQuestionPageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5521,8 +13403,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteChannelRequest); i {
+		file_lp_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateQuestionPageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5533,8 +13415,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteChannelResponse); i {
+		file_lp_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteQuestionPageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5545,8 +13427,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*Plan); i {
+		file_lp_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteQuestionPageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5557,8 +13439,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePlanRequest); i {
+		file_lp_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionPagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5569,8 +13451,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePlanResponse); i {
+		file_lp_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionPagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5581,8 +13463,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPlanRequest); i {
+		file_lp_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*QuestionBank); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5593,8 +13475,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPlanResponse); i {
+		file_lp_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*QuestionBankItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5605,8 +13487,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPlansRequest); i {
+		file_lp_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*QuestionDraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5617,8 +13499,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPlansResponse); i {
+		file_lp_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateQuestionBankRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5629,8 +13511,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePlanRequest); i {
+		file_lp_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateQuestionBankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5641,8 +13523,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePlanResponse); i {
+		file_lp_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionBankRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5653,8 +13535,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePlanRequest); i {
+		file_lp_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionBankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5665,8 +13547,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePlanResponse); i {
+		file_lp_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionBanksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5677,8 +13559,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*Lesson); i {
+		file_lp_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuestionBanksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5689,8 +13571,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateLessonRequest); i {
+		file_lp_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteQuestionBankRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5701,8 +13583,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateLessonResponse); i {
+		file_lp_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteQuestionBankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5713,8 +13595,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLessonRequest); i {
+		file_lp_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*AddQuestionToBankRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5725,8 +13607,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLessonResponse); i {
+		file_lp_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*AddQuestionToBankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5737,8 +13619,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLessonsRequest); i {
+		file_lp_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveQuestionFromBankRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5749,8 +13631,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLessonsResponse); i {
+		file_lp_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveQuestionFromBankResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5761,8 +13643,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateLessonRequest); i {
+		file_lp_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*SetLessonQuestionDrawsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5773,8 +13655,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateLessonResponse); i {
+		file_lp_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*SetLessonQuestionDrawsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5785,8 +13667,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteLessonRequest); i {
+		file_lp_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonQuestionDrawsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5797,8 +13679,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteLessonResponse); i {
+		file_lp_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLessonQuestionDrawsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5809,8 +13691,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*QuestionPage); i {
+		file_lp_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAttemptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5821,8 +13703,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateQuestionPageRequest); i {
+		file_lp_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAttemptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5833,8 +13715,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateQuestionPageResponse); i {
+		file_lp_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitQuestionAnswerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5845,8 +13727,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*GetQuestionPageRequest); i {
+		file_lp_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitQuestionAnswerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5857,8 +13739,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*GetQuestionPageResponse); i {
+		file_lp_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteAttemptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5869,8 +13751,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateQuestionPageRequest); i {
+		file_lp_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteAttemptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5881,8 +13763,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateQuestionPageResponse); i {
+		file_lp_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*LessonAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5893,8 +13775,8 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAttemptRequest); i {
+		file_lp_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*PageAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5905,8 +13787,140 @@ func file_lp_proto_init() {
 				return nil
 			}
 		}
-		file_lp_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAttemptResponse); i {
+		file_lp_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAttemptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAttemptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAttemptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkPageViewedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkPageViewedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*LessonProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[145].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[146].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPlanProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[147].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lp_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChannelProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5918,6 +13932,7 @@ func file_lp_proto_init() {
 			}
 		}
 	}
+	file_lp_proto_msgTypes[1].OneofWrappers = []any{}
 	file_lp_proto_msgTypes[12].OneofWrappers = []any{
 		(*CreatePageRequest_ImagePage)(nil),
 		(*CreatePageRequest_VideoPage)(nil),
@@ -5933,18 +13948,54 @@ func file_lp_proto_init() {
 		(*UpdatePageRequest_VideoPage)(nil),
 		(*UpdatePageRequest_PdfPage)(nil),
 	}
-	file_lp_proto_msgTypes[30].OneofWrappers = []any{}
-	file_lp_proto_msgTypes[41].OneofWrappers = []any{}
-	file_lp_proto_msgTypes[52].OneofWrappers = []any{}
-	file_lp_proto_msgTypes[57].OneofWrappers = []any{}
-	file_lp_proto_msgTypes[61].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[24].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[34].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[47].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[67].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[83].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[84].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[86].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[87].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[88].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[91].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[99].OneofWrappers = []any{
+		(*QuestionPage_ShortAnswer)(nil),
+		(*QuestionPage_MultiSelect)(nil),
+		(*QuestionPage_TrueFalse)(nil),
+		(*QuestionPage_Numeric)(nil),
+		(*QuestionPage_FillBlanks)(nil),
+		(*QuestionPage_Ordering)(nil),
+		(*QuestionPage_Matching)(nil),
+	}
+	file_lp_proto_msgTypes[100].OneofWrappers = []any{
+		(*CreateQuestionPageRequest_ShortAnswer)(nil),
+		(*CreateQuestionPageRequest_MultiSelect)(nil),
+		(*CreateQuestionPageRequest_TrueFalse)(nil),
+		(*CreateQuestionPageRequest_Numeric)(nil),
+		(*CreateQuestionPageRequest_FillBlanks)(nil),
+		(*CreateQuestionPageRequest_Ordering)(nil),
+		(*CreateQuestionPageRequest_Matching)(nil),
+	}
+	file_lp_proto_msgTypes[104].OneofWrappers = []any{
+		(*UpdateQuestionPageRequest_ShortAnswer)(nil),
+		(*UpdateQuestionPageRequest_MultiSelect)(nil),
+		(*UpdateQuestionPageRequest_TrueFalse)(nil),
+		(*UpdateQuestionPageRequest_Numeric)(nil),
+		(*UpdateQuestionPageRequest_FillBlanks)(nil),
+		(*UpdateQuestionPageRequest_Ordering)(nil),
+		(*UpdateQuestionPageRequest_Matching)(nil),
+	}
+	file_lp_proto_msgTypes[117].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[131].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[139].OneofWrappers = []any{}
+	file_lp_proto_msgTypes[141].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lp_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   65,
+			NumEnums:      6,
+			NumMessages:   149,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,30 +19,59 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LearningPlatform_CreateChannel_FullMethodName      = "/lp.v1.LearningPlatform/CreateChannel"
-	LearningPlatform_GetChannel_FullMethodName         = "/lp.v1.LearningPlatform/GetChannel"
-	LearningPlatform_GetChannels_FullMethodName        = "/lp.v1.LearningPlatform/GetChannels"
-	LearningPlatform_UpdateChannel_FullMethodName      = "/lp.v1.LearningPlatform/UpdateChannel"
-	LearningPlatform_DeleteChannel_FullMethodName      = "/lp.v1.LearningPlatform/DeleteChannel"
-	LearningPlatform_CreatePlan_FullMethodName         = "/lp.v1.LearningPlatform/CreatePlan"
-	LearningPlatform_GetPlan_FullMethodName            = "/lp.v1.LearningPlatform/GetPlan"
-	LearningPlatform_GetPlans_FullMethodName           = "/lp.v1.LearningPlatform/GetPlans"
-	LearningPlatform_UpdatePlan_FullMethodName         = "/lp.v1.LearningPlatform/UpdatePlan"
-	LearningPlatform_DeletePlan_FullMethodName         = "/lp.v1.LearningPlatform/DeletePlan"
-	LearningPlatform_CreateLesson_FullMethodName       = "/lp.v1.LearningPlatform/CreateLesson"
-	LearningPlatform_GetLesson_FullMethodName          = "/lp.v1.LearningPlatform/GetLesson"
-	LearningPlatform_GetLessons_FullMethodName         = "/lp.v1.LearningPlatform/GetLessons"
-	LearningPlatform_UpdateLesson_FullMethodName       = "/lp.v1.LearningPlatform/UpdateLesson"
-	LearningPlatform_DeleteLesson_FullMethodName       = "/lp.v1.LearningPlatform/DeleteLesson"
-	LearningPlatform_CreatePage_FullMethodName         = "/lp.v1.LearningPlatform/CreatePage"
-	LearningPlatform_GetPage_FullMethodName            = "/lp.v1.LearningPlatform/GetPage"
-	LearningPlatform_GetPages_FullMethodName           = "/lp.v1.LearningPlatform/GetPages"
-	LearningPlatform_UpdatePage_FullMethodName         = "/lp.v1.LearningPlatform/UpdatePage"
-	LearningPlatform_DeletePage_FullMethodName         = "/lp.v1.LearningPlatform/DeletePage"
-	LearningPlatform_CreateQuestionPage_FullMethodName = "/lp.v1.LearningPlatform/CreateQuestionPage"
-	LearningPlatform_GetQuestionPage_FullMethodName    = "/lp.v1.LearningPlatform/GetQuestionPage"
-	LearningPlatform_UpdateQuestionPage_FullMethodName = "/lp.v1.LearningPlatform/UpdateQuestionPage"
-	LearningPlatform_CreateAttempt_FullMethodName      = "/lp.v1.LearningPlatform/CreateAttempt"
+	LearningPlatform_CreateChannel_FullMethodName          = "/lp.v1.LearningPlatform/CreateChannel"
+	LearningPlatform_GetChannel_FullMethodName             = "/lp.v1.LearningPlatform/GetChannel"
+	LearningPlatform_GetChannels_FullMethodName            = "/lp.v1.LearningPlatform/GetChannels"
+	LearningPlatform_UpdateChannel_FullMethodName          = "/lp.v1.LearningPlatform/UpdateChannel"
+	LearningPlatform_DeleteChannel_FullMethodName          = "/lp.v1.LearningPlatform/DeleteChannel"
+	LearningPlatform_GetPlanChannels_FullMethodName        = "/lp.v1.LearningPlatform/GetPlanChannels"
+	LearningPlatform_CreatePlan_FullMethodName             = "/lp.v1.LearningPlatform/CreatePlan"
+	LearningPlatform_GetPlan_FullMethodName                = "/lp.v1.LearningPlatform/GetPlan"
+	LearningPlatform_GetPlans_FullMethodName               = "/lp.v1.LearningPlatform/GetPlans"
+	LearningPlatform_UpdatePlan_FullMethodName             = "/lp.v1.LearningPlatform/UpdatePlan"
+	LearningPlatform_DeletePlan_FullMethodName             = "/lp.v1.LearningPlatform/DeletePlan"
+	LearningPlatform_ReorderPlans_FullMethodName           = "/lp.v1.LearningPlatform/ReorderPlans"
+	LearningPlatform_AttachPlanToChannel_FullMethodName    = "/lp.v1.LearningPlatform/AttachPlanToChannel"
+	LearningPlatform_DetachPlanFromChannel_FullMethodName  = "/lp.v1.LearningPlatform/DetachPlanFromChannel"
+	LearningPlatform_ClonePlan_FullMethodName              = "/lp.v1.LearningPlatform/ClonePlan"
+	LearningPlatform_CreateLesson_FullMethodName           = "/lp.v1.LearningPlatform/CreateLesson"
+	LearningPlatform_GetLesson_FullMethodName              = "/lp.v1.LearningPlatform/GetLesson"
+	LearningPlatform_GetLessons_FullMethodName             = "/lp.v1.LearningPlatform/GetLessons"
+	LearningPlatform_UpdateLesson_FullMethodName           = "/lp.v1.LearningPlatform/UpdateLesson"
+	LearningPlatform_DeleteLesson_FullMethodName           = "/lp.v1.LearningPlatform/DeleteLesson"
+	LearningPlatform_ReorderLessons_FullMethodName         = "/lp.v1.LearningPlatform/ReorderLessons"
+	LearningPlatform_AttachLessonToPlan_FullMethodName     = "/lp.v1.LearningPlatform/AttachLessonToPlan"
+	LearningPlatform_DetachLessonFromPlan_FullMethodName   = "/lp.v1.LearningPlatform/DetachLessonFromPlan"
+	LearningPlatform_CloneLesson_FullMethodName            = "/lp.v1.LearningPlatform/CloneLesson"
+	LearningPlatform_MoveLesson_FullMethodName             = "/lp.v1.LearningPlatform/MoveLesson"
+	LearningPlatform_CreatePage_FullMethodName             = "/lp.v1.LearningPlatform/CreatePage"
+	LearningPlatform_GetPage_FullMethodName                = "/lp.v1.LearningPlatform/GetPage"
+	LearningPlatform_GetPages_FullMethodName               = "/lp.v1.LearningPlatform/GetPages"
+	LearningPlatform_UpdatePage_FullMethodName             = "/lp.v1.LearningPlatform/UpdatePage"
+	LearningPlatform_DeletePage_FullMethodName             = "/lp.v1.LearningPlatform/DeletePage"
+	LearningPlatform_ReorderPages_FullMethodName           = "/lp.v1.LearningPlatform/ReorderPages"
+	LearningPlatform_MovePage_FullMethodName               = "/lp.v1.LearningPlatform/MovePage"
+	LearningPlatform_CreateQuestionPage_FullMethodName     = "/lp.v1.LearningPlatform/CreateQuestionPage"
+	LearningPlatform_GetQuestionPage_FullMethodName        = "/lp.v1.LearningPlatform/GetQuestionPage"
+	LearningPlatform_UpdateQuestionPage_FullMethodName     = "/lp.v1.LearningPlatform/UpdateQuestionPage"
+	LearningPlatform_DeleteQuestionPage_FullMethodName     = "/lp.v1.LearningPlatform/DeleteQuestionPage"
+	LearningPlatform_GetQuestionPages_FullMethodName       = "/lp.v1.LearningPlatform/GetQuestionPages"
+	LearningPlatform_CreateQuestionBank_FullMethodName     = "/lp.v1.LearningPlatform/CreateQuestionBank"
+	LearningPlatform_GetQuestionBank_FullMethodName        = "/lp.v1.LearningPlatform/GetQuestionBank"
+	LearningPlatform_GetQuestionBanks_FullMethodName       = "/lp.v1.LearningPlatform/GetQuestionBanks"
+	LearningPlatform_DeleteQuestionBank_FullMethodName     = "/lp.v1.LearningPlatform/DeleteQuestionBank"
+	LearningPlatform_AddQuestionToBank_FullMethodName      = "/lp.v1.LearningPlatform/AddQuestionToBank"
+	LearningPlatform_RemoveQuestionFromBank_FullMethodName = "/lp.v1.LearningPlatform/RemoveQuestionFromBank"
+	LearningPlatform_SetLessonQuestionDraws_FullMethodName = "/lp.v1.LearningPlatform/SetLessonQuestionDraws"
+	LearningPlatform_GetLessonQuestionDraws_FullMethodName = "/lp.v1.LearningPlatform/GetLessonQuestionDraws"
+	LearningPlatform_CreateAttempt_FullMethodName          = "/lp.v1.LearningPlatform/CreateAttempt"
+	LearningPlatform_SubmitQuestionAnswer_FullMethodName   = "/lp.v1.LearningPlatform/SubmitQuestionAnswer"
+	LearningPlatform_CompleteAttempt_FullMethodName        = "/lp.v1.LearningPlatform/CompleteAttempt"
+	LearningPlatform_GetAttempt_FullMethodName             = "/lp.v1.LearningPlatform/GetAttempt"
+	LearningPlatform_ListAttempts_FullMethodName           = "/lp.v1.LearningPlatform/ListAttempts"
+	LearningPlatform_MarkPageViewed_FullMethodName         = "/lp.v1.LearningPlatform/MarkPageViewed"
+	LearningPlatform_GetPlanProgress_FullMethodName        = "/lp.v1.LearningPlatform/GetPlanProgress"
+	LearningPlatform_GetChannelProgress_FullMethodName     = "/lp.v1.LearningPlatform/GetChannelProgress"
 )
 
 // LearningPlatformClient is the client API for LearningPlatform service.
@@ -54,25 +83,54 @@ type LearningPlatformClient interface {
 	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
 	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error)
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	GetPlanChannels(ctx context.Context, in *GetPlanChannelsRequest, opts ...grpc.CallOption) (*GetPlanChannelsResponse, error)
 	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanResponse, error)
 	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error)
 	GetPlans(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error)
 	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*UpdatePlanResponse, error)
 	DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*DeletePlanResponse, error)
+	ReorderPlans(ctx context.Context, in *ReorderPlansRequest, opts ...grpc.CallOption) (*ReorderPlansResponse, error)
+	AttachPlanToChannel(ctx context.Context, in *AttachPlanToChannelRequest, opts ...grpc.CallOption) (*AttachPlanToChannelResponse, error)
+	DetachPlanFromChannel(ctx context.Context, in *DetachPlanFromChannelRequest, opts ...grpc.CallOption) (*DetachPlanFromChannelResponse, error)
+	ClonePlan(ctx context.Context, in *ClonePlanRequest, opts ...grpc.CallOption) (*ClonePlanResponse, error)
 	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error)
 	GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*GetLessonResponse, error)
 	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
 	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error)
 	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error)
+	ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error)
+	AttachLessonToPlan(ctx context.Context, in *AttachLessonToPlanRequest, opts ...grpc.CallOption) (*AttachLessonToPlanResponse, error)
+	DetachLessonFromPlan(ctx context.Context, in *DetachLessonFromPlanRequest, opts ...grpc.CallOption) (*DetachLessonFromPlanResponse, error)
+	CloneLesson(ctx context.Context, in *CloneLessonRequest, opts ...grpc.CallOption) (*CloneLessonResponse, error)
+	MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*MoveLessonResponse, error)
 	CreatePage(ctx context.Context, in *CreatePageRequest, opts ...grpc.CallOption) (*CreatePageResponse, error)
 	GetPage(ctx context.Context, in *GetPageRequest, opts ...grpc.CallOption) (*GetPageResponse, error)
 	GetPages(ctx context.Context, in *GetPagesRequest, opts ...grpc.CallOption) (*GetPagesResponse, error)
 	UpdatePage(ctx context.Context, in *UpdatePageRequest, opts ...grpc.CallOption) (*UpdatePageResponse, error)
 	DeletePage(ctx context.Context, in *DeletePageRequest, opts ...grpc.CallOption) (*DeletePageResponse, error)
+	ReorderPages(ctx context.Context, in *ReorderPagesRequest, opts ...grpc.CallOption) (*ReorderPagesResponse, error)
+	MovePage(ctx context.Context, in *MovePageRequest, opts ...grpc.CallOption) (*MovePageResponse, error)
 	CreateQuestionPage(ctx context.Context, in *CreateQuestionPageRequest, opts ...grpc.CallOption) (*CreateQuestionPageResponse, error)
 	GetQuestionPage(ctx context.Context, in *GetQuestionPageRequest, opts ...grpc.CallOption) (*GetQuestionPageResponse, error)
 	UpdateQuestionPage(ctx context.Context, in *UpdateQuestionPageRequest, opts ...grpc.CallOption) (*UpdateQuestionPageResponse, error)
+	DeleteQuestionPage(ctx context.Context, in *DeleteQuestionPageRequest, opts ...grpc.CallOption) (*DeleteQuestionPageResponse, error)
+	GetQuestionPages(ctx context.Context, in *GetQuestionPagesRequest, opts ...grpc.CallOption) (*GetQuestionPagesResponse, error)
+	CreateQuestionBank(ctx context.Context, in *CreateQuestionBankRequest, opts ...grpc.CallOption) (*CreateQuestionBankResponse, error)
+	GetQuestionBank(ctx context.Context, in *GetQuestionBankRequest, opts ...grpc.CallOption) (*GetQuestionBankResponse, error)
+	GetQuestionBanks(ctx context.Context, in *GetQuestionBanksRequest, opts ...grpc.CallOption) (*GetQuestionBanksResponse, error)
+	DeleteQuestionBank(ctx context.Context, in *DeleteQuestionBankRequest, opts ...grpc.CallOption) (*DeleteQuestionBankResponse, error)
+	AddQuestionToBank(ctx context.Context, in *AddQuestionToBankRequest, opts ...grpc.CallOption) (*AddQuestionToBankResponse, error)
+	RemoveQuestionFromBank(ctx context.Context, in *RemoveQuestionFromBankRequest, opts ...grpc.CallOption) (*RemoveQuestionFromBankResponse, error)
+	SetLessonQuestionDraws(ctx context.Context, in *SetLessonQuestionDrawsRequest, opts ...grpc.CallOption) (*SetLessonQuestionDrawsResponse, error)
+	GetLessonQuestionDraws(ctx context.Context, in *GetLessonQuestionDrawsRequest, opts ...grpc.CallOption) (*GetLessonQuestionDrawsResponse, error)
 	CreateAttempt(ctx context.Context, in *CreateAttemptRequest, opts ...grpc.CallOption) (*CreateAttemptResponse, error)
+	SubmitQuestionAnswer(ctx context.Context, in *SubmitQuestionAnswerRequest, opts ...grpc.CallOption) (*SubmitQuestionAnswerResponse, error)
+	CompleteAttempt(ctx context.Context, in *CompleteAttemptRequest, opts ...grpc.CallOption) (*CompleteAttemptResponse, error)
+	GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*GetAttemptResponse, error)
+	ListAttempts(ctx context.Context, in *ListAttemptsRequest, opts ...grpc.CallOption) (*ListAttemptsResponse, error)
+	MarkPageViewed(ctx context.Context, in *MarkPageViewedRequest, opts ...grpc.CallOption) (*MarkPageViewedResponse, error)
+	GetPlanProgress(ctx context.Context, in *GetPlanProgressRequest, opts ...grpc.CallOption) (*GetPlanProgressResponse, error)
+	GetChannelProgress(ctx context.Context, in *GetChannelProgressRequest, opts ...grpc.CallOption) (*GetChannelProgressResponse, error)
 }
 
 type learningPlatformClient struct {
@@ -133,6 +191,16 @@ func (c *learningPlatformClient) DeleteChannel(ctx context.Context, in *DeleteCh
 	return out, nil
 }
 
+func (c *learningPlatformClient) GetPlanChannels(ctx context.Context, in *GetPlanChannelsRequest, opts ...grpc.CallOption) (*GetPlanChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanChannelsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlanChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningPlatformClient) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePlanResponse)
@@ -183,6 +251,46 @@ func (c *learningPlatformClient) DeletePlan(ctx context.Context, in *DeletePlanR
 	return out, nil
 }
 
+func (c *learningPlatformClient) ReorderPlans(ctx context.Context, in *ReorderPlansRequest, opts ...grpc.CallOption) (*ReorderPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPlansResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ReorderPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) AttachPlanToChannel(ctx context.Context, in *AttachPlanToChannelRequest, opts ...grpc.CallOption) (*AttachPlanToChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachPlanToChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_AttachPlanToChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DetachPlanFromChannel(ctx context.Context, in *DetachPlanFromChannelRequest, opts ...grpc.CallOption) (*DetachPlanFromChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachPlanFromChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DetachPlanFromChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) ClonePlan(ctx context.Context, in *ClonePlanRequest, opts ...grpc.CallOption) (*ClonePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClonePlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ClonePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningPlatformClient) CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLessonResponse)
@@ -233,6 +341,56 @@ func (c *learningPlatformClient) DeleteLesson(ctx context.Context, in *DeleteLes
 	return out, nil
 }
 
+func (c *learningPlatformClient) ReorderLessons(ctx context.Context, in *ReorderLessonsRequest, opts ...grpc.CallOption) (*ReorderLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderLessonsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ReorderLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) AttachLessonToPlan(ctx context.Context, in *AttachLessonToPlanRequest, opts ...grpc.CallOption) (*AttachLessonToPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachLessonToPlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_AttachLessonToPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DetachLessonFromPlan(ctx context.Context, in *DetachLessonFromPlanRequest, opts ...grpc.CallOption) (*DetachLessonFromPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachLessonFromPlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DetachLessonFromPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CloneLesson(ctx context.Context, in *CloneLessonRequest, opts ...grpc.CallOption) (*CloneLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CloneLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*MoveLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_MoveLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningPlatformClient) CreatePage(ctx context.Context, in *CreatePageRequest, opts ...grpc.CallOption) (*CreatePageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePageResponse)
@@ -283,6 +441,26 @@ func (c *learningPlatformClient) DeletePage(ctx context.Context, in *DeletePageR
 	return out, nil
 }
 
+func (c *learningPlatformClient) ReorderPages(ctx context.Context, in *ReorderPagesRequest, opts ...grpc.CallOption) (*ReorderPagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPagesResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ReorderPages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) MovePage(ctx context.Context, in *MovePageRequest, opts ...grpc.CallOption) (*MovePageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovePageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_MovePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningPlatformClient) CreateQuestionPage(ctx context.Context, in *CreateQuestionPageRequest, opts ...grpc.CallOption) (*CreateQuestionPageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateQuestionPageResponse)
@@ -313,6 +491,106 @@ func (c *learningPlatformClient) UpdateQuestionPage(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *learningPlatformClient) DeleteQuestionPage(ctx context.Context, in *DeleteQuestionPageRequest, opts ...grpc.CallOption) (*DeleteQuestionPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeleteQuestionPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetQuestionPages(ctx context.Context, in *GetQuestionPagesRequest, opts ...grpc.CallOption) (*GetQuestionPagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionPagesResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetQuestionPages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreateQuestionBank(ctx context.Context, in *CreateQuestionBankRequest, opts ...grpc.CallOption) (*CreateQuestionBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuestionBankResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetQuestionBank(ctx context.Context, in *GetQuestionBankRequest, opts ...grpc.CallOption) (*GetQuestionBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionBankResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetQuestionBanks(ctx context.Context, in *GetQuestionBanksRequest, opts ...grpc.CallOption) (*GetQuestionBanksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionBanksResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetQuestionBanks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DeleteQuestionBank(ctx context.Context, in *DeleteQuestionBankRequest, opts ...grpc.CallOption) (*DeleteQuestionBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionBankResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeleteQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) AddQuestionToBank(ctx context.Context, in *AddQuestionToBankRequest, opts ...grpc.CallOption) (*AddQuestionToBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddQuestionToBankResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_AddQuestionToBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) RemoveQuestionFromBank(ctx context.Context, in *RemoveQuestionFromBankRequest, opts ...grpc.CallOption) (*RemoveQuestionFromBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveQuestionFromBankResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_RemoveQuestionFromBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) SetLessonQuestionDraws(ctx context.Context, in *SetLessonQuestionDrawsRequest, opts ...grpc.CallOption) (*SetLessonQuestionDrawsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLessonQuestionDrawsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_SetLessonQuestionDraws_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetLessonQuestionDraws(ctx context.Context, in *GetLessonQuestionDrawsRequest, opts ...grpc.CallOption) (*GetLessonQuestionDrawsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonQuestionDrawsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetLessonQuestionDraws_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningPlatformClient) CreateAttempt(ctx context.Context, in *CreateAttemptRequest, opts ...grpc.CallOption) (*CreateAttemptResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateAttemptResponse)
@@ -323,6 +601,76 @@ func (c *learningPlatformClient) CreateAttempt(ctx context.Context, in *CreateAt
 	return out, nil
 }
 
+func (c *learningPlatformClient) SubmitQuestionAnswer(ctx context.Context, in *SubmitQuestionAnswerRequest, opts ...grpc.CallOption) (*SubmitQuestionAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitQuestionAnswerResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_SubmitQuestionAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CompleteAttempt(ctx context.Context, in *CompleteAttemptRequest, opts ...grpc.CallOption) (*CompleteAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteAttemptResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CompleteAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*GetAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttemptResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) ListAttempts(ctx context.Context, in *ListAttemptsRequest, opts ...grpc.CallOption) (*ListAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttemptsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ListAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) MarkPageViewed(ctx context.Context, in *MarkPageViewedRequest, opts ...grpc.CallOption) (*MarkPageViewedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkPageViewedResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_MarkPageViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPlanProgress(ctx context.Context, in *GetPlanProgressRequest, opts ...grpc.CallOption) (*GetPlanProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanProgressResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlanProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetChannelProgress(ctx context.Context, in *GetChannelProgressRequest, opts ...grpc.CallOption) (*GetChannelProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelProgressResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetChannelProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LearningPlatformServer is the server API for LearningPlatform service.
 // All implementations must embed UnimplementedLearningPlatformServer
 // for forward compatibility.
@@ -332,25 +680,54 @@ type LearningPlatformServer interface {
 	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
 	UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error)
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	GetPlanChannels(context.Context, *GetPlanChannelsRequest) (*GetPlanChannelsResponse, error)
 	CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanResponse, error)
 	GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error)
 	GetPlans(context.Context, *GetPlansRequest) (*GetPlansResponse, error)
 	UpdatePlan(context.Context, *UpdatePlanRequest) (*UpdatePlanResponse, error)
 	DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanResponse, error)
+	ReorderPlans(context.Context, *ReorderPlansRequest) (*ReorderPlansResponse, error)
+	AttachPlanToChannel(context.Context, *AttachPlanToChannelRequest) (*AttachPlanToChannelResponse, error)
+	DetachPlanFromChannel(context.Context, *DetachPlanFromChannelRequest) (*DetachPlanFromChannelResponse, error)
+	ClonePlan(context.Context, *ClonePlanRequest) (*ClonePlanResponse, error)
 	CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error)
 	GetLesson(context.Context, *GetLessonRequest) (*GetLessonResponse, error)
 	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
 	UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error)
 	DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error)
+	ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error)
+	AttachLessonToPlan(context.Context, *AttachLessonToPlanRequest) (*AttachLessonToPlanResponse, error)
+	DetachLessonFromPlan(context.Context, *DetachLessonFromPlanRequest) (*DetachLessonFromPlanResponse, error)
+	CloneLesson(context.Context, *CloneLessonRequest) (*CloneLessonResponse, error)
+	MoveLesson(context.Context, *MoveLessonRequest) (*MoveLessonResponse, error)
 	CreatePage(context.Context, *CreatePageRequest) (*CreatePageResponse, error)
 	GetPage(context.Context, *GetPageRequest) (*GetPageResponse, error)
 	GetPages(context.Context, *GetPagesRequest) (*GetPagesResponse, error)
 	UpdatePage(context.Context, *UpdatePageRequest) (*UpdatePageResponse, error)
 	DeletePage(context.Context, *DeletePageRequest) (*DeletePageResponse, error)
+	ReorderPages(context.Context, *ReorderPagesRequest) (*ReorderPagesResponse, error)
+	MovePage(context.Context, *MovePageRequest) (*MovePageResponse, error)
 	CreateQuestionPage(context.Context, *CreateQuestionPageRequest) (*CreateQuestionPageResponse, error)
 	GetQuestionPage(context.Context, *GetQuestionPageRequest) (*GetQuestionPageResponse, error)
 	UpdateQuestionPage(context.Context, *UpdateQuestionPageRequest) (*UpdateQuestionPageResponse, error)
+	DeleteQuestionPage(context.Context, *DeleteQuestionPageRequest) (*DeleteQuestionPageResponse, error)
+	GetQuestionPages(context.Context, *GetQuestionPagesRequest) (*GetQuestionPagesResponse, error)
+	CreateQuestionBank(context.Context, *CreateQuestionBankRequest) (*CreateQuestionBankResponse, error)
+	GetQuestionBank(context.Context, *GetQuestionBankRequest) (*GetQuestionBankResponse, error)
+	GetQuestionBanks(context.Context, *GetQuestionBanksRequest) (*GetQuestionBanksResponse, error)
+	DeleteQuestionBank(context.Context, *DeleteQuestionBankRequest) (*DeleteQuestionBankResponse, error)
+	AddQuestionToBank(context.Context, *AddQuestionToBankRequest) (*AddQuestionToBankResponse, error)
+	RemoveQuestionFromBank(context.Context, *RemoveQuestionFromBankRequest) (*RemoveQuestionFromBankResponse, error)
+	SetLessonQuestionDraws(context.Context, *SetLessonQuestionDrawsRequest) (*SetLessonQuestionDrawsResponse, error)
+	GetLessonQuestionDraws(context.Context, *GetLessonQuestionDrawsRequest) (*GetLessonQuestionDrawsResponse, error)
 	CreateAttempt(context.Context, *CreateAttemptRequest) (*CreateAttemptResponse, error)
+	SubmitQuestionAnswer(context.Context, *SubmitQuestionAnswerRequest) (*SubmitQuestionAnswerResponse, error)
+	CompleteAttempt(context.Context, *CompleteAttemptRequest) (*CompleteAttemptResponse, error)
+	GetAttempt(context.Context, *GetAttemptRequest) (*GetAttemptResponse, error)
+	ListAttempts(context.Context, *ListAttemptsRequest) (*ListAttemptsResponse, error)
+	MarkPageViewed(context.Context, *MarkPageViewedRequest) (*MarkPageViewedResponse, error)
+	GetPlanProgress(context.Context, *GetPlanProgressRequest) (*GetPlanProgressResponse, error)
+	GetChannelProgress(context.Context, *GetChannelProgressRequest) (*GetChannelProgressResponse, error)
 	mustEmbedUnimplementedLearningPlatformServer()
 }
 
@@ -376,6 +753,9 @@ func (UnimplementedLearningPlatformServer) UpdateChannel(context.Context, *Updat
 func (UnimplementedLearningPlatformServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
 }
+func (UnimplementedLearningPlatformServer) GetPlanChannels(context.Context, *GetPlanChannelsRequest) (*GetPlanChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlanChannels not implemented")
+}
 func (UnimplementedLearningPlatformServer) CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePlan not implemented")
 }
@@ -391,6 +771,18 @@ func (UnimplementedLearningPlatformServer) UpdatePlan(context.Context, *UpdatePl
 func (UnimplementedLearningPlatformServer) DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePlan not implemented")
 }
+func (UnimplementedLearningPlatformServer) ReorderPlans(context.Context, *ReorderPlansRequest) (*ReorderPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPlans not implemented")
+}
+func (UnimplementedLearningPlatformServer) AttachPlanToChannel(context.Context, *AttachPlanToChannelRequest) (*AttachPlanToChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachPlanToChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) DetachPlanFromChannel(context.Context, *DetachPlanFromChannelRequest) (*DetachPlanFromChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachPlanFromChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) ClonePlan(context.Context, *ClonePlanRequest) (*ClonePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClonePlan not implemented")
+}
 func (UnimplementedLearningPlatformServer) CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLesson not implemented")
 }
@@ -406,6 +798,21 @@ func (UnimplementedLearningPlatformServer) UpdateLesson(context.Context, *Update
 func (UnimplementedLearningPlatformServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
 }
+func (UnimplementedLearningPlatformServer) ReorderLessons(context.Context, *ReorderLessonsRequest) (*ReorderLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLessons not implemented")
+}
+func (UnimplementedLearningPlatformServer) AttachLessonToPlan(context.Context, *AttachLessonToPlanRequest) (*AttachLessonToPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachLessonToPlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) DetachLessonFromPlan(context.Context, *DetachLessonFromPlanRequest) (*DetachLessonFromPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachLessonFromPlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) CloneLesson(context.Context, *CloneLessonRequest) (*CloneLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) MoveLesson(context.Context, *MoveLessonRequest) (*MoveLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLesson not implemented")
+}
 func (UnimplementedLearningPlatformServer) CreatePage(context.Context, *CreatePageRequest) (*CreatePageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePage not implemented")
 }
@@ -421,6 +828,12 @@ func (UnimplementedLearningPlatformServer) UpdatePage(context.Context, *UpdatePa
 func (UnimplementedLearningPlatformServer) DeletePage(context.Context, *DeletePageRequest) (*DeletePageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
 }
+func (UnimplementedLearningPlatformServer) ReorderPages(context.Context, *ReorderPagesRequest) (*ReorderPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPages not implemented")
+}
+func (UnimplementedLearningPlatformServer) MovePage(context.Context, *MovePageRequest) (*MovePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePage not implemented")
+}
 func (UnimplementedLearningPlatformServer) CreateQuestionPage(context.Context, *CreateQuestionPageRequest) (*CreateQuestionPageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestionPage not implemented")
 }
@@ -430,9 +843,60 @@ func (UnimplementedLearningPlatformServer) GetQuestionPage(context.Context, *Get
 func (UnimplementedLearningPlatformServer) UpdateQuestionPage(context.Context, *UpdateQuestionPageRequest) (*UpdateQuestionPageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestionPage not implemented")
 }
+func (UnimplementedLearningPlatformServer) DeleteQuestionPage(context.Context, *DeleteQuestionPageRequest) (*DeleteQuestionPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestionPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetQuestionPages(context.Context, *GetQuestionPagesRequest) (*GetQuestionPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionPages not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreateQuestionBank(context.Context, *CreateQuestionBankRequest) (*CreateQuestionBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestionBank not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetQuestionBank(context.Context, *GetQuestionBankRequest) (*GetQuestionBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionBank not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetQuestionBanks(context.Context, *GetQuestionBanksRequest) (*GetQuestionBanksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionBanks not implemented")
+}
+func (UnimplementedLearningPlatformServer) DeleteQuestionBank(context.Context, *DeleteQuestionBankRequest) (*DeleteQuestionBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestionBank not implemented")
+}
+func (UnimplementedLearningPlatformServer) AddQuestionToBank(context.Context, *AddQuestionToBankRequest) (*AddQuestionToBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuestionToBank not implemented")
+}
+func (UnimplementedLearningPlatformServer) RemoveQuestionFromBank(context.Context, *RemoveQuestionFromBankRequest) (*RemoveQuestionFromBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQuestionFromBank not implemented")
+}
+func (UnimplementedLearningPlatformServer) SetLessonQuestionDraws(context.Context, *SetLessonQuestionDrawsRequest) (*SetLessonQuestionDrawsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonQuestionDraws not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetLessonQuestionDraws(context.Context, *GetLessonQuestionDrawsRequest) (*GetLessonQuestionDrawsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonQuestionDraws not implemented")
+}
 func (UnimplementedLearningPlatformServer) CreateAttempt(context.Context, *CreateAttemptRequest) (*CreateAttemptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAttempt not implemented")
 }
+func (UnimplementedLearningPlatformServer) SubmitQuestionAnswer(context.Context, *SubmitQuestionAnswerRequest) (*SubmitQuestionAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuestionAnswer not implemented")
+}
+func (UnimplementedLearningPlatformServer) CompleteAttempt(context.Context, *CompleteAttemptRequest) (*CompleteAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAttempt not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetAttempt(context.Context, *GetAttemptRequest) (*GetAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttempt not implemented")
+}
+func (UnimplementedLearningPlatformServer) ListAttempts(context.Context, *ListAttemptsRequest) (*ListAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttempts not implemented")
+}
+func (UnimplementedLearningPlatformServer) MarkPageViewed(context.Context, *MarkPageViewedRequest) (*MarkPageViewedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPageViewed not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPlanProgress(context.Context, *GetPlanProgressRequest) (*GetPlanProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlanProgress not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetChannelProgress(context.Context, *GetChannelProgressRequest) (*GetChannelProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelProgress not implemented")
+}
 func (UnimplementedLearningPlatformServer) mustEmbedUnimplementedLearningPlatformServer() {}
 func (UnimplementedLearningPlatformServer) testEmbeddedByValue()                          {}
 
@@ -544,6 +1008,24 @@ func _LearningPlatform_DeleteChannel_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningPlatform_GetPlanChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlanChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlanChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlanChannels(ctx, req.(*GetPlanChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningPlatform_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePlanRequest)
 	if err := dec(in); err != nil {
@@ -634,43 +1116,115 @@ func _LearningPlatform_DeletePlan_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LearningPlatform_CreateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLessonRequest)
+func _LearningPlatform_ReorderPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LearningPlatformServer).CreateLesson(ctx, in)
+		return srv.(LearningPlatformServer).ReorderPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LearningPlatform_CreateLesson_FullMethodName,
+		FullMethod: LearningPlatform_ReorderPlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LearningPlatformServer).CreateLesson(ctx, req.(*CreateLessonRequest))
+		return srv.(LearningPlatformServer).ReorderPlans(ctx, req.(*ReorderPlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LearningPlatform_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLessonRequest)
+func _LearningPlatform_AttachPlanToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachPlanToChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LearningPlatformServer).GetLesson(ctx, in)
+		return srv.(LearningPlatformServer).AttachPlanToChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LearningPlatform_GetLesson_FullMethodName,
+		FullMethod: LearningPlatform_AttachPlanToChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LearningPlatformServer).GetLesson(ctx, req.(*GetLessonRequest))
+		return srv.(LearningPlatformServer).AttachPlanToChannel(ctx, req.(*AttachPlanToChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LearningPlatform_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _LearningPlatform_DetachPlanFromChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachPlanFromChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DetachPlanFromChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DetachPlanFromChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DetachPlanFromChannel(ctx, req.(*DetachPlanFromChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_ClonePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClonePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).ClonePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_ClonePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).ClonePlan(ctx, req.(*ClonePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateLesson(ctx, req.(*CreateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLesson(ctx, req.(*GetLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLessonsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -724,6 +1278,96 @@ func _LearningPlatform_DeleteLesson_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningPlatform_ReorderLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).ReorderLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_ReorderLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).ReorderLessons(ctx, req.(*ReorderLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_AttachLessonToPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachLessonToPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).AttachLessonToPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_AttachLessonToPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).AttachLessonToPlan(ctx, req.(*AttachLessonToPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DetachLessonFromPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachLessonFromPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DetachLessonFromPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DetachLessonFromPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DetachLessonFromPlan(ctx, req.(*DetachLessonFromPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CloneLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CloneLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CloneLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CloneLesson(ctx, req.(*CloneLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_MoveLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).MoveLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_MoveLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).MoveLesson(ctx, req.(*MoveLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningPlatform_CreatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePageRequest)
 	if err := dec(in); err != nil {
@@ -814,6 +1458,42 @@ func _LearningPlatform_DeletePage_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningPlatform_ReorderPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).ReorderPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_ReorderPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).ReorderPages(ctx, req.(*ReorderPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_MovePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).MovePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_MovePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).MovePage(ctx, req.(*MovePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningPlatform_CreateQuestionPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateQuestionPageRequest)
 	if err := dec(in); err != nil {
@@ -868,6 +1548,186 @@ func _LearningPlatform_UpdateQuestionPage_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningPlatform_DeleteQuestionPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeleteQuestionPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeleteQuestionPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeleteQuestionPage(ctx, req.(*DeleteQuestionPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetQuestionPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetQuestionPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetQuestionPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetQuestionPages(ctx, req.(*GetQuestionPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateQuestionBank(ctx, req.(*CreateQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetQuestionBank(ctx, req.(*GetQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetQuestionBanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionBanksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetQuestionBanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetQuestionBanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetQuestionBanks(ctx, req.(*GetQuestionBanksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DeleteQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeleteQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeleteQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeleteQuestionBank(ctx, req.(*DeleteQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_AddQuestionToBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddQuestionToBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).AddQuestionToBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_AddQuestionToBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).AddQuestionToBank(ctx, req.(*AddQuestionToBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_RemoveQuestionFromBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveQuestionFromBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).RemoveQuestionFromBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_RemoveQuestionFromBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).RemoveQuestionFromBank(ctx, req.(*RemoveQuestionFromBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_SetLessonQuestionDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLessonQuestionDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).SetLessonQuestionDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_SetLessonQuestionDraws_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).SetLessonQuestionDraws(ctx, req.(*SetLessonQuestionDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLessonQuestionDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonQuestionDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLessonQuestionDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLessonQuestionDraws_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLessonQuestionDraws(ctx, req.(*GetLessonQuestionDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningPlatform_CreateAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAttemptRequest)
 	if err := dec(in); err != nil {
@@ -886,6 +1746,132 @@ func _LearningPlatform_CreateAttempt_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningPlatform_SubmitQuestionAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuestionAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).SubmitQuestionAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_SubmitQuestionAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).SubmitQuestionAnswer(ctx, req.(*SubmitQuestionAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CompleteAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CompleteAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CompleteAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CompleteAttempt(ctx, req.(*CompleteAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetAttempt(ctx, req.(*GetAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_ListAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).ListAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_ListAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).ListAttempts(ctx, req.(*ListAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_MarkPageViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPageViewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).MarkPageViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_MarkPageViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).MarkPageViewed(ctx, req.(*MarkPageViewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPlanProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlanProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlanProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlanProgress(ctx, req.(*GetPlanProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetChannelProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetChannelProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetChannelProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetChannelProgress(ctx, req.(*GetChannelProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LearningPlatform_ServiceDesc is the grpc.ServiceDesc for LearningPlatform service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -913,6 +1899,10 @@ var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChannel",
 			Handler:    _LearningPlatform_DeleteChannel_Handler,
 		},
+		{
+			MethodName: "GetPlanChannels",
+			Handler:    _LearningPlatform_GetPlanChannels_Handler,
+		},
 		{
 			MethodName: "CreatePlan",
 			Handler:    _LearningPlatform_CreatePlan_Handler,
@@ -933,6 +1923,22 @@ var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePlan",
 			Handler:    _LearningPlatform_DeletePlan_Handler,
 		},
+		{
+			MethodName: "ReorderPlans",
+			Handler:    _LearningPlatform_ReorderPlans_Handler,
+		},
+		{
+			MethodName: "AttachPlanToChannel",
+			Handler:    _LearningPlatform_AttachPlanToChannel_Handler,
+		},
+		{
+			MethodName: "DetachPlanFromChannel",
+			Handler:    _LearningPlatform_DetachPlanFromChannel_Handler,
+		},
+		{
+			MethodName: "ClonePlan",
+			Handler:    _LearningPlatform_ClonePlan_Handler,
+		},
 		{
 			MethodName: "CreateLesson",
 			Handler:    _LearningPlatform_CreateLesson_Handler,
@@ -953,6 +1959,26 @@ var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLesson",
 			Handler:    _LearningPlatform_DeleteLesson_Handler,
 		},
+		{
+			MethodName: "ReorderLessons",
+			Handler:    _LearningPlatform_ReorderLessons_Handler,
+		},
+		{
+			MethodName: "AttachLessonToPlan",
+			Handler:    _LearningPlatform_AttachLessonToPlan_Handler,
+		},
+		{
+			MethodName: "DetachLessonFromPlan",
+			Handler:    _LearningPlatform_DetachLessonFromPlan_Handler,
+		},
+		{
+			MethodName: "CloneLesson",
+			Handler:    _LearningPlatform_CloneLesson_Handler,
+		},
+		{
+			MethodName: "MoveLesson",
+			Handler:    _LearningPlatform_MoveLesson_Handler,
+		},
 		{
 			MethodName: "CreatePage",
 			Handler:    _LearningPlatform_CreatePage_Handler,
@@ -973,6 +1999,14 @@ var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePage",
 			Handler:    _LearningPlatform_DeletePage_Handler,
 		},
+		{
+			MethodName: "ReorderPages",
+			Handler:    _LearningPlatform_ReorderPages_Handler,
+		},
+		{
+			MethodName: "MovePage",
+			Handler:    _LearningPlatform_MovePage_Handler,
+		},
 		{
 			MethodName: "CreateQuestionPage",
 			Handler:    _LearningPlatform_CreateQuestionPage_Handler,
@@ -985,10 +2019,78 @@ var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateQuestionPage",
 			Handler:    _LearningPlatform_UpdateQuestionPage_Handler,
 		},
+		{
+			MethodName: "DeleteQuestionPage",
+			Handler:    _LearningPlatform_DeleteQuestionPage_Handler,
+		},
+		{
+			MethodName: "GetQuestionPages",
+			Handler:    _LearningPlatform_GetQuestionPages_Handler,
+		},
+		{
+			MethodName: "CreateQuestionBank",
+			Handler:    _LearningPlatform_CreateQuestionBank_Handler,
+		},
+		{
+			MethodName: "GetQuestionBank",
+			Handler:    _LearningPlatform_GetQuestionBank_Handler,
+		},
+		{
+			MethodName: "GetQuestionBanks",
+			Handler:    _LearningPlatform_GetQuestionBanks_Handler,
+		},
+		{
+			MethodName: "DeleteQuestionBank",
+			Handler:    _LearningPlatform_DeleteQuestionBank_Handler,
+		},
+		{
+			MethodName: "AddQuestionToBank",
+			Handler:    _LearningPlatform_AddQuestionToBank_Handler,
+		},
+		{
+			MethodName: "RemoveQuestionFromBank",
+			Handler:    _LearningPlatform_RemoveQuestionFromBank_Handler,
+		},
+		{
+			MethodName: "SetLessonQuestionDraws",
+			Handler:    _LearningPlatform_SetLessonQuestionDraws_Handler,
+		},
+		{
+			MethodName: "GetLessonQuestionDraws",
+			Handler:    _LearningPlatform_GetLessonQuestionDraws_Handler,
+		},
 		{
 			MethodName: "CreateAttempt",
 			Handler:    _LearningPlatform_CreateAttempt_Handler,
 		},
+		{
+			MethodName: "SubmitQuestionAnswer",
+			Handler:    _LearningPlatform_SubmitQuestionAnswer_Handler,
+		},
+		{
+			MethodName: "CompleteAttempt",
+			Handler:    _LearningPlatform_CompleteAttempt_Handler,
+		},
+		{
+			MethodName: "GetAttempt",
+			Handler:    _LearningPlatform_GetAttempt_Handler,
+		},
+		{
+			MethodName: "ListAttempts",
+			Handler:    _LearningPlatform_ListAttempts_Handler,
+		},
+		{
+			MethodName: "MarkPageViewed",
+			Handler:    _LearningPlatform_MarkPageViewed_Handler,
+		},
+		{
+			MethodName: "GetPlanProgress",
+			Handler:    _LearningPlatform_GetPlanProgress_Handler,
+		},
+		{
+			MethodName: "GetChannelProgress",
+			Handler:    _LearningPlatform_GetChannelProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lp.proto",